@@ -5,10 +5,16 @@
 package main
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
+	"net/http"
 	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
+	"github.com/IBM/sarama"
 	"github.com/bborbe/errors"
 	libhttp "github.com/bborbe/http"
 	libkafka "github.com/bborbe/kafka"
@@ -21,9 +27,11 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel"
 
 	"github.com/bborbe/kafka-topic-reader/pkg"
 	"github.com/bborbe/kafka-topic-reader/pkg/factory"
+	"github.com/bborbe/kafka-topic-reader/pkg/ui"
 )
 
 func main() {
@@ -32,14 +40,71 @@ func main() {
 }
 
 type application struct {
-	SentryDSN                 string            `required:"true"  arg:"sentry-dsn"                   env:"SENTRY_DSN"                   usage:"SentryDSN"                                                               display:"length"`
+	SentryDSN                 string            `required:"true"  arg:"sentry-dsn"                   env:"SENTRY_DSN"                   usage:"SentryDSN"                                                                                         display:"length"`
 	SentryProxy               string            `required:"false" arg:"sentry-proxy"                 env:"SENTRY_PROXY"                 usage:"Sentry Proxy"`
 	Listen                    string            `required:"true"  arg:"listen"                       env:"LISTEN"                       usage:"address to listen to"`
-	KafkaBrokers              string            `required:"true"  arg:"kafka-brokers"                env:"KAFKA_BROKERS"                usage:"Comma separated list of Kafka brokers"`
-	ErrorPreviewContentLength int               `required:"false" arg:"error-preview-content-length" env:"ERROR_PREVIEW_CONTENT_LENGTH" usage:"Maximum length in bytes for error message preview. Use -1 for unlimited"                  default:"100"`
-	PrometheusNamespace       string            `required:"false" arg:"prometheus-namespace"         env:"PROMETHEUS_NAMESPACE"         usage:"Namespace used for prometheus"                                                            default:"default"`
-	BuildGitVersion           string            `required:"false" arg:"build-git-version"            env:"BUILD_GIT_VERSION"            usage:"Build Git version"                                                                        default:"dev"`
-	BuildGitCommit            string            `required:"false" arg:"build-git-commit"             env:"BUILD_GIT_COMMIT"             usage:"Build Git commit hash"                                                                    default:"none"`
+	KafkaBrokers              string            `required:"false" arg:"kafka-brokers"                env:"KAFKA_BROKERS"                usage:"Comma separated list of Kafka brokers"`
+	KafkaClusterName          string            `required:"false" arg:"kafka-cluster-name"           env:"KAFKA_CLUSTER_NAME"           usage:"Name of the cluster configured by kafka-brokers"                                                                    default:"default"`
+	KafkaClustersFile         string            `required:"false" arg:"kafka-clusters-file"          env:"KAFKA_CLUSTERS_FILE"          usage:"JSON file with additional named Kafka clusters"`
+	KafkaDefaultCluster       string            `required:"false" arg:"kafka-default-cluster"        env:"KAFKA_DEFAULT_CLUSTER"        usage:"Cluster used if none is selected, defaults to the first configured"`
+	KafkaTLSEnabled           bool              `required:"false" arg:"kafka-tls-enabled"            env:"KAFKA_TLS_ENABLED"            usage:"Connect to Kafka with TLS, implied if a TLS file is set"`
+	KafkaTLSCAFile            string            `required:"false" arg:"kafka-tls-ca-file"            env:"KAFKA_TLS_CA_FILE"            usage:"PEM file with CA certificates to verify the Kafka brokers"`
+	KafkaTLSCertFile          string            `required:"false" arg:"kafka-tls-cert-file"          env:"KAFKA_TLS_CERT_FILE"          usage:"PEM file with client certificate for Kafka"`
+	KafkaTLSKeyFile           string            `required:"false" arg:"kafka-tls-key-file"           env:"KAFKA_TLS_KEY_FILE"           usage:"PEM file with client key for Kafka"`
+	KafkaTLSInsecure          bool              `required:"false" arg:"kafka-tls-insecure"           env:"KAFKA_TLS_INSECURE"           usage:"Skip verification of the Kafka broker certificates"`
+	KafkaSASLMechanism        string            `required:"false" arg:"kafka-sasl-mechanism"         env:"KAFKA_SASL_MECHANISM"         usage:"SASL mechanism PLAIN, SCRAM-SHA-256 or SCRAM-SHA-512, empty disables SASL"`
+	KafkaSASLUsernameFile     string            `required:"false" arg:"kafka-sasl-username-file"     env:"KAFKA_SASL_USERNAME_FILE"     usage:"File containing the SASL username"`
+	KafkaSASLPasswordFile     string            `required:"false" arg:"kafka-sasl-password-file"     env:"KAFKA_SASL_PASSWORD_FILE"     usage:"File containing the SASL password"`
+	AuthAPIKeysFile           string            `required:"false" arg:"auth-api-keys-file"           env:"AUTH_API_KEYS_FILE"           usage:"JSON file with static API keys"`
+	AuthJWKSFile              string            `required:"false" arg:"auth-jwks-file"               env:"AUTH_JWKS_FILE"               usage:"JWKS file with keys to validate JWTs"`
+	AuthJWKSURL               string            `required:"false" arg:"auth-jwks-url"                env:"AUTH_JWKS_URL"                usage:"JWKS URL with keys to validate JWTs"`
+	AuthJWTIssuer             string            `required:"false" arg:"auth-jwt-issuer"              env:"AUTH_JWT_ISSUER"              usage:"Required issuer of JWTs"`
+	AuthJWTAudience           string            `required:"false" arg:"auth-jwt-audience"            env:"AUTH_JWT_AUDIENCE"            usage:"Required audience of JWTs"`
+	AuthJWTGroupsClaim        string            `required:"false" arg:"auth-jwt-groups-claim"        env:"AUTH_JWT_GROUPS_CLAIM"        usage:"JWT claim containing the groups of the user"                                                                        default:"groups"`
+	AuthPolicyFile            string            `required:"false" arg:"auth-policy-file"             env:"AUTH_POLICY_FILE"             usage:"JSON file with rules granting operations on topics, requires authentication"`
+	RedactionRulesFile        string            `required:"false" arg:"redaction-rules-file"         env:"REDACTION_RULES_FILE"         usage:"JSON file with per-topic rules to mask, hash or drop sensitive fields"`
+	RedactionHashKeyFile      string            `required:"false" arg:"redaction-hash-key-file"      env:"REDACTION_HASH_KEY_FILE"      usage:"File with the key for hashed values, random per start if empty"`
+	AuditLogFile              string            `required:"false" arg:"audit-log-file"               env:"AUDIT_LOG_FILE"               usage:"File to append audit events as JSON lines"`
+	AuditKafkaTopic           string            `required:"false" arg:"audit-kafka-topic"            env:"AUDIT_KAFKA_TOPIC"            usage:"Kafka topic to send audit events to"`
+	AuditKafkaCluster         string            `required:"false" arg:"audit-kafka-cluster"          env:"AUDIT_KAFKA_CLUSTER"          usage:"Cluster of the audit topic, defaults to the default cluster"`
+	RateLimitPerSecond        float64           `required:"false" arg:"rate-limit-per-second"        env:"RATE_LIMIT_PER_SECOND"        usage:"Requests per second per client (identity or IP), 0 disables rate limiting"                                          default:"10"`
+	RateLimitBurst            int               `required:"false" arg:"rate-limit-burst"             env:"RATE_LIMIT_BURST"             usage:"Requests a client can send at once before the rate limit applies"                                                   default:"20"`
+	MaxConcurrentReads        int               `required:"false" arg:"max-concurrent-reads"         env:"MAX_CONCURRENT_READS"         usage:"Maximum of reads running at the same time over all clients, 0 is unlimited"                                         default:"16"`
+	ReadDefaultLimit          uint64            `required:"false" arg:"read-default-limit"           env:"READ_DEFAULT_LIMIT"           usage:"Records returned by /read if no limit is given"                                                                     default:"100"`
+	ReadMaxLimit              uint64            `required:"false" arg:"read-max-limit"               env:"READ_MAX_LIMIT"               usage:"Maximum records returned by /read, larger limits are capped"                                                        default:"1000"`
+	ReadTimeout               time.Duration     `required:"false" arg:"read-timeout"                 env:"READ_TIMEOUT"                 usage:"Timeout of /read if no timeout is given"                                                                            default:"15s"`
+	ReadMaxTimeout            time.Duration     `required:"false" arg:"read-max-timeout"             env:"READ_MAX_TIMEOUT"             usage:"Maximum timeout of /read, larger timeouts are capped"                                                               default:"60s"`
+	ReadMaxResponseBytes      int               `required:"false" arg:"read-max-response-bytes"      env:"READ_MAX_RESPONSE_BYTES"      usage:"Maximum JSON size of the records returned by /read, 0 is unlimited"                                                 default:"10485760"`
+	ConversionWorkers         int               `required:"false" arg:"conversion-workers"           env:"CONVERSION_WORKERS"           usage:"Messages of a read converted in parallel, 1 converts sequentially"                                                  default:"1"`
+	FetchMinBytes             int               `required:"false" arg:"fetch-min-bytes"              env:"FETCH_MIN_BYTES"              usage:"Bytes a broker waits for before answering a fetch of a read"                                                        default:"1"`
+	FetchDefaultBytes         int               `required:"false" arg:"fetch-default-bytes"          env:"FETCH_DEFAULT_BYTES"          usage:"Bytes fetched per partition and request"                                                                            default:"1048576"`
+	FetchMaxBytes             int               `required:"false" arg:"fetch-max-bytes"              env:"FETCH_MAX_BYTES"              usage:"Bytes fetched per partition and request for larger messages, 0 is unlimited"                                        default:"0"`
+	FetchMaxWait              time.Duration     `required:"false" arg:"fetch-max-wait"               env:"FETCH_MAX_WAIT"               usage:"Time a broker waits for the fetch min bytes"                                                                        default:"500ms"`
+	FetchIsolation            string            `required:"false" arg:"fetch-isolation"              env:"FETCH_ISOLATION"              usage:"Isolation of reads without isolation parameter, read_uncommitted or read_committed"                                 default:"read_uncommitted"`
+	FetchLimitBytes           int               `required:"false" arg:"fetch-limit-bytes"            env:"FETCH_LIMIT_BYTES"            usage:"Maximum fetch bytes a request can set, larger values are capped"                                                    default:"67108864"`
+	FetchLimitMaxWait         time.Duration     `required:"false" arg:"fetch-limit-max-wait"         env:"FETCH_LIMIT_MAX_WAIT"         usage:"Maximum fetch max wait a request can set, larger values are capped"                                                 default:"5s"`
+	SearchDir                 string            `required:"false" arg:"search-dir"                   env:"SEARCH_DIR"                   usage:"Directory to store the matches of search jobs, empty keeps them in memory"`
+	SearchMaxRunning          int               `required:"false" arg:"search-max-running"           env:"SEARCH_MAX_RUNNING"           usage:"Maximum of search jobs running at the same time per cluster, 0 disables search jobs"                                default:"4"`
+	SearchMaxRunningPerOwner  int               `required:"false" arg:"search-max-running-per-owner" env:"SEARCH_MAX_RUNNING_PER_OWNER" usage:"Maximum of search jobs of the same subject running at the same time per cluster, 0 is unlimited"                    default:"2"`
+	SearchMaxMatches          uint64            `required:"false" arg:"search-max-matches"           env:"SEARCH_MAX_MATCHES"           usage:"Matches a search job stops at, 0 is unlimited"                                                                      default:"10000"`
+	SearchTimeout             time.Duration     `required:"false" arg:"search-timeout"               env:"SEARCH_TIMEOUT"               usage:"Maximum duration of a search job"                                                                                   default:"1h"`
+	SearchRetention           time.Duration     `required:"false" arg:"search-retention"             env:"SEARCH_RETENTION"             usage:"Time a finished search job and its matches are kept"                                                                default:"1h"`
+	IndexDir                  string            `required:"false" arg:"index-dir"                    env:"INDEX_DIR"                    usage:"Directory of the offset index files, empty disables the offset index"`
+	IndexTopics               string            `required:"false" arg:"index-topics"                 env:"INDEX_TOPICS"                 usage:"Comma separated list of topics of the cluster configured by kafka-brokers to index"`
+	IndexTimeBucket           time.Duration     `required:"false" arg:"index-time-bucket"            env:"INDEX_TIME_BUCKET"            usage:"Resolution of the time index, a jump to a time starts at most one bucket early"                                     default:"1m"`
+	IndexBatchSize            int               `required:"false" arg:"index-batch-size"             env:"INDEX_BATCH_SIZE"             usage:"Messages written to the offset index in one transaction"                                                            default:"1000"`
+	IndexFlushInterval        time.Duration     `required:"false" arg:"index-flush-interval"         env:"INDEX_FLUSH_INTERVAL"         usage:"Maximum time indexed messages wait for their transaction"                                                           default:"1s"`
+	ConsumerPoolIdleTimeout   time.Duration     `required:"false" arg:"consumer-pool-idle-timeout"   env:"CONSUMER_POOL_IDLE_TIMEOUT"   usage:"Time an idle partition consumer is kept open for the next read"                                                     default:"1m"`
+	ConsumerPoolMaxIdle       int               `required:"false" arg:"consumer-pool-max-idle"       env:"CONSUMER_POOL_MAX_IDLE"       usage:"Maximum of idle partition consumers kept open per cluster, 0 disables pooling"                                      default:"32"`
+	ConsumerPoolMaxClients    int               `required:"false" arg:"consumer-pool-max-clients"    env:"CONSUMER_POOL_MAX_CLIENTS"    usage:"Maximum of Kafka clients per cluster for reads with fetch parameters other than the defaults"                       default:"4"`
+	MessageCacheMaxBytes      int64             `required:"false" arg:"message-cache-max-bytes"      env:"MESSAGE_CACHE_MAX_BYTES"      usage:"Maximum size of the cache of read messages per cluster, 0 disables the cache"                                       default:"67108864"`
+	TracingOTLPEndpoint       string            `required:"false" arg:"tracing-otlp-endpoint"        env:"TRACING_OTLP_ENDPOINT"        usage:"OTLP HTTP endpoint to export traces to, like http://otel-collector:4318, empty disables tracing"`
+	TracingSampleRatio        float64           `required:"false" arg:"tracing-sample-ratio"         env:"TRACING_SAMPLE_RATIO"         usage:"Ratio of traces to sample if the caller did not decide"                                                             default:"1"`
+	ErrorPreviewContentLength int               `required:"false" arg:"error-preview-content-length" env:"ERROR_PREVIEW_CONTENT_LENGTH" usage:"Maximum length in bytes for error message preview. Use -1 for unlimited"                                            default:"100"`
+	MaxDecompressedBytes      int               `required:"false" arg:"max-decompressed-bytes"       env:"MAX_DECOMPRESSED_BYTES"       usage:"Maximum size of a decompressed message value compressed by the producer, 0 disables decompression"                  default:"10485760"`
+	PrometheusNamespace       string            `required:"false" arg:"prometheus-namespace"         env:"PROMETHEUS_NAMESPACE"         usage:"Namespace used for prometheus"                                                                                      default:"default"`
+	BuildGitVersion           string            `required:"false" arg:"build-git-version"            env:"BUILD_GIT_VERSION"            usage:"Build Git version"                                                                                                  default:"dev"`
+	BuildGitCommit            string            `required:"false" arg:"build-git-commit"             env:"BUILD_GIT_COMMIT"             usage:"Build Git commit hash"                                                                                              default:"none"`
 	BuildDate                 *libtime.DateTime `required:"false" arg:"build-date"                   env:"BUILD_DATE"                   usage:"Build timestamp (RFC3339)"`
 }
 
@@ -53,38 +118,507 @@ func (a *application) Run(
 	)
 	buildInfoMetrics.SetBuildInfo(a.BuildDate)
 
+	tracerProvider, err := pkg.NewTracerProvider(
+		ctx,
+		a.TracingOTLPEndpoint,
+		"kafka-topic-reader",
+		a.BuildGitVersion,
+		a.TracingSampleRatio,
+	)
+	if err != nil {
+		return errors.Wrapf(ctx, err, "create tracer provider failed")
+	}
+	defer a.shutdownTracerProvider(tracerProvider)
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(pkg.NewTraceContextPropagator())
+
+	clusterConfigs, err := a.clusterConfigs(ctx)
+	if err != nil {
+		return errors.Wrapf(ctx, err, "get cluster configs failed")
+	}
+
+	authenticator, err := a.createAuthenticator(ctx)
+	if err != nil {
+		return errors.Wrapf(ctx, err, "create authenticator failed")
+	}
+
+	authorizer, err := a.createAuthorizer(ctx, authenticator)
+	if err != nil {
+		return errors.Wrapf(ctx, err, "create authorizer failed")
+	}
+
+	redactor, err := a.createRedactor(ctx)
+	if err != nil {
+		return errors.Wrapf(ctx, err, "create redactor failed")
+	}
+
+	readLimits := a.readLimits()
+	if err := readLimits.Validate(ctx); err != nil {
+		return errors.Wrapf(ctx, err, "validate read limits failed")
+	}
+
+	fetchLimits := a.fetchLimits()
+	if err := fetchLimits.Validate(ctx); err != nil {
+		return errors.Wrapf(ctx, err, "validate fetch limits failed")
+	}
+
+	metricsFactory := pkg.NewMetricsFactory(
+		prometheus.DefaultRegisterer,
+		a.PrometheusNamespace,
+	)
+
+	limitMetrics := pkg.NewLimitMetrics(
+		prometheus.DefaultRegisterer,
+		a.PrometheusNamespace,
+	)
+	limitMetrics.SetLimits(a.RateLimitPerSecond, a.RateLimitBurst, a.MaxConcurrentReads)
+
+	auditLogger, err := a.createAuditLogger(ctx, clusterConfigs)
+	if err != nil {
+		return errors.Wrapf(ctx, err, "create audit logger failed")
+	}
+	defer auditLogger.Close()
+
+	consumerPoolMetrics := pkg.NewConsumerPoolMetrics(
+		prometheus.DefaultRegisterer,
+		a.PrometheusNamespace,
+	)
+
+	messageCacheMetrics := pkg.NewMessageCacheMetrics(
+		prometheus.DefaultRegisterer,
+		a.PrometheusNamespace,
+	)
+
+	indexMetrics := pkg.NewIndexMetrics(
+		prometheus.DefaultRegisterer,
+		a.PrometheusNamespace,
+	)
+
+	clusters, err := a.createClusters(
+		ctx,
+		clusterConfigs,
+		consumerPoolMetrics,
+		messageCacheMetrics,
+		indexMetrics,
+	)
+	if err != nil {
+		return errors.Wrapf(ctx, err, "create clusters failed")
+	}
+	defer clusters.Close()
+
+	funcs := []run.Func{
+		a.createHTTPServer(
+			sentryClient,
+			authenticator,
+			authorizer,
+			redactor,
+			auditLogger,
+			limitMetrics,
+			metricsFactory,
+			clusters,
+		),
+	}
+	for _, cluster := range clusters {
+		funcs = append(funcs, cluster.ConsumerPool.Run)
+		if cluster.Indexer != nil {
+			funcs = append(funcs, cluster.Indexer.Run)
+		}
+	}
+	return service.Run(ctx, funcs...)
+}
+
+func (a *application) createAuditLogger(
+	ctx context.Context,
+	clusterConfigs pkg.ClusterConfigs,
+) (pkg.AuditLogger, error) {
+	var auditLoggers []pkg.AuditLogger
+	if a.AuditLogFile != "" {
+		fileAuditLogger, err := pkg.NewFileAuditLogger(ctx, a.AuditLogFile)
+		if err != nil {
+			return nil, errors.Wrapf(ctx, err, "create file audit logger failed")
+		}
+		auditLoggers = append(auditLoggers, fileAuditLogger)
+	}
+	if a.AuditKafkaTopic != "" {
+		clusterConfig, ok := clusterConfigs.Find(a.auditKafkaCluster(clusterConfigs))
+		if !ok {
+			return nil, errors.Errorf(ctx, "audit cluster %s not configured", a.AuditKafkaCluster)
+		}
+		syncProducer, err := a.createSyncProducer(ctx, *clusterConfig)
+		if err != nil {
+			return nil, errors.Wrapf(ctx, err, "create audit producer failed")
+		}
+		auditLoggers = append(
+			auditLoggers,
+			pkg.NewKafkaAuditLogger(syncProducer, a.AuditKafkaTopic),
+		)
+	}
+	if len(auditLoggers) == 0 {
+		glog.Warningf("no audit log file or topic configured, audit disabled")
+	}
+	return pkg.NewAuditLoggerList(auditLoggers...), nil
+}
+
+func (a *application) auditKafkaCluster(clusterConfigs pkg.ClusterConfigs) pkg.ClusterName {
+	if a.AuditKafkaCluster != "" {
+		return pkg.ClusterName(a.AuditKafkaCluster)
+	}
+	if a.KafkaDefaultCluster != "" {
+		return pkg.ClusterName(a.KafkaDefaultCluster)
+	}
+	return clusterConfigs[0].Name
+}
+
+// createSyncProducer uses an own client, the producer settings differ from the reader clients.
+func (a *application) createSyncProducer(
+	ctx context.Context,
+	clusterConfig pkg.ClusterConfig,
+) (sarama.SyncProducer, error) {
+	saramaConfigOptions, err := clusterConfig.Auth.SaramaConfigOptions(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "create kafka auth failed")
+	}
 	saramaClient, err := libkafka.CreateSaramaClient(
 		ctx,
-		libkafka.ParseBrokersFromString(a.KafkaBrokers),
+		libkafka.ParseBrokersFromString(clusterConfig.Brokers),
+		saramaConfigOptions,
+		func(config *sarama.Config) {
+			config.Producer.Return.Successes = true
+			config.Producer.RequiredAcks = sarama.WaitForAll
+		},
 	)
 	if err != nil {
-		return errors.Wrapf(ctx, err, "create sarama client failed")
+		return nil, errors.Wrapf(ctx, err, "create sarama client failed")
+	}
+	syncProducer, err := sarama.NewSyncProducerFromClient(saramaClient)
+	if err != nil {
+		_ = saramaClient.Close()
+		return nil, errors.Wrapf(ctx, err, "create sync producer failed")
+	}
+	return &syncProducerWithClient{SyncProducer: syncProducer, saramaClient: saramaClient}, nil
+}
+
+// syncProducerWithClient closes the client together with the producer.
+type syncProducerWithClient struct {
+	sarama.SyncProducer
+	saramaClient libkafka.SaramaClient
+}
+
+func (s *syncProducerWithClient) Close() error {
+	if err := s.SyncProducer.Close(); err != nil {
+		_ = s.saramaClient.Close()
+		return err
+	}
+	return s.saramaClient.Close()
+}
+
+func (a *application) createRedactor(ctx context.Context) (pkg.Redactor, error) {
+	var rules pkg.RedactionRules
+	if a.RedactionRulesFile != "" {
+		var err error
+		rules, err = pkg.ParseRedactionRulesFile(ctx, a.RedactionRulesFile)
+		if err != nil {
+			return nil, errors.Wrapf(ctx, err, "parse redaction rules failed")
+		}
+	}
+	hashKey := make([]byte, 32)
+	if a.RedactionHashKeyFile != "" {
+		content, err := os.ReadFile(a.RedactionHashKeyFile)
+		if err != nil {
+			return nil, errors.Wrapf(ctx, err, "read redaction hash key failed")
+		}
+		hashKey = bytes.TrimSpace(content)
+	} else if _, err := rand.Read(hashKey); err != nil {
+		return nil, errors.Wrapf(ctx, err, "generate redaction hash key failed")
+	}
+	return pkg.NewRedactor(ctx, rules, hashKey)
+}
+
+// createAuthorizer allows everything if no policy is configured.
+func (a *application) createAuthorizer(
+	ctx context.Context,
+	authenticator pkg.Authenticator,
+) (pkg.Authorizer, error) {
+	if a.AuthPolicyFile == "" {
+		return pkg.NewAllowAllAuthorizer(), nil
+	}
+	if authenticator == nil {
+		return nil, errors.New(ctx, "auth-policy-file requires api keys or jwks")
+	}
+	policy, err := pkg.ParsePolicyFile(ctx, a.AuthPolicyFile)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "parse policy failed")
 	}
-	defer saramaClient.Close()
+	return pkg.NewPolicyAuthorizer(*policy), nil
+}
 
-	return service.Run(
+// createAuthenticator returns nil if no authentication is configured.
+func (a *application) createAuthenticator(ctx context.Context) (pkg.Authenticator, error) {
+	var authenticators []pkg.Authenticator
+	if a.AuthAPIKeysFile != "" {
+		apiKeys, err := pkg.ParseAPIKeysFile(ctx, a.AuthAPIKeysFile)
+		if err != nil {
+			return nil, errors.Wrapf(ctx, err, "parse api keys failed")
+		}
+		authenticators = append(authenticators, pkg.NewAPIKeyAuthenticator(apiKeys))
+	}
+	if a.AuthJWKSFile != "" && a.AuthJWKSURL != "" {
+		return nil, errors.New(ctx, "auth-jwks-file and auth-jwks-url are exclusive")
+	}
+	var jwks pkg.JWKS
+	if a.AuthJWKSFile != "" {
+		var err error
+		jwks, err = pkg.NewJWKSFile(ctx, a.AuthJWKSFile)
+		if err != nil {
+			return nil, errors.Wrapf(ctx, err, "create jwks failed")
+		}
+	}
+	if a.AuthJWKSURL != "" {
+		jwks = pkg.NewJWKSURL(&http.Client{Timeout: 10 * time.Second}, a.AuthJWKSURL, time.Hour)
+	}
+	if jwks != nil {
+		authenticators = append(authenticators, pkg.NewJWTAuthenticator(
+			jwks,
+			a.AuthJWTIssuer,
+			a.AuthJWTAudience,
+			a.AuthJWTGroupsClaim,
+		))
+	}
+	if len(authenticators) == 0 {
+		glog.Warningf("no api keys or jwks configured, authentication disabled")
+		return nil, nil
+	}
+	return pkg.NewAuthenticatorList(authenticators...), nil
+}
+
+func (a *application) clusterConfigs(ctx context.Context) (pkg.ClusterConfigs, error) {
+	var result pkg.ClusterConfigs
+	if a.KafkaBrokers != "" {
+		result = append(result, pkg.ClusterConfig{
+			Name:        pkg.ClusterName(a.KafkaClusterName),
+			Brokers:     a.KafkaBrokers,
+			Auth:        a.kafkaAuth(),
+			IndexTopics: a.indexTopics(),
+		})
+	}
+	if a.KafkaClustersFile != "" {
+		clusterConfigs, err := pkg.ParseClusterConfigsFile(ctx, a.KafkaClustersFile)
+		if err != nil {
+			return nil, errors.Wrapf(ctx, err, "parse clusters file failed")
+		}
+		result = append(result, clusterConfigs...)
+	}
+	if err := result.Validate(ctx); err != nil {
+		return nil, errors.Wrapf(ctx, err, "validate cluster configs failed")
+	}
+	if a.KafkaDefaultCluster != "" {
+		if _, ok := result.Find(pkg.ClusterName(a.KafkaDefaultCluster)); !ok {
+			return nil, errors.Errorf(
+				ctx,
+				"default cluster %s not configured",
+				a.KafkaDefaultCluster,
+			)
+		}
+	}
+	return result, nil
+}
+
+// createClusters connects to all clusters. A cluster that fails is kept with its
+// error so it is reported as unhealthy without affecting the others. Only if no
+// cluster could be connected an error is returned.
+func (a *application) createClusters(
+	ctx context.Context,
+	clusterConfigs pkg.ClusterConfigs,
+	consumerPoolMetrics pkg.ConsumerPoolMetrics,
+	messageCacheMetrics pkg.MessageCacheMetrics,
+	indexMetrics pkg.IndexMetrics,
+) (pkg.Clusters, error) {
+	result := make(pkg.Clusters, 0, len(clusterConfigs))
+	var lastErr error
+	for _, clusterConfig := range clusterConfigs {
+		cluster, err := a.createCluster(
+			ctx,
+			clusterConfig,
+			consumerPoolMetrics,
+			messageCacheMetrics,
+			indexMetrics,
+		)
+		if err != nil {
+			glog.Warningf("connect to cluster %s failed: %v", clusterConfig.Name, err)
+			cluster = pkg.Cluster{Name: clusterConfig.Name, Err: err}
+			lastErr = err
+		}
+		result = append(result, cluster)
+	}
+	if !result.Connected() {
+		return nil, errors.Wrapf(ctx, lastErr, "connect to all clusters failed")
+	}
+	return result, nil
+}
+
+func (a *application) createCluster(
+	ctx context.Context,
+	clusterConfig pkg.ClusterConfig,
+	consumerPoolMetrics pkg.ConsumerPoolMetrics,
+	messageCacheMetrics pkg.MessageCacheMetrics,
+	indexMetrics pkg.IndexMetrics,
+) (pkg.Cluster, error) {
+	saramaConfigOptions, err := clusterConfig.Auth.SaramaConfigOptions(ctx)
+	if err != nil {
+		return pkg.Cluster{}, errors.Wrapf(ctx, err, "create kafka auth failed")
+	}
+
+	fetchConfig := a.fetchLimits().Default
+	saramaClient, err := libkafka.CreateSaramaClient(
+		ctx,
+		libkafka.ParseBrokersFromString(clusterConfig.Brokers),
+		saramaConfigOptions,
+		fetchConfig.SaramaConfigOptions(),
+	)
+	if err != nil {
+		return pkg.Cluster{}, errors.Wrapf(ctx, err, "create sarama client failed")
+	}
+
+	// no close of clusterAdmin, it would close the shared saramaClient
+	clusterAdmin, err := sarama.NewClusterAdminFromClient(saramaClient)
+	if err != nil {
+		_ = saramaClient.Close()
+		return pkg.Cluster{}, errors.Wrapf(ctx, err, "create cluster admin failed")
+	}
+
+	offsetIndex, indexer, err := a.createOffsetIndex(
 		ctx,
-		a.createHTTPServer(sentryClient, saramaClient),
+		clusterConfig,
+		saramaClient,
+		fetchConfig,
+		indexMetrics,
 	)
+	if err != nil {
+		_ = saramaClient.Close()
+		return pkg.Cluster{}, errors.Wrapf(ctx, err, "create offset index failed")
+	}
+	return pkg.Cluster{
+		Name:         clusterConfig.Name,
+		SaramaClient: saramaClient,
+		ClusterAdmin: clusterAdmin,
+		ConsumerPool: pkg.NewConsumerPool(
+			pkg.NewSaramaConsumerFactory(saramaClient, fetchConfig, a.ConsumerPoolMaxClients),
+			consumerPoolMetrics,
+			a.ConsumerPoolIdleTimeout,
+			a.ConsumerPoolMaxIdle,
+		),
+		MessageCache: pkg.NewMessageCache(messageCacheMetrics, a.MessageCacheMaxBytes),
+		OffsetIndex:  offsetIndex,
+		Indexer:      indexer,
+	}, nil
+}
+
+// createOffsetIndex opens the index file of the cluster in the index dir. It returns
+// nil if no index dir or no topic to index is configured.
+func (a *application) createOffsetIndex(
+	ctx context.Context,
+	clusterConfig pkg.ClusterConfig,
+	saramaClient libkafka.SaramaClient,
+	fetchConfig pkg.FetchConfig,
+	indexMetrics pkg.IndexMetrics,
+) (pkg.OffsetIndex, pkg.Indexer, error) {
+	if a.IndexDir == "" || len(clusterConfig.IndexTopics) == 0 {
+		return nil, nil, nil
+	}
+	if err := os.MkdirAll(a.IndexDir, 0700); err != nil {
+		return nil, nil, errors.Wrapf(ctx, err, "create index dir %s failed", a.IndexDir)
+	}
+	offsetIndex, err := pkg.NewOffsetIndex(
+		ctx,
+		filepath.Join(a.IndexDir, clusterConfig.Name.String()+".db"),
+		a.IndexTimeBucket,
+	)
+	if err != nil {
+		return nil, nil, errors.Wrapf(ctx, err, "open offset index failed")
+	}
+	indexer := factory.CreateIndexer(
+		saramaClient,
+		fetchConfig,
+		offsetIndex,
+		indexMetrics,
+		clusterConfig.IndexTopics,
+		a.IndexBatchSize,
+		a.IndexFlushInterval,
+	)
+	return offsetIndex, indexer, nil
+}
+
+// indexTopics returns the topics of the index topics flag.
+func (a *application) indexTopics() []libkafka.Topic {
+	var result []libkafka.Topic
+	for _, value := range strings.Split(a.IndexTopics, ",") {
+		if topic := strings.TrimSpace(value); topic != "" {
+			result = append(result, libkafka.Topic(topic))
+		}
+	}
+	return result
+}
+
+func (a *application) kafkaAuth() pkg.KafkaAuth {
+	return pkg.KafkaAuth{
+		TLSEnabled:            a.KafkaTLSEnabled,
+		TLSCAFile:             a.KafkaTLSCAFile,
+		TLSCertFile:           a.KafkaTLSCertFile,
+		TLSKeyFile:            a.KafkaTLSKeyFile,
+		TLSInsecureSkipVerify: a.KafkaTLSInsecure,
+		SASLMechanism:         a.KafkaSASLMechanism,
+		SASLUsernameFile:      a.KafkaSASLUsernameFile,
+		SASLPasswordFile:      a.KafkaSASLPasswordFile,
+	}
 }
 
 func (a *application) createHTTPServer(
 	sentryClient sentry.Client,
-	saramaClient libkafka.SaramaClient,
+	authenticator pkg.Authenticator,
+	authorizer pkg.Authorizer,
+	redactor pkg.Redactor,
+	auditLogger pkg.AuditLogger,
+	limitMetrics pkg.LimitMetrics,
+	metricsFactory pkg.MetricsFactory,
+	clusters pkg.Clusters,
 ) run.Func {
 	return func(ctx context.Context) error {
 		ctx, cancel := context.WithCancel(ctx)
 		defer cancel()
 
+		protected := mux.NewRouter()
+		protected.Path("/setloglevel/{level}").Handler(
+			log.NewSetLoglevelHandler(ctx, log.NewLogLevelSetter(2, 5*time.Minute)),
+		)
+		protected.Path("/clusters").
+			Handler(libhttp.NewErrorHandler(pkg.NewClustersHandler(clusters)))
+		protected.Path("/clusters/{cluster}/healthz").Handler(pkg.NewClusterHealthHandler(clusters))
+		protected.PathPrefix("/").
+			Handler(a.createClusterHandler(
+				ctx,
+				sentryClient,
+				authorizer,
+				redactor,
+				auditLogger,
+				pkg.NewConcurrencyLimiter(a.MaxConcurrentReads),
+				limitMetrics,
+				metricsFactory,
+				clusters,
+			))
+		limited := a.createRateLimitHandler(limitMetrics, protected)
+
 		router := mux.NewRouter()
 		router.Path("/healthz").Handler(libhttp.NewPrintHandler("OK"))
 		router.Path("/readiness").Handler(libhttp.NewPrintHandler("OK"))
 		router.Path("/metrics").Handler(promhttp.Handler())
-		router.Path("/setloglevel/{level}").Handler(
-			log.NewSetLoglevelHandler(ctx, log.NewLogLevelSetter(2, 5*time.Minute)),
-		)
-		router.Path("/read").
-			Handler(factory.CreateReadHandler(sentryClient, saramaClient, a.ErrorPreviewContentLength))
+		// static ui assets contain no data, the ui sends the token with its api calls
+		router.Path("/ui").Handler(http.RedirectHandler("/ui/", http.StatusMovedPermanently))
+		router.PathPrefix("/ui/").Handler(ui.NewHandler("/ui/"))
+		api := limited
+		if authenticator != nil {
+			api = pkg.NewAuthHandler(authenticator, limited)
+		}
+		router.PathPrefix("/").Handler(pkg.NewTracingHandler(api))
 
 		glog.V(2).Infof("starting http server listen on %s", a.Listen)
 		return libhttp.NewServer(
@@ -93,3 +627,278 @@ func (a *application) createHTTPServer(
 		).Run(ctx)
 	}
 }
+
+// shutdownTracerProvider flushes pending spans, with its own timeout as the run context
+// is already canceled.
+func (a *application) shutdownTracerProvider(tracerProvider pkg.TracerProvider) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := tracerProvider.Shutdown(ctx); err != nil {
+		glog.Warningf("shutdown tracer provider failed: %v", err)
+	}
+}
+
+func (a *application) fetchLimits() pkg.FetchLimits {
+	return pkg.FetchLimits{
+		Default: pkg.FetchConfig{
+			MinBytes:     a.FetchMinBytes,
+			DefaultBytes: a.FetchDefaultBytes,
+			MaxBytes:     a.FetchMaxBytes,
+			MaxWait:      a.FetchMaxWait,
+			Isolation:    pkg.Isolation(a.FetchIsolation),
+		},
+		MaxBytes: a.FetchLimitBytes,
+		MaxWait:  a.FetchLimitMaxWait,
+	}
+}
+
+func (a *application) readLimits() pkg.ReadLimits {
+	return pkg.ReadLimits{
+		DefaultLimit:     a.ReadDefaultLimit,
+		MaxLimit:         a.ReadMaxLimit,
+		DefaultTimeout:   a.ReadTimeout,
+		MaxTimeout:       a.ReadMaxTimeout,
+		MaxResponseBytes: a.ReadMaxResponseBytes,
+	}
+}
+
+// createRateLimitHandler limits requests per client, it runs after authentication to
+// limit authenticated clients by identity instead of IP.
+func (a *application) createRateLimitHandler(
+	limitMetrics pkg.LimitMetrics,
+	handler http.Handler,
+) http.Handler {
+	if a.RateLimitPerSecond <= 0 {
+		return handler
+	}
+	return pkg.NewRateLimitHandler(
+		pkg.NewRateLimiter(a.RateLimitPerSecond, a.RateLimitBurst, 10*time.Minute),
+		limitMetrics,
+		handler,
+	)
+}
+
+func (a *application) createClusterHandler(
+	ctx context.Context,
+	sentryClient sentry.Client,
+	authorizer pkg.Authorizer,
+	redactor pkg.Redactor,
+	auditLogger pkg.AuditLogger,
+	concurrencyLimiter pkg.ConcurrencyLimiter,
+	limitMetrics pkg.LimitMetrics,
+	metricsFactory pkg.MetricsFactory,
+	clusters pkg.Clusters,
+) http.Handler {
+	handlers := make(map[pkg.ClusterName]http.Handler, len(clusters))
+	for _, cluster := range clusters {
+		if cluster.Err != nil {
+			handlers[cluster.Name] = http.HandlerFunc(
+				func(resp http.ResponseWriter, req *http.Request) {
+					http.Error(
+						resp,
+						"cluster "+cluster.Name.String()+" unavailable",
+						http.StatusServiceUnavailable,
+					)
+				},
+			)
+			continue
+		}
+		handlers[cluster.Name] = a.createClusterRouter(
+			ctx,
+			sentryClient,
+			authorizer,
+			redactor,
+			auditLogger,
+			concurrencyLimiter,
+			limitMetrics,
+			metricsFactory(cluster.Name, cluster.SaramaClient),
+			cluster,
+		)
+	}
+	defaultCluster := pkg.ClusterName(a.KafkaDefaultCluster)
+	if defaultCluster == "" {
+		defaultCluster = clusters[0].Name
+	}
+	return pkg.NewClusterHandler(defaultCluster, handlers)
+}
+
+// createClusterRouter routes the api of the cluster, search jobs run until ctx is
+// canceled.
+func (a *application) createClusterRouter(
+	ctx context.Context,
+	sentryClient sentry.Client,
+	authorizer pkg.Authorizer,
+	redactor pkg.Redactor,
+	auditLogger pkg.AuditLogger,
+	concurrencyLimiter pkg.ConcurrencyLimiter,
+	limitMetrics pkg.LimitMetrics,
+	metrics pkg.Metrics,
+	cluster pkg.Cluster,
+) http.Handler {
+	authorized := func(operation pkg.Operation, handler http.Handler) http.Handler {
+		return pkg.NewAuthorizationHandler(authorizer, cluster.Name, operation, handler)
+	}
+	// audit wraps authorization, so denied requests are recorded too
+	audited := func(operation pkg.Operation, handler http.Handler) http.Handler {
+		return pkg.NewAuditHandler(auditLogger, cluster.Name, operation, handler)
+	}
+	readHandler := factory.CreateReadHandler(
+		sentryClient,
+		cluster.SaramaClient,
+		cluster.ClusterAdmin,
+		cluster.ConsumerPool,
+		cluster.MessageCache,
+		cluster.OffsetIndex,
+		redactor,
+		authorizer,
+		cluster.Name,
+		concurrencyLimiter,
+		limitMetrics,
+		metrics,
+		a.readLimits(),
+		a.fetchLimits(),
+		a.ConversionWorkers,
+		a.ErrorPreviewContentLength,
+		a.MaxDecompressedBytes,
+	)
+	router := mux.NewRouter()
+	router.Path("/read").
+		Handler(audited(pkg.OperationRead, authorized(pkg.OperationRead, readHandler)))
+	router.Path("/topics").
+		Handler(factory.CreateTopicsHandler(cluster.ClusterAdmin, authorizer, cluster.Name))
+	router.Path("/topics/{topic}/partitions").
+		Handler(authorized(pkg.OperationRead, factory.CreatePartitionsHandler(cluster.SaramaClient)))
+	router.Path("/topics/{topic}/config").
+		Handler(authorized(pkg.OperationRead, factory.CreateTopicConfigHandler(cluster.ClusterAdmin)))
+	router.Path("/consumer-groups").
+		Handler(factory.CreateConsumerGroupsHandler(
+			cluster.SaramaClient,
+			cluster.ClusterAdmin,
+			authorizer,
+			cluster.Name,
+		))
+	router.Path("/consumer-groups/{group}").
+		Handler(factory.CreateConsumerGroupHandler(
+			cluster.SaramaClient,
+			cluster.ClusterAdmin,
+			authorizer,
+			cluster.Name,
+		))
+	if cluster.Indexer != nil {
+		a.addIndexRoutes(router, authorizer, redactor, cluster, audited, authorized)
+	}
+	if a.SearchMaxRunning > 0 {
+		a.addSearchRoutes(
+			ctx,
+			router,
+			sentryClient,
+			redactor,
+			concurrencyLimiter,
+			limitMetrics,
+			metrics,
+			cluster,
+			audited,
+			authorized,
+		)
+	}
+	return router
+}
+
+func (a *application) addSearchRoutes(
+	ctx context.Context,
+	router *mux.Router,
+	sentryClient sentry.Client,
+	redactor pkg.Redactor,
+	concurrencyLimiter pkg.ConcurrencyLimiter,
+	limitMetrics pkg.LimitMetrics,
+	metrics pkg.Metrics,
+	cluster pkg.Cluster,
+	audited func(operation pkg.Operation, handler http.Handler) http.Handler,
+	authorized func(operation pkg.Operation, handler http.Handler) http.Handler,
+) {
+	createResults := pkg.NewMemorySearchResultsFactory()
+	if a.SearchDir != "" {
+		createResults = pkg.NewFileSearchResultsFactory(
+			filepath.Join(a.SearchDir, cluster.Name.String()),
+		)
+	}
+	searchManager := factory.CreateSearchManager(
+		ctx,
+		sentryClient,
+		cluster.SaramaClient,
+		cluster.ConsumerPool,
+		cluster.MessageCache,
+		redactor,
+		concurrencyLimiter,
+		limitMetrics,
+		metrics,
+		createResults,
+		a.ConversionWorkers,
+		a.ErrorPreviewContentLength,
+		a.MaxDecompressedBytes,
+		a.SearchMaxRunning,
+		a.SearchMaxRunningPerOwner,
+		a.SearchMaxMatches,
+		a.SearchTimeout,
+		a.SearchRetention,
+	)
+	// only the owner can get or cancel a search, the topic was authorized at start
+	router.Path("/searches").
+		Methods(http.MethodPost).
+		Handler(audited(pkg.OperationRead, authorized(
+			pkg.OperationRead,
+			factory.CreateSearchCreateHandler(searchManager, a.fetchLimits()),
+		)))
+	router.Path("/searches/{id}").
+		Methods(http.MethodGet).
+		Handler(audited(
+			pkg.OperationRead,
+			factory.CreateSearchHandler(searchManager, a.readLimits()),
+		))
+	router.Path("/searches/{id}").
+		Methods(http.MethodDelete).
+		Handler(audited(pkg.OperationRead, factory.CreateSearchCancelHandler(searchManager)))
+}
+
+// addIndexRoutes routes the key lookup and the administration of the offset index.
+func (a *application) addIndexRoutes(
+	router *mux.Router,
+	authorizer pkg.Authorizer,
+	redactor pkg.Redactor,
+	cluster pkg.Cluster,
+	audited func(operation pkg.Operation, handler http.Handler) http.Handler,
+	authorized func(operation pkg.Operation, handler http.Handler) http.Handler,
+) {
+	router.Path("/topics/{topic}/keys").
+		Methods(http.MethodGet).
+		Handler(audited(pkg.OperationRead, authorized(
+			pkg.OperationRead,
+			factory.CreateKeyOffsetsHandler(
+				cluster.Indexer,
+				cluster.OffsetIndex,
+				redactor,
+				a.readLimits(),
+			),
+		)))
+	router.Path("/index").
+		Methods(http.MethodGet).
+		Handler(factory.CreateIndexHandler(
+			cluster.Indexer,
+			cluster.OffsetIndex,
+			authorizer,
+			cluster.Name,
+		))
+	router.Path("/index/rebuild").
+		Methods(http.MethodPost).
+		Handler(audited(pkg.OperationAdmin, authorized(
+			pkg.OperationAdmin,
+			factory.CreateIndexRebuildHandler(cluster.Indexer),
+		)))
+	// compaction rewrites the index of all topics
+	router.Path("/index/compact").
+		Methods(http.MethodPost).
+		Handler(audited(pkg.OperationAdmin, authorized(
+			pkg.OperationAdmin,
+			factory.CreateIndexCompactHandler(cluster.Indexer),
+		)))
+}
@@ -0,0 +1,175 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+type AuditLogger struct {
+	CloseStub        func() error
+	closeMutex       sync.RWMutex
+	closeArgsForCall []struct {
+	}
+	closeReturns struct {
+		result1 error
+	}
+	closeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	LogStub        func(context.Context, pkg.AuditEvent) error
+	logMutex       sync.RWMutex
+	logArgsForCall []struct {
+		arg1 context.Context
+		arg2 pkg.AuditEvent
+	}
+	logReturns struct {
+		result1 error
+	}
+	logReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *AuditLogger) Close() error {
+	fake.closeMutex.Lock()
+	ret, specificReturn := fake.closeReturnsOnCall[len(fake.closeArgsForCall)]
+	fake.closeArgsForCall = append(fake.closeArgsForCall, struct {
+	}{})
+	stub := fake.CloseStub
+	fakeReturns := fake.closeReturns
+	fake.recordInvocation("Close", []interface{}{})
+	fake.closeMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *AuditLogger) CloseCallCount() int {
+	fake.closeMutex.RLock()
+	defer fake.closeMutex.RUnlock()
+	return len(fake.closeArgsForCall)
+}
+
+func (fake *AuditLogger) CloseCalls(stub func() error) {
+	fake.closeMutex.Lock()
+	defer fake.closeMutex.Unlock()
+	fake.CloseStub = stub
+}
+
+func (fake *AuditLogger) CloseReturns(result1 error) {
+	fake.closeMutex.Lock()
+	defer fake.closeMutex.Unlock()
+	fake.CloseStub = nil
+	fake.closeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AuditLogger) CloseReturnsOnCall(i int, result1 error) {
+	fake.closeMutex.Lock()
+	defer fake.closeMutex.Unlock()
+	fake.CloseStub = nil
+	if fake.closeReturnsOnCall == nil {
+		fake.closeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.closeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AuditLogger) Log(arg1 context.Context, arg2 pkg.AuditEvent) error {
+	fake.logMutex.Lock()
+	ret, specificReturn := fake.logReturnsOnCall[len(fake.logArgsForCall)]
+	fake.logArgsForCall = append(fake.logArgsForCall, struct {
+		arg1 context.Context
+		arg2 pkg.AuditEvent
+	}{arg1, arg2})
+	stub := fake.LogStub
+	fakeReturns := fake.logReturns
+	fake.recordInvocation("Log", []interface{}{arg1, arg2})
+	fake.logMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *AuditLogger) LogCallCount() int {
+	fake.logMutex.RLock()
+	defer fake.logMutex.RUnlock()
+	return len(fake.logArgsForCall)
+}
+
+func (fake *AuditLogger) LogCalls(stub func(context.Context, pkg.AuditEvent) error) {
+	fake.logMutex.Lock()
+	defer fake.logMutex.Unlock()
+	fake.LogStub = stub
+}
+
+func (fake *AuditLogger) LogArgsForCall(i int) (context.Context, pkg.AuditEvent) {
+	fake.logMutex.RLock()
+	defer fake.logMutex.RUnlock()
+	argsForCall := fake.logArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *AuditLogger) LogReturns(result1 error) {
+	fake.logMutex.Lock()
+	defer fake.logMutex.Unlock()
+	fake.LogStub = nil
+	fake.logReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AuditLogger) LogReturnsOnCall(i int, result1 error) {
+	fake.logMutex.Lock()
+	defer fake.logMutex.Unlock()
+	fake.LogStub = nil
+	if fake.logReturnsOnCall == nil {
+		fake.logReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.logReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *AuditLogger) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *AuditLogger) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pkg.AuditLogger = new(AuditLogger)
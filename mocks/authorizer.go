@@ -0,0 +1,192 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/bborbe/kafka"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+type Authorizer struct {
+	AllowedStub        func(*pkg.Identity, pkg.ClusterName, kafka.Topic, pkg.Operation) bool
+	allowedMutex       sync.RWMutex
+	allowedArgsForCall []struct {
+		arg1 *pkg.Identity
+		arg2 pkg.ClusterName
+		arg3 kafka.Topic
+		arg4 pkg.Operation
+	}
+	allowedReturns struct {
+		result1 bool
+	}
+	allowedReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	VisibleStub        func(*pkg.Identity, pkg.ClusterName, kafka.Topic) bool
+	visibleMutex       sync.RWMutex
+	visibleArgsForCall []struct {
+		arg1 *pkg.Identity
+		arg2 pkg.ClusterName
+		arg3 kafka.Topic
+	}
+	visibleReturns struct {
+		result1 bool
+	}
+	visibleReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *Authorizer) Allowed(arg1 *pkg.Identity, arg2 pkg.ClusterName, arg3 kafka.Topic, arg4 pkg.Operation) bool {
+	fake.allowedMutex.Lock()
+	ret, specificReturn := fake.allowedReturnsOnCall[len(fake.allowedArgsForCall)]
+	fake.allowedArgsForCall = append(fake.allowedArgsForCall, struct {
+		arg1 *pkg.Identity
+		arg2 pkg.ClusterName
+		arg3 kafka.Topic
+		arg4 pkg.Operation
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.AllowedStub
+	fakeReturns := fake.allowedReturns
+	fake.recordInvocation("Allowed", []interface{}{arg1, arg2, arg3, arg4})
+	fake.allowedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Authorizer) AllowedCallCount() int {
+	fake.allowedMutex.RLock()
+	defer fake.allowedMutex.RUnlock()
+	return len(fake.allowedArgsForCall)
+}
+
+func (fake *Authorizer) AllowedCalls(stub func(*pkg.Identity, pkg.ClusterName, kafka.Topic, pkg.Operation) bool) {
+	fake.allowedMutex.Lock()
+	defer fake.allowedMutex.Unlock()
+	fake.AllowedStub = stub
+}
+
+func (fake *Authorizer) AllowedArgsForCall(i int) (*pkg.Identity, pkg.ClusterName, kafka.Topic, pkg.Operation) {
+	fake.allowedMutex.RLock()
+	defer fake.allowedMutex.RUnlock()
+	argsForCall := fake.allowedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *Authorizer) AllowedReturns(result1 bool) {
+	fake.allowedMutex.Lock()
+	defer fake.allowedMutex.Unlock()
+	fake.AllowedStub = nil
+	fake.allowedReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *Authorizer) AllowedReturnsOnCall(i int, result1 bool) {
+	fake.allowedMutex.Lock()
+	defer fake.allowedMutex.Unlock()
+	fake.AllowedStub = nil
+	if fake.allowedReturnsOnCall == nil {
+		fake.allowedReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.allowedReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *Authorizer) Visible(arg1 *pkg.Identity, arg2 pkg.ClusterName, arg3 kafka.Topic) bool {
+	fake.visibleMutex.Lock()
+	ret, specificReturn := fake.visibleReturnsOnCall[len(fake.visibleArgsForCall)]
+	fake.visibleArgsForCall = append(fake.visibleArgsForCall, struct {
+		arg1 *pkg.Identity
+		arg2 pkg.ClusterName
+		arg3 kafka.Topic
+	}{arg1, arg2, arg3})
+	stub := fake.VisibleStub
+	fakeReturns := fake.visibleReturns
+	fake.recordInvocation("Visible", []interface{}{arg1, arg2, arg3})
+	fake.visibleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Authorizer) VisibleCallCount() int {
+	fake.visibleMutex.RLock()
+	defer fake.visibleMutex.RUnlock()
+	return len(fake.visibleArgsForCall)
+}
+
+func (fake *Authorizer) VisibleCalls(stub func(*pkg.Identity, pkg.ClusterName, kafka.Topic) bool) {
+	fake.visibleMutex.Lock()
+	defer fake.visibleMutex.Unlock()
+	fake.VisibleStub = stub
+}
+
+func (fake *Authorizer) VisibleArgsForCall(i int) (*pkg.Identity, pkg.ClusterName, kafka.Topic) {
+	fake.visibleMutex.RLock()
+	defer fake.visibleMutex.RUnlock()
+	argsForCall := fake.visibleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *Authorizer) VisibleReturns(result1 bool) {
+	fake.visibleMutex.Lock()
+	defer fake.visibleMutex.Unlock()
+	fake.VisibleStub = nil
+	fake.visibleReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *Authorizer) VisibleReturnsOnCall(i int, result1 bool) {
+	fake.visibleMutex.Lock()
+	defer fake.visibleMutex.Unlock()
+	fake.VisibleStub = nil
+	if fake.visibleReturnsOnCall == nil {
+		fake.visibleReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.visibleReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *Authorizer) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *Authorizer) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pkg.Authorizer = new(Authorizer)
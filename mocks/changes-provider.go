@@ -10,7 +10,7 @@ import (
 )
 
 type ChangesProvider struct {
-	ChangesStub        func(context.Context, kafka.Topic, kafka.Partition, kafka.Offset, uint64, []byte) (pkg.Records, error)
+	ChangesStub        func(context.Context, kafka.Topic, kafka.Partition, kafka.Offset, uint64, []byte, pkg.FetchConfig) (*pkg.ChangesResult, error)
 	changesMutex       sync.RWMutex
 	changesArgsForCall []struct {
 		arg1 context.Context
@@ -19,20 +19,21 @@ type ChangesProvider struct {
 		arg4 kafka.Offset
 		arg5 uint64
 		arg6 []byte
+		arg7 pkg.FetchConfig
 	}
 	changesReturns struct {
-		result1 pkg.Records
+		result1 *pkg.ChangesResult
 		result2 error
 	}
 	changesReturnsOnCall map[int]struct {
-		result1 pkg.Records
+		result1 *pkg.ChangesResult
 		result2 error
 	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *ChangesProvider) Changes(arg1 context.Context, arg2 kafka.Topic, arg3 kafka.Partition, arg4 kafka.Offset, arg5 uint64, arg6 []byte) (pkg.Records, error) {
+func (fake *ChangesProvider) Changes(arg1 context.Context, arg2 kafka.Topic, arg3 kafka.Partition, arg4 kafka.Offset, arg5 uint64, arg6 []byte, arg7 pkg.FetchConfig) (*pkg.ChangesResult, error) {
 	var arg6Copy []byte
 	if arg6 != nil {
 		arg6Copy = make([]byte, len(arg6))
@@ -47,13 +48,14 @@ func (fake *ChangesProvider) Changes(arg1 context.Context, arg2 kafka.Topic, arg
 		arg4 kafka.Offset
 		arg5 uint64
 		arg6 []byte
-	}{arg1, arg2, arg3, arg4, arg5, arg6Copy})
+		arg7 pkg.FetchConfig
+	}{arg1, arg2, arg3, arg4, arg5, arg6Copy, arg7})
 	stub := fake.ChangesStub
 	fakeReturns := fake.changesReturns
-	fake.recordInvocation("Changes", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6Copy})
+	fake.recordInvocation("Changes", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6Copy, arg7})
 	fake.changesMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -67,41 +69,41 @@ func (fake *ChangesProvider) ChangesCallCount() int {
 	return len(fake.changesArgsForCall)
 }
 
-func (fake *ChangesProvider) ChangesCalls(stub func(context.Context, kafka.Topic, kafka.Partition, kafka.Offset, uint64, []byte) (pkg.Records, error)) {
+func (fake *ChangesProvider) ChangesCalls(stub func(context.Context, kafka.Topic, kafka.Partition, kafka.Offset, uint64, []byte, pkg.FetchConfig) (*pkg.ChangesResult, error)) {
 	fake.changesMutex.Lock()
 	defer fake.changesMutex.Unlock()
 	fake.ChangesStub = stub
 }
 
-func (fake *ChangesProvider) ChangesArgsForCall(i int) (context.Context, kafka.Topic, kafka.Partition, kafka.Offset, uint64, []byte) {
+func (fake *ChangesProvider) ChangesArgsForCall(i int) (context.Context, kafka.Topic, kafka.Partition, kafka.Offset, uint64, []byte, pkg.FetchConfig) {
 	fake.changesMutex.RLock()
 	defer fake.changesMutex.RUnlock()
 	argsForCall := fake.changesArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
 }
 
-func (fake *ChangesProvider) ChangesReturns(result1 pkg.Records, result2 error) {
+func (fake *ChangesProvider) ChangesReturns(result1 *pkg.ChangesResult, result2 error) {
 	fake.changesMutex.Lock()
 	defer fake.changesMutex.Unlock()
 	fake.ChangesStub = nil
 	fake.changesReturns = struct {
-		result1 pkg.Records
+		result1 *pkg.ChangesResult
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *ChangesProvider) ChangesReturnsOnCall(i int, result1 pkg.Records, result2 error) {
+func (fake *ChangesProvider) ChangesReturnsOnCall(i int, result1 *pkg.ChangesResult, result2 error) {
 	fake.changesMutex.Lock()
 	defer fake.changesMutex.Unlock()
 	fake.ChangesStub = nil
 	if fake.changesReturnsOnCall == nil {
 		fake.changesReturnsOnCall = make(map[int]struct {
-			result1 pkg.Records
+			result1 *pkg.ChangesResult
 			result2 error
 		})
 	}
 	fake.changesReturnsOnCall[i] = struct {
-		result1 pkg.Records
+		result1 *pkg.ChangesResult
 		result2 error
 	}{result1, result2}
 }
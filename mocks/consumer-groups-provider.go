@@ -0,0 +1,278 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/bborbe/kafka"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+type ConsumerGroupsProvider struct {
+	CommittedOffsetStub        func(context.Context, string, kafka.Topic, kafka.Partition) (*kafka.Offset, error)
+	committedOffsetMutex       sync.RWMutex
+	committedOffsetArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 kafka.Topic
+		arg4 kafka.Partition
+	}
+	committedOffsetReturns struct {
+		result1 *kafka.Offset
+		result2 error
+	}
+	committedOffsetReturnsOnCall map[int]struct {
+		result1 *kafka.Offset
+		result2 error
+	}
+	ConsumerGroupStub        func(context.Context, string) (*pkg.ConsumerGroupDetail, error)
+	consumerGroupMutex       sync.RWMutex
+	consumerGroupArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	consumerGroupReturns struct {
+		result1 *pkg.ConsumerGroupDetail
+		result2 error
+	}
+	consumerGroupReturnsOnCall map[int]struct {
+		result1 *pkg.ConsumerGroupDetail
+		result2 error
+	}
+	ConsumerGroupsStub        func(context.Context) (pkg.ConsumerGroupInfos, error)
+	consumerGroupsMutex       sync.RWMutex
+	consumerGroupsArgsForCall []struct {
+		arg1 context.Context
+	}
+	consumerGroupsReturns struct {
+		result1 pkg.ConsumerGroupInfos
+		result2 error
+	}
+	consumerGroupsReturnsOnCall map[int]struct {
+		result1 pkg.ConsumerGroupInfos
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *ConsumerGroupsProvider) CommittedOffset(arg1 context.Context, arg2 string, arg3 kafka.Topic, arg4 kafka.Partition) (*kafka.Offset, error) {
+	fake.committedOffsetMutex.Lock()
+	ret, specificReturn := fake.committedOffsetReturnsOnCall[len(fake.committedOffsetArgsForCall)]
+	fake.committedOffsetArgsForCall = append(fake.committedOffsetArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 kafka.Topic
+		arg4 kafka.Partition
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.CommittedOffsetStub
+	fakeReturns := fake.committedOffsetReturns
+	fake.recordInvocation("CommittedOffset", []interface{}{arg1, arg2, arg3, arg4})
+	fake.committedOffsetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *ConsumerGroupsProvider) CommittedOffsetCallCount() int {
+	fake.committedOffsetMutex.RLock()
+	defer fake.committedOffsetMutex.RUnlock()
+	return len(fake.committedOffsetArgsForCall)
+}
+
+func (fake *ConsumerGroupsProvider) CommittedOffsetCalls(stub func(context.Context, string, kafka.Topic, kafka.Partition) (*kafka.Offset, error)) {
+	fake.committedOffsetMutex.Lock()
+	defer fake.committedOffsetMutex.Unlock()
+	fake.CommittedOffsetStub = stub
+}
+
+func (fake *ConsumerGroupsProvider) CommittedOffsetArgsForCall(i int) (context.Context, string, kafka.Topic, kafka.Partition) {
+	fake.committedOffsetMutex.RLock()
+	defer fake.committedOffsetMutex.RUnlock()
+	argsForCall := fake.committedOffsetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *ConsumerGroupsProvider) CommittedOffsetReturns(result1 *kafka.Offset, result2 error) {
+	fake.committedOffsetMutex.Lock()
+	defer fake.committedOffsetMutex.Unlock()
+	fake.CommittedOffsetStub = nil
+	fake.committedOffsetReturns = struct {
+		result1 *kafka.Offset
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *ConsumerGroupsProvider) CommittedOffsetReturnsOnCall(i int, result1 *kafka.Offset, result2 error) {
+	fake.committedOffsetMutex.Lock()
+	defer fake.committedOffsetMutex.Unlock()
+	fake.CommittedOffsetStub = nil
+	if fake.committedOffsetReturnsOnCall == nil {
+		fake.committedOffsetReturnsOnCall = make(map[int]struct {
+			result1 *kafka.Offset
+			result2 error
+		})
+	}
+	fake.committedOffsetReturnsOnCall[i] = struct {
+		result1 *kafka.Offset
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *ConsumerGroupsProvider) ConsumerGroup(arg1 context.Context, arg2 string) (*pkg.ConsumerGroupDetail, error) {
+	fake.consumerGroupMutex.Lock()
+	ret, specificReturn := fake.consumerGroupReturnsOnCall[len(fake.consumerGroupArgsForCall)]
+	fake.consumerGroupArgsForCall = append(fake.consumerGroupArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ConsumerGroupStub
+	fakeReturns := fake.consumerGroupReturns
+	fake.recordInvocation("ConsumerGroup", []interface{}{arg1, arg2})
+	fake.consumerGroupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *ConsumerGroupsProvider) ConsumerGroupCallCount() int {
+	fake.consumerGroupMutex.RLock()
+	defer fake.consumerGroupMutex.RUnlock()
+	return len(fake.consumerGroupArgsForCall)
+}
+
+func (fake *ConsumerGroupsProvider) ConsumerGroupCalls(stub func(context.Context, string) (*pkg.ConsumerGroupDetail, error)) {
+	fake.consumerGroupMutex.Lock()
+	defer fake.consumerGroupMutex.Unlock()
+	fake.ConsumerGroupStub = stub
+}
+
+func (fake *ConsumerGroupsProvider) ConsumerGroupArgsForCall(i int) (context.Context, string) {
+	fake.consumerGroupMutex.RLock()
+	defer fake.consumerGroupMutex.RUnlock()
+	argsForCall := fake.consumerGroupArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *ConsumerGroupsProvider) ConsumerGroupReturns(result1 *pkg.ConsumerGroupDetail, result2 error) {
+	fake.consumerGroupMutex.Lock()
+	defer fake.consumerGroupMutex.Unlock()
+	fake.ConsumerGroupStub = nil
+	fake.consumerGroupReturns = struct {
+		result1 *pkg.ConsumerGroupDetail
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *ConsumerGroupsProvider) ConsumerGroupReturnsOnCall(i int, result1 *pkg.ConsumerGroupDetail, result2 error) {
+	fake.consumerGroupMutex.Lock()
+	defer fake.consumerGroupMutex.Unlock()
+	fake.ConsumerGroupStub = nil
+	if fake.consumerGroupReturnsOnCall == nil {
+		fake.consumerGroupReturnsOnCall = make(map[int]struct {
+			result1 *pkg.ConsumerGroupDetail
+			result2 error
+		})
+	}
+	fake.consumerGroupReturnsOnCall[i] = struct {
+		result1 *pkg.ConsumerGroupDetail
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *ConsumerGroupsProvider) ConsumerGroups(arg1 context.Context) (pkg.ConsumerGroupInfos, error) {
+	fake.consumerGroupsMutex.Lock()
+	ret, specificReturn := fake.consumerGroupsReturnsOnCall[len(fake.consumerGroupsArgsForCall)]
+	fake.consumerGroupsArgsForCall = append(fake.consumerGroupsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ConsumerGroupsStub
+	fakeReturns := fake.consumerGroupsReturns
+	fake.recordInvocation("ConsumerGroups", []interface{}{arg1})
+	fake.consumerGroupsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *ConsumerGroupsProvider) ConsumerGroupsCallCount() int {
+	fake.consumerGroupsMutex.RLock()
+	defer fake.consumerGroupsMutex.RUnlock()
+	return len(fake.consumerGroupsArgsForCall)
+}
+
+func (fake *ConsumerGroupsProvider) ConsumerGroupsCalls(stub func(context.Context) (pkg.ConsumerGroupInfos, error)) {
+	fake.consumerGroupsMutex.Lock()
+	defer fake.consumerGroupsMutex.Unlock()
+	fake.ConsumerGroupsStub = stub
+}
+
+func (fake *ConsumerGroupsProvider) ConsumerGroupsArgsForCall(i int) context.Context {
+	fake.consumerGroupsMutex.RLock()
+	defer fake.consumerGroupsMutex.RUnlock()
+	argsForCall := fake.consumerGroupsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *ConsumerGroupsProvider) ConsumerGroupsReturns(result1 pkg.ConsumerGroupInfos, result2 error) {
+	fake.consumerGroupsMutex.Lock()
+	defer fake.consumerGroupsMutex.Unlock()
+	fake.ConsumerGroupsStub = nil
+	fake.consumerGroupsReturns = struct {
+		result1 pkg.ConsumerGroupInfos
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *ConsumerGroupsProvider) ConsumerGroupsReturnsOnCall(i int, result1 pkg.ConsumerGroupInfos, result2 error) {
+	fake.consumerGroupsMutex.Lock()
+	defer fake.consumerGroupsMutex.Unlock()
+	fake.ConsumerGroupsStub = nil
+	if fake.consumerGroupsReturnsOnCall == nil {
+		fake.consumerGroupsReturnsOnCall = make(map[int]struct {
+			result1 pkg.ConsumerGroupInfos
+			result2 error
+		})
+	}
+	fake.consumerGroupsReturnsOnCall[i] = struct {
+		result1 pkg.ConsumerGroupInfos
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *ConsumerGroupsProvider) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *ConsumerGroupsProvider) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pkg.ConsumerGroupsProvider = new(ConsumerGroupsProvider)
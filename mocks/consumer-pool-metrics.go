@@ -0,0 +1,139 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+type ConsumerPoolMetrics struct {
+	AcquiredStub        func(pkg.ConsumerPoolResult)
+	acquiredMutex       sync.RWMutex
+	acquiredArgsForCall []struct {
+		arg1 pkg.ConsumerPoolResult
+	}
+	EvictedStub        func()
+	evictedMutex       sync.RWMutex
+	evictedArgsForCall []struct {
+	}
+	IdleChangedStub        func(int)
+	idleChangedMutex       sync.RWMutex
+	idleChangedArgsForCall []struct {
+		arg1 int
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *ConsumerPoolMetrics) Acquired(arg1 pkg.ConsumerPoolResult) {
+	fake.acquiredMutex.Lock()
+	fake.acquiredArgsForCall = append(fake.acquiredArgsForCall, struct {
+		arg1 pkg.ConsumerPoolResult
+	}{arg1})
+	stub := fake.AcquiredStub
+	fake.recordInvocation("Acquired", []interface{}{arg1})
+	fake.acquiredMutex.Unlock()
+	if stub != nil {
+		fake.AcquiredStub(arg1)
+	}
+}
+
+func (fake *ConsumerPoolMetrics) AcquiredCallCount() int {
+	fake.acquiredMutex.RLock()
+	defer fake.acquiredMutex.RUnlock()
+	return len(fake.acquiredArgsForCall)
+}
+
+func (fake *ConsumerPoolMetrics) AcquiredCalls(stub func(pkg.ConsumerPoolResult)) {
+	fake.acquiredMutex.Lock()
+	defer fake.acquiredMutex.Unlock()
+	fake.AcquiredStub = stub
+}
+
+func (fake *ConsumerPoolMetrics) AcquiredArgsForCall(i int) pkg.ConsumerPoolResult {
+	fake.acquiredMutex.RLock()
+	defer fake.acquiredMutex.RUnlock()
+	argsForCall := fake.acquiredArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *ConsumerPoolMetrics) Evicted() {
+	fake.evictedMutex.Lock()
+	fake.evictedArgsForCall = append(fake.evictedArgsForCall, struct {
+	}{})
+	stub := fake.EvictedStub
+	fake.recordInvocation("Evicted", []interface{}{})
+	fake.evictedMutex.Unlock()
+	if stub != nil {
+		fake.EvictedStub()
+	}
+}
+
+func (fake *ConsumerPoolMetrics) EvictedCallCount() int {
+	fake.evictedMutex.RLock()
+	defer fake.evictedMutex.RUnlock()
+	return len(fake.evictedArgsForCall)
+}
+
+func (fake *ConsumerPoolMetrics) EvictedCalls(stub func()) {
+	fake.evictedMutex.Lock()
+	defer fake.evictedMutex.Unlock()
+	fake.EvictedStub = stub
+}
+
+func (fake *ConsumerPoolMetrics) IdleChanged(arg1 int) {
+	fake.idleChangedMutex.Lock()
+	fake.idleChangedArgsForCall = append(fake.idleChangedArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	stub := fake.IdleChangedStub
+	fake.recordInvocation("IdleChanged", []interface{}{arg1})
+	fake.idleChangedMutex.Unlock()
+	if stub != nil {
+		fake.IdleChangedStub(arg1)
+	}
+}
+
+func (fake *ConsumerPoolMetrics) IdleChangedCallCount() int {
+	fake.idleChangedMutex.RLock()
+	defer fake.idleChangedMutex.RUnlock()
+	return len(fake.idleChangedArgsForCall)
+}
+
+func (fake *ConsumerPoolMetrics) IdleChangedCalls(stub func(int)) {
+	fake.idleChangedMutex.Lock()
+	defer fake.idleChangedMutex.Unlock()
+	fake.IdleChangedStub = stub
+}
+
+func (fake *ConsumerPoolMetrics) IdleChangedArgsForCall(i int) int {
+	fake.idleChangedMutex.RLock()
+	defer fake.idleChangedMutex.RUnlock()
+	argsForCall := fake.idleChangedArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *ConsumerPoolMetrics) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *ConsumerPoolMetrics) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pkg.ConsumerPoolMetrics = new(ConsumerPoolMetrics)
@@ -0,0 +1,118 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/bborbe/kafka"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+type IndexMetrics struct {
+	LagStub        func(kafka.Topic, kafka.Partition, int64)
+	lagMutex       sync.RWMutex
+	lagArgsForCall []struct {
+		arg1 kafka.Topic
+		arg2 kafka.Partition
+		arg3 int64
+	}
+	MessagesIndexedStub        func(kafka.Topic, int)
+	messagesIndexedMutex       sync.RWMutex
+	messagesIndexedArgsForCall []struct {
+		arg1 kafka.Topic
+		arg2 int
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *IndexMetrics) Lag(arg1 kafka.Topic, arg2 kafka.Partition, arg3 int64) {
+	fake.lagMutex.Lock()
+	fake.lagArgsForCall = append(fake.lagArgsForCall, struct {
+		arg1 kafka.Topic
+		arg2 kafka.Partition
+		arg3 int64
+	}{arg1, arg2, arg3})
+	stub := fake.LagStub
+	fake.recordInvocation("Lag", []interface{}{arg1, arg2, arg3})
+	fake.lagMutex.Unlock()
+	if stub != nil {
+		fake.LagStub(arg1, arg2, arg3)
+	}
+}
+
+func (fake *IndexMetrics) LagCallCount() int {
+	fake.lagMutex.RLock()
+	defer fake.lagMutex.RUnlock()
+	return len(fake.lagArgsForCall)
+}
+
+func (fake *IndexMetrics) LagCalls(stub func(kafka.Topic, kafka.Partition, int64)) {
+	fake.lagMutex.Lock()
+	defer fake.lagMutex.Unlock()
+	fake.LagStub = stub
+}
+
+func (fake *IndexMetrics) LagArgsForCall(i int) (kafka.Topic, kafka.Partition, int64) {
+	fake.lagMutex.RLock()
+	defer fake.lagMutex.RUnlock()
+	argsForCall := fake.lagArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *IndexMetrics) MessagesIndexed(arg1 kafka.Topic, arg2 int) {
+	fake.messagesIndexedMutex.Lock()
+	fake.messagesIndexedArgsForCall = append(fake.messagesIndexedArgsForCall, struct {
+		arg1 kafka.Topic
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.MessagesIndexedStub
+	fake.recordInvocation("MessagesIndexed", []interface{}{arg1, arg2})
+	fake.messagesIndexedMutex.Unlock()
+	if stub != nil {
+		fake.MessagesIndexedStub(arg1, arg2)
+	}
+}
+
+func (fake *IndexMetrics) MessagesIndexedCallCount() int {
+	fake.messagesIndexedMutex.RLock()
+	defer fake.messagesIndexedMutex.RUnlock()
+	return len(fake.messagesIndexedArgsForCall)
+}
+
+func (fake *IndexMetrics) MessagesIndexedCalls(stub func(kafka.Topic, int)) {
+	fake.messagesIndexedMutex.Lock()
+	defer fake.messagesIndexedMutex.Unlock()
+	fake.MessagesIndexedStub = stub
+}
+
+func (fake *IndexMetrics) MessagesIndexedArgsForCall(i int) (kafka.Topic, int) {
+	fake.messagesIndexedMutex.RLock()
+	defer fake.messagesIndexedMutex.RUnlock()
+	argsForCall := fake.messagesIndexedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *IndexMetrics) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *IndexMetrics) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pkg.IndexMetrics = new(IndexMetrics)
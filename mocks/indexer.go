@@ -0,0 +1,392 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/bborbe/kafka"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+type Indexer struct {
+	CompactStub        func(context.Context) error
+	compactMutex       sync.RWMutex
+	compactArgsForCall []struct {
+		arg1 context.Context
+	}
+	compactReturns struct {
+		result1 error
+	}
+	compactReturnsOnCall map[int]struct {
+		result1 error
+	}
+	IndexedStub        func(kafka.Topic) bool
+	indexedMutex       sync.RWMutex
+	indexedArgsForCall []struct {
+		arg1 kafka.Topic
+	}
+	indexedReturns struct {
+		result1 bool
+	}
+	indexedReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	RebuildStub        func(context.Context, kafka.Topic) error
+	rebuildMutex       sync.RWMutex
+	rebuildArgsForCall []struct {
+		arg1 context.Context
+		arg2 kafka.Topic
+	}
+	rebuildReturns struct {
+		result1 error
+	}
+	rebuildReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RunStub        func(context.Context) error
+	runMutex       sync.RWMutex
+	runArgsForCall []struct {
+		arg1 context.Context
+	}
+	runReturns struct {
+		result1 error
+	}
+	runReturnsOnCall map[int]struct {
+		result1 error
+	}
+	TopicsStub        func() []kafka.Topic
+	topicsMutex       sync.RWMutex
+	topicsArgsForCall []struct {
+	}
+	topicsReturns struct {
+		result1 []kafka.Topic
+	}
+	topicsReturnsOnCall map[int]struct {
+		result1 []kafka.Topic
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *Indexer) Compact(arg1 context.Context) error {
+	fake.compactMutex.Lock()
+	ret, specificReturn := fake.compactReturnsOnCall[len(fake.compactArgsForCall)]
+	fake.compactArgsForCall = append(fake.compactArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.CompactStub
+	fakeReturns := fake.compactReturns
+	fake.recordInvocation("Compact", []interface{}{arg1})
+	fake.compactMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Indexer) CompactCallCount() int {
+	fake.compactMutex.RLock()
+	defer fake.compactMutex.RUnlock()
+	return len(fake.compactArgsForCall)
+}
+
+func (fake *Indexer) CompactCalls(stub func(context.Context) error) {
+	fake.compactMutex.Lock()
+	defer fake.compactMutex.Unlock()
+	fake.CompactStub = stub
+}
+
+func (fake *Indexer) CompactArgsForCall(i int) context.Context {
+	fake.compactMutex.RLock()
+	defer fake.compactMutex.RUnlock()
+	argsForCall := fake.compactArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Indexer) CompactReturns(result1 error) {
+	fake.compactMutex.Lock()
+	defer fake.compactMutex.Unlock()
+	fake.CompactStub = nil
+	fake.compactReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Indexer) CompactReturnsOnCall(i int, result1 error) {
+	fake.compactMutex.Lock()
+	defer fake.compactMutex.Unlock()
+	fake.CompactStub = nil
+	if fake.compactReturnsOnCall == nil {
+		fake.compactReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.compactReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Indexer) Indexed(arg1 kafka.Topic) bool {
+	fake.indexedMutex.Lock()
+	ret, specificReturn := fake.indexedReturnsOnCall[len(fake.indexedArgsForCall)]
+	fake.indexedArgsForCall = append(fake.indexedArgsForCall, struct {
+		arg1 kafka.Topic
+	}{arg1})
+	stub := fake.IndexedStub
+	fakeReturns := fake.indexedReturns
+	fake.recordInvocation("Indexed", []interface{}{arg1})
+	fake.indexedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Indexer) IndexedCallCount() int {
+	fake.indexedMutex.RLock()
+	defer fake.indexedMutex.RUnlock()
+	return len(fake.indexedArgsForCall)
+}
+
+func (fake *Indexer) IndexedCalls(stub func(kafka.Topic) bool) {
+	fake.indexedMutex.Lock()
+	defer fake.indexedMutex.Unlock()
+	fake.IndexedStub = stub
+}
+
+func (fake *Indexer) IndexedArgsForCall(i int) kafka.Topic {
+	fake.indexedMutex.RLock()
+	defer fake.indexedMutex.RUnlock()
+	argsForCall := fake.indexedArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Indexer) IndexedReturns(result1 bool) {
+	fake.indexedMutex.Lock()
+	defer fake.indexedMutex.Unlock()
+	fake.IndexedStub = nil
+	fake.indexedReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *Indexer) IndexedReturnsOnCall(i int, result1 bool) {
+	fake.indexedMutex.Lock()
+	defer fake.indexedMutex.Unlock()
+	fake.IndexedStub = nil
+	if fake.indexedReturnsOnCall == nil {
+		fake.indexedReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.indexedReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *Indexer) Rebuild(arg1 context.Context, arg2 kafka.Topic) error {
+	fake.rebuildMutex.Lock()
+	ret, specificReturn := fake.rebuildReturnsOnCall[len(fake.rebuildArgsForCall)]
+	fake.rebuildArgsForCall = append(fake.rebuildArgsForCall, struct {
+		arg1 context.Context
+		arg2 kafka.Topic
+	}{arg1, arg2})
+	stub := fake.RebuildStub
+	fakeReturns := fake.rebuildReturns
+	fake.recordInvocation("Rebuild", []interface{}{arg1, arg2})
+	fake.rebuildMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Indexer) RebuildCallCount() int {
+	fake.rebuildMutex.RLock()
+	defer fake.rebuildMutex.RUnlock()
+	return len(fake.rebuildArgsForCall)
+}
+
+func (fake *Indexer) RebuildCalls(stub func(context.Context, kafka.Topic) error) {
+	fake.rebuildMutex.Lock()
+	defer fake.rebuildMutex.Unlock()
+	fake.RebuildStub = stub
+}
+
+func (fake *Indexer) RebuildArgsForCall(i int) (context.Context, kafka.Topic) {
+	fake.rebuildMutex.RLock()
+	defer fake.rebuildMutex.RUnlock()
+	argsForCall := fake.rebuildArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Indexer) RebuildReturns(result1 error) {
+	fake.rebuildMutex.Lock()
+	defer fake.rebuildMutex.Unlock()
+	fake.RebuildStub = nil
+	fake.rebuildReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Indexer) RebuildReturnsOnCall(i int, result1 error) {
+	fake.rebuildMutex.Lock()
+	defer fake.rebuildMutex.Unlock()
+	fake.RebuildStub = nil
+	if fake.rebuildReturnsOnCall == nil {
+		fake.rebuildReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.rebuildReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Indexer) Run(arg1 context.Context) error {
+	fake.runMutex.Lock()
+	ret, specificReturn := fake.runReturnsOnCall[len(fake.runArgsForCall)]
+	fake.runArgsForCall = append(fake.runArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.RunStub
+	fakeReturns := fake.runReturns
+	fake.recordInvocation("Run", []interface{}{arg1})
+	fake.runMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Indexer) RunCallCount() int {
+	fake.runMutex.RLock()
+	defer fake.runMutex.RUnlock()
+	return len(fake.runArgsForCall)
+}
+
+func (fake *Indexer) RunCalls(stub func(context.Context) error) {
+	fake.runMutex.Lock()
+	defer fake.runMutex.Unlock()
+	fake.RunStub = stub
+}
+
+func (fake *Indexer) RunArgsForCall(i int) context.Context {
+	fake.runMutex.RLock()
+	defer fake.runMutex.RUnlock()
+	argsForCall := fake.runArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Indexer) RunReturns(result1 error) {
+	fake.runMutex.Lock()
+	defer fake.runMutex.Unlock()
+	fake.RunStub = nil
+	fake.runReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Indexer) RunReturnsOnCall(i int, result1 error) {
+	fake.runMutex.Lock()
+	defer fake.runMutex.Unlock()
+	fake.RunStub = nil
+	if fake.runReturnsOnCall == nil {
+		fake.runReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.runReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Indexer) Topics() []kafka.Topic {
+	fake.topicsMutex.Lock()
+	ret, specificReturn := fake.topicsReturnsOnCall[len(fake.topicsArgsForCall)]
+	fake.topicsArgsForCall = append(fake.topicsArgsForCall, struct {
+	}{})
+	stub := fake.TopicsStub
+	fakeReturns := fake.topicsReturns
+	fake.recordInvocation("Topics", []interface{}{})
+	fake.topicsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Indexer) TopicsCallCount() int {
+	fake.topicsMutex.RLock()
+	defer fake.topicsMutex.RUnlock()
+	return len(fake.topicsArgsForCall)
+}
+
+func (fake *Indexer) TopicsCalls(stub func() []kafka.Topic) {
+	fake.topicsMutex.Lock()
+	defer fake.topicsMutex.Unlock()
+	fake.TopicsStub = stub
+}
+
+func (fake *Indexer) TopicsReturns(result1 []kafka.Topic) {
+	fake.topicsMutex.Lock()
+	defer fake.topicsMutex.Unlock()
+	fake.TopicsStub = nil
+	fake.topicsReturns = struct {
+		result1 []kafka.Topic
+	}{result1}
+}
+
+func (fake *Indexer) TopicsReturnsOnCall(i int, result1 []kafka.Topic) {
+	fake.topicsMutex.Lock()
+	defer fake.topicsMutex.Unlock()
+	fake.TopicsStub = nil
+	if fake.topicsReturnsOnCall == nil {
+		fake.topicsReturnsOnCall = make(map[int]struct {
+			result1 []kafka.Topic
+		})
+	}
+	fake.topicsReturnsOnCall[i] = struct {
+		result1 []kafka.Topic
+	}{result1}
+}
+
+func (fake *Indexer) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *Indexer) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pkg.Indexer = new(Indexer)
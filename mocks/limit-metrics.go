@@ -0,0 +1,171 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+type LimitMetrics struct {
+	ReadFinishedStub        func()
+	readFinishedMutex       sync.RWMutex
+	readFinishedArgsForCall []struct {
+	}
+	ReadStartedStub        func()
+	readStartedMutex       sync.RWMutex
+	readStartedArgsForCall []struct {
+	}
+	RejectedStub        func(pkg.LimitReason)
+	rejectedMutex       sync.RWMutex
+	rejectedArgsForCall []struct {
+		arg1 pkg.LimitReason
+	}
+	SetLimitsStub        func(float64, int, int)
+	setLimitsMutex       sync.RWMutex
+	setLimitsArgsForCall []struct {
+		arg1 float64
+		arg2 int
+		arg3 int
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *LimitMetrics) ReadFinished() {
+	fake.readFinishedMutex.Lock()
+	fake.readFinishedArgsForCall = append(fake.readFinishedArgsForCall, struct {
+	}{})
+	stub := fake.ReadFinishedStub
+	fake.recordInvocation("ReadFinished", []interface{}{})
+	fake.readFinishedMutex.Unlock()
+	if stub != nil {
+		fake.ReadFinishedStub()
+	}
+}
+
+func (fake *LimitMetrics) ReadFinishedCallCount() int {
+	fake.readFinishedMutex.RLock()
+	defer fake.readFinishedMutex.RUnlock()
+	return len(fake.readFinishedArgsForCall)
+}
+
+func (fake *LimitMetrics) ReadFinishedCalls(stub func()) {
+	fake.readFinishedMutex.Lock()
+	defer fake.readFinishedMutex.Unlock()
+	fake.ReadFinishedStub = stub
+}
+
+func (fake *LimitMetrics) ReadStarted() {
+	fake.readStartedMutex.Lock()
+	fake.readStartedArgsForCall = append(fake.readStartedArgsForCall, struct {
+	}{})
+	stub := fake.ReadStartedStub
+	fake.recordInvocation("ReadStarted", []interface{}{})
+	fake.readStartedMutex.Unlock()
+	if stub != nil {
+		fake.ReadStartedStub()
+	}
+}
+
+func (fake *LimitMetrics) ReadStartedCallCount() int {
+	fake.readStartedMutex.RLock()
+	defer fake.readStartedMutex.RUnlock()
+	return len(fake.readStartedArgsForCall)
+}
+
+func (fake *LimitMetrics) ReadStartedCalls(stub func()) {
+	fake.readStartedMutex.Lock()
+	defer fake.readStartedMutex.Unlock()
+	fake.ReadStartedStub = stub
+}
+
+func (fake *LimitMetrics) Rejected(arg1 pkg.LimitReason) {
+	fake.rejectedMutex.Lock()
+	fake.rejectedArgsForCall = append(fake.rejectedArgsForCall, struct {
+		arg1 pkg.LimitReason
+	}{arg1})
+	stub := fake.RejectedStub
+	fake.recordInvocation("Rejected", []interface{}{arg1})
+	fake.rejectedMutex.Unlock()
+	if stub != nil {
+		fake.RejectedStub(arg1)
+	}
+}
+
+func (fake *LimitMetrics) RejectedCallCount() int {
+	fake.rejectedMutex.RLock()
+	defer fake.rejectedMutex.RUnlock()
+	return len(fake.rejectedArgsForCall)
+}
+
+func (fake *LimitMetrics) RejectedCalls(stub func(pkg.LimitReason)) {
+	fake.rejectedMutex.Lock()
+	defer fake.rejectedMutex.Unlock()
+	fake.RejectedStub = stub
+}
+
+func (fake *LimitMetrics) RejectedArgsForCall(i int) pkg.LimitReason {
+	fake.rejectedMutex.RLock()
+	defer fake.rejectedMutex.RUnlock()
+	argsForCall := fake.rejectedArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *LimitMetrics) SetLimits(arg1 float64, arg2 int, arg3 int) {
+	fake.setLimitsMutex.Lock()
+	fake.setLimitsArgsForCall = append(fake.setLimitsArgsForCall, struct {
+		arg1 float64
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.SetLimitsStub
+	fake.recordInvocation("SetLimits", []interface{}{arg1, arg2, arg3})
+	fake.setLimitsMutex.Unlock()
+	if stub != nil {
+		fake.SetLimitsStub(arg1, arg2, arg3)
+	}
+}
+
+func (fake *LimitMetrics) SetLimitsCallCount() int {
+	fake.setLimitsMutex.RLock()
+	defer fake.setLimitsMutex.RUnlock()
+	return len(fake.setLimitsArgsForCall)
+}
+
+func (fake *LimitMetrics) SetLimitsCalls(stub func(float64, int, int)) {
+	fake.setLimitsMutex.Lock()
+	defer fake.setLimitsMutex.Unlock()
+	fake.SetLimitsStub = stub
+}
+
+func (fake *LimitMetrics) SetLimitsArgsForCall(i int) (float64, int, int) {
+	fake.setLimitsMutex.RLock()
+	defer fake.setLimitsMutex.RUnlock()
+	argsForCall := fake.setLimitsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *LimitMetrics) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *LimitMetrics) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pkg.LimitMetrics = new(LimitMetrics)
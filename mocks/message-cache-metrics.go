@@ -0,0 +1,158 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+type MessageCacheMetrics struct {
+	BytesChangedStub        func(int64)
+	bytesChangedMutex       sync.RWMutex
+	bytesChangedArgsForCall []struct {
+		arg1 int64
+	}
+	EvictedStub        func()
+	evictedMutex       sync.RWMutex
+	evictedArgsForCall []struct {
+	}
+	HitStub        func()
+	hitMutex       sync.RWMutex
+	hitArgsForCall []struct {
+	}
+	MissStub        func()
+	missMutex       sync.RWMutex
+	missArgsForCall []struct {
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *MessageCacheMetrics) BytesChanged(arg1 int64) {
+	fake.bytesChangedMutex.Lock()
+	fake.bytesChangedArgsForCall = append(fake.bytesChangedArgsForCall, struct {
+		arg1 int64
+	}{arg1})
+	stub := fake.BytesChangedStub
+	fake.recordInvocation("BytesChanged", []interface{}{arg1})
+	fake.bytesChangedMutex.Unlock()
+	if stub != nil {
+		fake.BytesChangedStub(arg1)
+	}
+}
+
+func (fake *MessageCacheMetrics) BytesChangedCallCount() int {
+	fake.bytesChangedMutex.RLock()
+	defer fake.bytesChangedMutex.RUnlock()
+	return len(fake.bytesChangedArgsForCall)
+}
+
+func (fake *MessageCacheMetrics) BytesChangedCalls(stub func(int64)) {
+	fake.bytesChangedMutex.Lock()
+	defer fake.bytesChangedMutex.Unlock()
+	fake.BytesChangedStub = stub
+}
+
+func (fake *MessageCacheMetrics) BytesChangedArgsForCall(i int) int64 {
+	fake.bytesChangedMutex.RLock()
+	defer fake.bytesChangedMutex.RUnlock()
+	argsForCall := fake.bytesChangedArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *MessageCacheMetrics) Evicted() {
+	fake.evictedMutex.Lock()
+	fake.evictedArgsForCall = append(fake.evictedArgsForCall, struct {
+	}{})
+	stub := fake.EvictedStub
+	fake.recordInvocation("Evicted", []interface{}{})
+	fake.evictedMutex.Unlock()
+	if stub != nil {
+		fake.EvictedStub()
+	}
+}
+
+func (fake *MessageCacheMetrics) EvictedCallCount() int {
+	fake.evictedMutex.RLock()
+	defer fake.evictedMutex.RUnlock()
+	return len(fake.evictedArgsForCall)
+}
+
+func (fake *MessageCacheMetrics) EvictedCalls(stub func()) {
+	fake.evictedMutex.Lock()
+	defer fake.evictedMutex.Unlock()
+	fake.EvictedStub = stub
+}
+
+func (fake *MessageCacheMetrics) Hit() {
+	fake.hitMutex.Lock()
+	fake.hitArgsForCall = append(fake.hitArgsForCall, struct {
+	}{})
+	stub := fake.HitStub
+	fake.recordInvocation("Hit", []interface{}{})
+	fake.hitMutex.Unlock()
+	if stub != nil {
+		fake.HitStub()
+	}
+}
+
+func (fake *MessageCacheMetrics) HitCallCount() int {
+	fake.hitMutex.RLock()
+	defer fake.hitMutex.RUnlock()
+	return len(fake.hitArgsForCall)
+}
+
+func (fake *MessageCacheMetrics) HitCalls(stub func()) {
+	fake.hitMutex.Lock()
+	defer fake.hitMutex.Unlock()
+	fake.HitStub = stub
+}
+
+func (fake *MessageCacheMetrics) Miss() {
+	fake.missMutex.Lock()
+	fake.missArgsForCall = append(fake.missArgsForCall, struct {
+	}{})
+	stub := fake.MissStub
+	fake.recordInvocation("Miss", []interface{}{})
+	fake.missMutex.Unlock()
+	if stub != nil {
+		fake.MissStub()
+	}
+}
+
+func (fake *MessageCacheMetrics) MissCallCount() int {
+	fake.missMutex.RLock()
+	defer fake.missMutex.RUnlock()
+	return len(fake.missArgsForCall)
+}
+
+func (fake *MessageCacheMetrics) MissCalls(stub func()) {
+	fake.missMutex.Lock()
+	defer fake.missMutex.Unlock()
+	fake.MissStub = stub
+}
+
+func (fake *MessageCacheMetrics) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *MessageCacheMetrics) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pkg.MessageCacheMetrics = new(MessageCacheMetrics)
@@ -0,0 +1,201 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/IBM/sarama"
+	"github.com/bborbe/kafka"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+type MessageCache struct {
+	GetStub        func(kafka.Topic, kafka.Partition, kafka.Offset) ([]*sarama.ConsumerMessage, kafka.Offset, bool)
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+		arg1 kafka.Topic
+		arg2 kafka.Partition
+		arg3 kafka.Offset
+	}
+	getReturns struct {
+		result1 []*sarama.ConsumerMessage
+		result2 kafka.Offset
+		result3 bool
+	}
+	getReturnsOnCall map[int]struct {
+		result1 []*sarama.ConsumerMessage
+		result2 kafka.Offset
+		result3 bool
+	}
+	WriterStub        func(kafka.Topic, kafka.Partition, kafka.Offset) pkg.MessageCacheWriter
+	writerMutex       sync.RWMutex
+	writerArgsForCall []struct {
+		arg1 kafka.Topic
+		arg2 kafka.Partition
+		arg3 kafka.Offset
+	}
+	writerReturns struct {
+		result1 pkg.MessageCacheWriter
+	}
+	writerReturnsOnCall map[int]struct {
+		result1 pkg.MessageCacheWriter
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *MessageCache) Get(arg1 kafka.Topic, arg2 kafka.Partition, arg3 kafka.Offset) ([]*sarama.ConsumerMessage, kafka.Offset, bool) {
+	fake.getMutex.Lock()
+	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 kafka.Topic
+		arg2 kafka.Partition
+		arg3 kafka.Offset
+	}{arg1, arg2, arg3})
+	stub := fake.GetStub
+	fakeReturns := fake.getReturns
+	fake.recordInvocation("Get", []interface{}{arg1, arg2, arg3})
+	fake.getMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *MessageCache) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *MessageCache) GetCalls(stub func(kafka.Topic, kafka.Partition, kafka.Offset) ([]*sarama.ConsumerMessage, kafka.Offset, bool)) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = stub
+}
+
+func (fake *MessageCache) GetArgsForCall(i int) (kafka.Topic, kafka.Partition, kafka.Offset) {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	argsForCall := fake.getArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *MessageCache) GetReturns(result1 []*sarama.ConsumerMessage, result2 kafka.Offset, result3 bool) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 []*sarama.ConsumerMessage
+		result2 kafka.Offset
+		result3 bool
+	}{result1, result2, result3}
+}
+
+func (fake *MessageCache) GetReturnsOnCall(i int, result1 []*sarama.ConsumerMessage, result2 kafka.Offset, result3 bool) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 []*sarama.ConsumerMessage
+			result2 kafka.Offset
+			result3 bool
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 []*sarama.ConsumerMessage
+		result2 kafka.Offset
+		result3 bool
+	}{result1, result2, result3}
+}
+
+func (fake *MessageCache) Writer(arg1 kafka.Topic, arg2 kafka.Partition, arg3 kafka.Offset) pkg.MessageCacheWriter {
+	fake.writerMutex.Lock()
+	ret, specificReturn := fake.writerReturnsOnCall[len(fake.writerArgsForCall)]
+	fake.writerArgsForCall = append(fake.writerArgsForCall, struct {
+		arg1 kafka.Topic
+		arg2 kafka.Partition
+		arg3 kafka.Offset
+	}{arg1, arg2, arg3})
+	stub := fake.WriterStub
+	fakeReturns := fake.writerReturns
+	fake.recordInvocation("Writer", []interface{}{arg1, arg2, arg3})
+	fake.writerMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *MessageCache) WriterCallCount() int {
+	fake.writerMutex.RLock()
+	defer fake.writerMutex.RUnlock()
+	return len(fake.writerArgsForCall)
+}
+
+func (fake *MessageCache) WriterCalls(stub func(kafka.Topic, kafka.Partition, kafka.Offset) pkg.MessageCacheWriter) {
+	fake.writerMutex.Lock()
+	defer fake.writerMutex.Unlock()
+	fake.WriterStub = stub
+}
+
+func (fake *MessageCache) WriterArgsForCall(i int) (kafka.Topic, kafka.Partition, kafka.Offset) {
+	fake.writerMutex.RLock()
+	defer fake.writerMutex.RUnlock()
+	argsForCall := fake.writerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *MessageCache) WriterReturns(result1 pkg.MessageCacheWriter) {
+	fake.writerMutex.Lock()
+	defer fake.writerMutex.Unlock()
+	fake.WriterStub = nil
+	fake.writerReturns = struct {
+		result1 pkg.MessageCacheWriter
+	}{result1}
+}
+
+func (fake *MessageCache) WriterReturnsOnCall(i int, result1 pkg.MessageCacheWriter) {
+	fake.writerMutex.Lock()
+	defer fake.writerMutex.Unlock()
+	fake.WriterStub = nil
+	if fake.writerReturnsOnCall == nil {
+		fake.writerReturnsOnCall = make(map[int]struct {
+			result1 pkg.MessageCacheWriter
+		})
+	}
+	fake.writerReturnsOnCall[i] = struct {
+		result1 pkg.MessageCacheWriter
+	}{result1}
+}
+
+func (fake *MessageCache) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *MessageCache) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pkg.MessageCache = new(MessageCache)
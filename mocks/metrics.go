@@ -0,0 +1,277 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"sync"
+	"time"
+
+	"github.com/bborbe/kafka"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+type Metrics struct {
+	BytesReadStub        func(kafka.Topic, int)
+	bytesReadMutex       sync.RWMutex
+	bytesReadArgsForCall []struct {
+		arg1 kafka.Topic
+		arg2 int
+	}
+	ConversionFailedStub        func(kafka.Topic, pkg.ConversionFailureReason)
+	conversionFailedMutex       sync.RWMutex
+	conversionFailedArgsForCall []struct {
+		arg1 kafka.Topic
+		arg2 pkg.ConversionFailureReason
+	}
+	FilterCheckedStub        func(kafka.Topic, bool)
+	filterCheckedMutex       sync.RWMutex
+	filterCheckedArgsForCall []struct {
+		arg1 kafka.Topic
+		arg2 bool
+	}
+	FirstRecordStub        func(kafka.Topic, time.Duration)
+	firstRecordMutex       sync.RWMutex
+	firstRecordArgsForCall []struct {
+		arg1 kafka.Topic
+		arg2 time.Duration
+	}
+	ReadCompletedStub        func(kafka.Topic, pkg.ReadStatus, time.Duration)
+	readCompletedMutex       sync.RWMutex
+	readCompletedArgsForCall []struct {
+		arg1 kafka.Topic
+		arg2 pkg.ReadStatus
+		arg3 time.Duration
+	}
+	RecordsReadStub        func(kafka.Topic, uint64, int)
+	recordsReadMutex       sync.RWMutex
+	recordsReadArgsForCall []struct {
+		arg1 kafka.Topic
+		arg2 uint64
+		arg3 int
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *Metrics) BytesRead(arg1 kafka.Topic, arg2 int) {
+	fake.bytesReadMutex.Lock()
+	fake.bytesReadArgsForCall = append(fake.bytesReadArgsForCall, struct {
+		arg1 kafka.Topic
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.BytesReadStub
+	fake.recordInvocation("BytesRead", []interface{}{arg1, arg2})
+	fake.bytesReadMutex.Unlock()
+	if stub != nil {
+		fake.BytesReadStub(arg1, arg2)
+	}
+}
+
+func (fake *Metrics) BytesReadCallCount() int {
+	fake.bytesReadMutex.RLock()
+	defer fake.bytesReadMutex.RUnlock()
+	return len(fake.bytesReadArgsForCall)
+}
+
+func (fake *Metrics) BytesReadCalls(stub func(kafka.Topic, int)) {
+	fake.bytesReadMutex.Lock()
+	defer fake.bytesReadMutex.Unlock()
+	fake.BytesReadStub = stub
+}
+
+func (fake *Metrics) BytesReadArgsForCall(i int) (kafka.Topic, int) {
+	fake.bytesReadMutex.RLock()
+	defer fake.bytesReadMutex.RUnlock()
+	argsForCall := fake.bytesReadArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Metrics) ConversionFailed(arg1 kafka.Topic, arg2 pkg.ConversionFailureReason) {
+	fake.conversionFailedMutex.Lock()
+	fake.conversionFailedArgsForCall = append(fake.conversionFailedArgsForCall, struct {
+		arg1 kafka.Topic
+		arg2 pkg.ConversionFailureReason
+	}{arg1, arg2})
+	stub := fake.ConversionFailedStub
+	fake.recordInvocation("ConversionFailed", []interface{}{arg1, arg2})
+	fake.conversionFailedMutex.Unlock()
+	if stub != nil {
+		fake.ConversionFailedStub(arg1, arg2)
+	}
+}
+
+func (fake *Metrics) ConversionFailedCallCount() int {
+	fake.conversionFailedMutex.RLock()
+	defer fake.conversionFailedMutex.RUnlock()
+	return len(fake.conversionFailedArgsForCall)
+}
+
+func (fake *Metrics) ConversionFailedCalls(stub func(kafka.Topic, pkg.ConversionFailureReason)) {
+	fake.conversionFailedMutex.Lock()
+	defer fake.conversionFailedMutex.Unlock()
+	fake.ConversionFailedStub = stub
+}
+
+func (fake *Metrics) ConversionFailedArgsForCall(i int) (kafka.Topic, pkg.ConversionFailureReason) {
+	fake.conversionFailedMutex.RLock()
+	defer fake.conversionFailedMutex.RUnlock()
+	argsForCall := fake.conversionFailedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Metrics) FilterChecked(arg1 kafka.Topic, arg2 bool) {
+	fake.filterCheckedMutex.Lock()
+	fake.filterCheckedArgsForCall = append(fake.filterCheckedArgsForCall, struct {
+		arg1 kafka.Topic
+		arg2 bool
+	}{arg1, arg2})
+	stub := fake.FilterCheckedStub
+	fake.recordInvocation("FilterChecked", []interface{}{arg1, arg2})
+	fake.filterCheckedMutex.Unlock()
+	if stub != nil {
+		fake.FilterCheckedStub(arg1, arg2)
+	}
+}
+
+func (fake *Metrics) FilterCheckedCallCount() int {
+	fake.filterCheckedMutex.RLock()
+	defer fake.filterCheckedMutex.RUnlock()
+	return len(fake.filterCheckedArgsForCall)
+}
+
+func (fake *Metrics) FilterCheckedCalls(stub func(kafka.Topic, bool)) {
+	fake.filterCheckedMutex.Lock()
+	defer fake.filterCheckedMutex.Unlock()
+	fake.FilterCheckedStub = stub
+}
+
+func (fake *Metrics) FilterCheckedArgsForCall(i int) (kafka.Topic, bool) {
+	fake.filterCheckedMutex.RLock()
+	defer fake.filterCheckedMutex.RUnlock()
+	argsForCall := fake.filterCheckedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Metrics) FirstRecord(arg1 kafka.Topic, arg2 time.Duration) {
+	fake.firstRecordMutex.Lock()
+	fake.firstRecordArgsForCall = append(fake.firstRecordArgsForCall, struct {
+		arg1 kafka.Topic
+		arg2 time.Duration
+	}{arg1, arg2})
+	stub := fake.FirstRecordStub
+	fake.recordInvocation("FirstRecord", []interface{}{arg1, arg2})
+	fake.firstRecordMutex.Unlock()
+	if stub != nil {
+		fake.FirstRecordStub(arg1, arg2)
+	}
+}
+
+func (fake *Metrics) FirstRecordCallCount() int {
+	fake.firstRecordMutex.RLock()
+	defer fake.firstRecordMutex.RUnlock()
+	return len(fake.firstRecordArgsForCall)
+}
+
+func (fake *Metrics) FirstRecordCalls(stub func(kafka.Topic, time.Duration)) {
+	fake.firstRecordMutex.Lock()
+	defer fake.firstRecordMutex.Unlock()
+	fake.FirstRecordStub = stub
+}
+
+func (fake *Metrics) FirstRecordArgsForCall(i int) (kafka.Topic, time.Duration) {
+	fake.firstRecordMutex.RLock()
+	defer fake.firstRecordMutex.RUnlock()
+	argsForCall := fake.firstRecordArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Metrics) ReadCompleted(arg1 kafka.Topic, arg2 pkg.ReadStatus, arg3 time.Duration) {
+	fake.readCompletedMutex.Lock()
+	fake.readCompletedArgsForCall = append(fake.readCompletedArgsForCall, struct {
+		arg1 kafka.Topic
+		arg2 pkg.ReadStatus
+		arg3 time.Duration
+	}{arg1, arg2, arg3})
+	stub := fake.ReadCompletedStub
+	fake.recordInvocation("ReadCompleted", []interface{}{arg1, arg2, arg3})
+	fake.readCompletedMutex.Unlock()
+	if stub != nil {
+		fake.ReadCompletedStub(arg1, arg2, arg3)
+	}
+}
+
+func (fake *Metrics) ReadCompletedCallCount() int {
+	fake.readCompletedMutex.RLock()
+	defer fake.readCompletedMutex.RUnlock()
+	return len(fake.readCompletedArgsForCall)
+}
+
+func (fake *Metrics) ReadCompletedCalls(stub func(kafka.Topic, pkg.ReadStatus, time.Duration)) {
+	fake.readCompletedMutex.Lock()
+	defer fake.readCompletedMutex.Unlock()
+	fake.ReadCompletedStub = stub
+}
+
+func (fake *Metrics) ReadCompletedArgsForCall(i int) (kafka.Topic, pkg.ReadStatus, time.Duration) {
+	fake.readCompletedMutex.RLock()
+	defer fake.readCompletedMutex.RUnlock()
+	argsForCall := fake.readCompletedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *Metrics) RecordsRead(arg1 kafka.Topic, arg2 uint64, arg3 int) {
+	fake.recordsReadMutex.Lock()
+	fake.recordsReadArgsForCall = append(fake.recordsReadArgsForCall, struct {
+		arg1 kafka.Topic
+		arg2 uint64
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.RecordsReadStub
+	fake.recordInvocation("RecordsRead", []interface{}{arg1, arg2, arg3})
+	fake.recordsReadMutex.Unlock()
+	if stub != nil {
+		fake.RecordsReadStub(arg1, arg2, arg3)
+	}
+}
+
+func (fake *Metrics) RecordsReadCallCount() int {
+	fake.recordsReadMutex.RLock()
+	defer fake.recordsReadMutex.RUnlock()
+	return len(fake.recordsReadArgsForCall)
+}
+
+func (fake *Metrics) RecordsReadCalls(stub func(kafka.Topic, uint64, int)) {
+	fake.recordsReadMutex.Lock()
+	defer fake.recordsReadMutex.Unlock()
+	fake.RecordsReadStub = stub
+}
+
+func (fake *Metrics) RecordsReadArgsForCall(i int) (kafka.Topic, uint64, int) {
+	fake.recordsReadMutex.RLock()
+	defer fake.recordsReadMutex.RUnlock()
+	argsForCall := fake.recordsReadArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *Metrics) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *Metrics) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pkg.Metrics = new(Metrics)
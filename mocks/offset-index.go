@@ -0,0 +1,665 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/bborbe/kafka"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+type OffsetIndex struct {
+	AddStub        func(context.Context, kafka.Topic, kafka.Partition, []pkg.IndexEntry) error
+	addMutex       sync.RWMutex
+	addArgsForCall []struct {
+		arg1 context.Context
+		arg2 kafka.Topic
+		arg3 kafka.Partition
+		arg4 []pkg.IndexEntry
+	}
+	addReturns struct {
+		result1 error
+	}
+	addReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CloseStub        func() error
+	closeMutex       sync.RWMutex
+	closeArgsForCall []struct {
+	}
+	closeReturns struct {
+		result1 error
+	}
+	closeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CompactStub        func(context.Context) error
+	compactMutex       sync.RWMutex
+	compactArgsForCall []struct {
+		arg1 context.Context
+	}
+	compactReturns struct {
+		result1 error
+	}
+	compactReturnsOnCall map[int]struct {
+		result1 error
+	}
+	KeyOffsetsStub        func(context.Context, kafka.Topic, []byte, int) ([]pkg.IndexedOffset, error)
+	keyOffsetsMutex       sync.RWMutex
+	keyOffsetsArgsForCall []struct {
+		arg1 context.Context
+		arg2 kafka.Topic
+		arg3 []byte
+		arg4 int
+	}
+	keyOffsetsReturns struct {
+		result1 []pkg.IndexedOffset
+		result2 error
+	}
+	keyOffsetsReturnsOnCall map[int]struct {
+		result1 []pkg.IndexedOffset
+		result2 error
+	}
+	ProgressStub        func(context.Context, kafka.Topic) ([]pkg.IndexProgress, error)
+	progressMutex       sync.RWMutex
+	progressArgsForCall []struct {
+		arg1 context.Context
+		arg2 kafka.Topic
+	}
+	progressReturns struct {
+		result1 []pkg.IndexProgress
+		result2 error
+	}
+	progressReturnsOnCall map[int]struct {
+		result1 []pkg.IndexProgress
+		result2 error
+	}
+	PruneStub        func(context.Context, kafka.Topic, kafka.Partition, kafka.Offset) error
+	pruneMutex       sync.RWMutex
+	pruneArgsForCall []struct {
+		arg1 context.Context
+		arg2 kafka.Topic
+		arg3 kafka.Partition
+		arg4 kafka.Offset
+	}
+	pruneReturns struct {
+		result1 error
+	}
+	pruneReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RemoveStub        func(context.Context, kafka.Topic) error
+	removeMutex       sync.RWMutex
+	removeArgsForCall []struct {
+		arg1 context.Context
+		arg2 kafka.Topic
+	}
+	removeReturns struct {
+		result1 error
+	}
+	removeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	TimeOffsetStub        func(context.Context, kafka.Topic, kafka.Partition, time.Time) (kafka.Offset, bool, error)
+	timeOffsetMutex       sync.RWMutex
+	timeOffsetArgsForCall []struct {
+		arg1 context.Context
+		arg2 kafka.Topic
+		arg3 kafka.Partition
+		arg4 time.Time
+	}
+	timeOffsetReturns struct {
+		result1 kafka.Offset
+		result2 bool
+		result3 error
+	}
+	timeOffsetReturnsOnCall map[int]struct {
+		result1 kafka.Offset
+		result2 bool
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *OffsetIndex) Add(arg1 context.Context, arg2 kafka.Topic, arg3 kafka.Partition, arg4 []pkg.IndexEntry) error {
+	var arg4Copy []pkg.IndexEntry
+	if arg4 != nil {
+		arg4Copy = make([]pkg.IndexEntry, len(arg4))
+		copy(arg4Copy, arg4)
+	}
+	fake.addMutex.Lock()
+	ret, specificReturn := fake.addReturnsOnCall[len(fake.addArgsForCall)]
+	fake.addArgsForCall = append(fake.addArgsForCall, struct {
+		arg1 context.Context
+		arg2 kafka.Topic
+		arg3 kafka.Partition
+		arg4 []pkg.IndexEntry
+	}{arg1, arg2, arg3, arg4Copy})
+	stub := fake.AddStub
+	fakeReturns := fake.addReturns
+	fake.recordInvocation("Add", []interface{}{arg1, arg2, arg3, arg4Copy})
+	fake.addMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *OffsetIndex) AddCallCount() int {
+	fake.addMutex.RLock()
+	defer fake.addMutex.RUnlock()
+	return len(fake.addArgsForCall)
+}
+
+func (fake *OffsetIndex) AddCalls(stub func(context.Context, kafka.Topic, kafka.Partition, []pkg.IndexEntry) error) {
+	fake.addMutex.Lock()
+	defer fake.addMutex.Unlock()
+	fake.AddStub = stub
+}
+
+func (fake *OffsetIndex) AddArgsForCall(i int) (context.Context, kafka.Topic, kafka.Partition, []pkg.IndexEntry) {
+	fake.addMutex.RLock()
+	defer fake.addMutex.RUnlock()
+	argsForCall := fake.addArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *OffsetIndex) AddReturns(result1 error) {
+	fake.addMutex.Lock()
+	defer fake.addMutex.Unlock()
+	fake.AddStub = nil
+	fake.addReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *OffsetIndex) AddReturnsOnCall(i int, result1 error) {
+	fake.addMutex.Lock()
+	defer fake.addMutex.Unlock()
+	fake.AddStub = nil
+	if fake.addReturnsOnCall == nil {
+		fake.addReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *OffsetIndex) Close() error {
+	fake.closeMutex.Lock()
+	ret, specificReturn := fake.closeReturnsOnCall[len(fake.closeArgsForCall)]
+	fake.closeArgsForCall = append(fake.closeArgsForCall, struct {
+	}{})
+	stub := fake.CloseStub
+	fakeReturns := fake.closeReturns
+	fake.recordInvocation("Close", []interface{}{})
+	fake.closeMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *OffsetIndex) CloseCallCount() int {
+	fake.closeMutex.RLock()
+	defer fake.closeMutex.RUnlock()
+	return len(fake.closeArgsForCall)
+}
+
+func (fake *OffsetIndex) CloseCalls(stub func() error) {
+	fake.closeMutex.Lock()
+	defer fake.closeMutex.Unlock()
+	fake.CloseStub = stub
+}
+
+func (fake *OffsetIndex) CloseReturns(result1 error) {
+	fake.closeMutex.Lock()
+	defer fake.closeMutex.Unlock()
+	fake.CloseStub = nil
+	fake.closeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *OffsetIndex) CloseReturnsOnCall(i int, result1 error) {
+	fake.closeMutex.Lock()
+	defer fake.closeMutex.Unlock()
+	fake.CloseStub = nil
+	if fake.closeReturnsOnCall == nil {
+		fake.closeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.closeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *OffsetIndex) Compact(arg1 context.Context) error {
+	fake.compactMutex.Lock()
+	ret, specificReturn := fake.compactReturnsOnCall[len(fake.compactArgsForCall)]
+	fake.compactArgsForCall = append(fake.compactArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.CompactStub
+	fakeReturns := fake.compactReturns
+	fake.recordInvocation("Compact", []interface{}{arg1})
+	fake.compactMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *OffsetIndex) CompactCallCount() int {
+	fake.compactMutex.RLock()
+	defer fake.compactMutex.RUnlock()
+	return len(fake.compactArgsForCall)
+}
+
+func (fake *OffsetIndex) CompactCalls(stub func(context.Context) error) {
+	fake.compactMutex.Lock()
+	defer fake.compactMutex.Unlock()
+	fake.CompactStub = stub
+}
+
+func (fake *OffsetIndex) CompactArgsForCall(i int) context.Context {
+	fake.compactMutex.RLock()
+	defer fake.compactMutex.RUnlock()
+	argsForCall := fake.compactArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *OffsetIndex) CompactReturns(result1 error) {
+	fake.compactMutex.Lock()
+	defer fake.compactMutex.Unlock()
+	fake.CompactStub = nil
+	fake.compactReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *OffsetIndex) CompactReturnsOnCall(i int, result1 error) {
+	fake.compactMutex.Lock()
+	defer fake.compactMutex.Unlock()
+	fake.CompactStub = nil
+	if fake.compactReturnsOnCall == nil {
+		fake.compactReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.compactReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *OffsetIndex) KeyOffsets(arg1 context.Context, arg2 kafka.Topic, arg3 []byte, arg4 int) ([]pkg.IndexedOffset, error) {
+	var arg3Copy []byte
+	if arg3 != nil {
+		arg3Copy = make([]byte, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.keyOffsetsMutex.Lock()
+	ret, specificReturn := fake.keyOffsetsReturnsOnCall[len(fake.keyOffsetsArgsForCall)]
+	fake.keyOffsetsArgsForCall = append(fake.keyOffsetsArgsForCall, struct {
+		arg1 context.Context
+		arg2 kafka.Topic
+		arg3 []byte
+		arg4 int
+	}{arg1, arg2, arg3Copy, arg4})
+	stub := fake.KeyOffsetsStub
+	fakeReturns := fake.keyOffsetsReturns
+	fake.recordInvocation("KeyOffsets", []interface{}{arg1, arg2, arg3Copy, arg4})
+	fake.keyOffsetsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *OffsetIndex) KeyOffsetsCallCount() int {
+	fake.keyOffsetsMutex.RLock()
+	defer fake.keyOffsetsMutex.RUnlock()
+	return len(fake.keyOffsetsArgsForCall)
+}
+
+func (fake *OffsetIndex) KeyOffsetsCalls(stub func(context.Context, kafka.Topic, []byte, int) ([]pkg.IndexedOffset, error)) {
+	fake.keyOffsetsMutex.Lock()
+	defer fake.keyOffsetsMutex.Unlock()
+	fake.KeyOffsetsStub = stub
+}
+
+func (fake *OffsetIndex) KeyOffsetsArgsForCall(i int) (context.Context, kafka.Topic, []byte, int) {
+	fake.keyOffsetsMutex.RLock()
+	defer fake.keyOffsetsMutex.RUnlock()
+	argsForCall := fake.keyOffsetsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *OffsetIndex) KeyOffsetsReturns(result1 []pkg.IndexedOffset, result2 error) {
+	fake.keyOffsetsMutex.Lock()
+	defer fake.keyOffsetsMutex.Unlock()
+	fake.KeyOffsetsStub = nil
+	fake.keyOffsetsReturns = struct {
+		result1 []pkg.IndexedOffset
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *OffsetIndex) KeyOffsetsReturnsOnCall(i int, result1 []pkg.IndexedOffset, result2 error) {
+	fake.keyOffsetsMutex.Lock()
+	defer fake.keyOffsetsMutex.Unlock()
+	fake.KeyOffsetsStub = nil
+	if fake.keyOffsetsReturnsOnCall == nil {
+		fake.keyOffsetsReturnsOnCall = make(map[int]struct {
+			result1 []pkg.IndexedOffset
+			result2 error
+		})
+	}
+	fake.keyOffsetsReturnsOnCall[i] = struct {
+		result1 []pkg.IndexedOffset
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *OffsetIndex) Progress(arg1 context.Context, arg2 kafka.Topic) ([]pkg.IndexProgress, error) {
+	fake.progressMutex.Lock()
+	ret, specificReturn := fake.progressReturnsOnCall[len(fake.progressArgsForCall)]
+	fake.progressArgsForCall = append(fake.progressArgsForCall, struct {
+		arg1 context.Context
+		arg2 kafka.Topic
+	}{arg1, arg2})
+	stub := fake.ProgressStub
+	fakeReturns := fake.progressReturns
+	fake.recordInvocation("Progress", []interface{}{arg1, arg2})
+	fake.progressMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *OffsetIndex) ProgressCallCount() int {
+	fake.progressMutex.RLock()
+	defer fake.progressMutex.RUnlock()
+	return len(fake.progressArgsForCall)
+}
+
+func (fake *OffsetIndex) ProgressCalls(stub func(context.Context, kafka.Topic) ([]pkg.IndexProgress, error)) {
+	fake.progressMutex.Lock()
+	defer fake.progressMutex.Unlock()
+	fake.ProgressStub = stub
+}
+
+func (fake *OffsetIndex) ProgressArgsForCall(i int) (context.Context, kafka.Topic) {
+	fake.progressMutex.RLock()
+	defer fake.progressMutex.RUnlock()
+	argsForCall := fake.progressArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *OffsetIndex) ProgressReturns(result1 []pkg.IndexProgress, result2 error) {
+	fake.progressMutex.Lock()
+	defer fake.progressMutex.Unlock()
+	fake.ProgressStub = nil
+	fake.progressReturns = struct {
+		result1 []pkg.IndexProgress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *OffsetIndex) ProgressReturnsOnCall(i int, result1 []pkg.IndexProgress, result2 error) {
+	fake.progressMutex.Lock()
+	defer fake.progressMutex.Unlock()
+	fake.ProgressStub = nil
+	if fake.progressReturnsOnCall == nil {
+		fake.progressReturnsOnCall = make(map[int]struct {
+			result1 []pkg.IndexProgress
+			result2 error
+		})
+	}
+	fake.progressReturnsOnCall[i] = struct {
+		result1 []pkg.IndexProgress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *OffsetIndex) Prune(arg1 context.Context, arg2 kafka.Topic, arg3 kafka.Partition, arg4 kafka.Offset) error {
+	fake.pruneMutex.Lock()
+	ret, specificReturn := fake.pruneReturnsOnCall[len(fake.pruneArgsForCall)]
+	fake.pruneArgsForCall = append(fake.pruneArgsForCall, struct {
+		arg1 context.Context
+		arg2 kafka.Topic
+		arg3 kafka.Partition
+		arg4 kafka.Offset
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.PruneStub
+	fakeReturns := fake.pruneReturns
+	fake.recordInvocation("Prune", []interface{}{arg1, arg2, arg3, arg4})
+	fake.pruneMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *OffsetIndex) PruneCallCount() int {
+	fake.pruneMutex.RLock()
+	defer fake.pruneMutex.RUnlock()
+	return len(fake.pruneArgsForCall)
+}
+
+func (fake *OffsetIndex) PruneCalls(stub func(context.Context, kafka.Topic, kafka.Partition, kafka.Offset) error) {
+	fake.pruneMutex.Lock()
+	defer fake.pruneMutex.Unlock()
+	fake.PruneStub = stub
+}
+
+func (fake *OffsetIndex) PruneArgsForCall(i int) (context.Context, kafka.Topic, kafka.Partition, kafka.Offset) {
+	fake.pruneMutex.RLock()
+	defer fake.pruneMutex.RUnlock()
+	argsForCall := fake.pruneArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *OffsetIndex) PruneReturns(result1 error) {
+	fake.pruneMutex.Lock()
+	defer fake.pruneMutex.Unlock()
+	fake.PruneStub = nil
+	fake.pruneReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *OffsetIndex) PruneReturnsOnCall(i int, result1 error) {
+	fake.pruneMutex.Lock()
+	defer fake.pruneMutex.Unlock()
+	fake.PruneStub = nil
+	if fake.pruneReturnsOnCall == nil {
+		fake.pruneReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.pruneReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *OffsetIndex) Remove(arg1 context.Context, arg2 kafka.Topic) error {
+	fake.removeMutex.Lock()
+	ret, specificReturn := fake.removeReturnsOnCall[len(fake.removeArgsForCall)]
+	fake.removeArgsForCall = append(fake.removeArgsForCall, struct {
+		arg1 context.Context
+		arg2 kafka.Topic
+	}{arg1, arg2})
+	stub := fake.RemoveStub
+	fakeReturns := fake.removeReturns
+	fake.recordInvocation("Remove", []interface{}{arg1, arg2})
+	fake.removeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *OffsetIndex) RemoveCallCount() int {
+	fake.removeMutex.RLock()
+	defer fake.removeMutex.RUnlock()
+	return len(fake.removeArgsForCall)
+}
+
+func (fake *OffsetIndex) RemoveCalls(stub func(context.Context, kafka.Topic) error) {
+	fake.removeMutex.Lock()
+	defer fake.removeMutex.Unlock()
+	fake.RemoveStub = stub
+}
+
+func (fake *OffsetIndex) RemoveArgsForCall(i int) (context.Context, kafka.Topic) {
+	fake.removeMutex.RLock()
+	defer fake.removeMutex.RUnlock()
+	argsForCall := fake.removeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *OffsetIndex) RemoveReturns(result1 error) {
+	fake.removeMutex.Lock()
+	defer fake.removeMutex.Unlock()
+	fake.RemoveStub = nil
+	fake.removeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *OffsetIndex) RemoveReturnsOnCall(i int, result1 error) {
+	fake.removeMutex.Lock()
+	defer fake.removeMutex.Unlock()
+	fake.RemoveStub = nil
+	if fake.removeReturnsOnCall == nil {
+		fake.removeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *OffsetIndex) TimeOffset(arg1 context.Context, arg2 kafka.Topic, arg3 kafka.Partition, arg4 time.Time) (kafka.Offset, bool, error) {
+	fake.timeOffsetMutex.Lock()
+	ret, specificReturn := fake.timeOffsetReturnsOnCall[len(fake.timeOffsetArgsForCall)]
+	fake.timeOffsetArgsForCall = append(fake.timeOffsetArgsForCall, struct {
+		arg1 context.Context
+		arg2 kafka.Topic
+		arg3 kafka.Partition
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.TimeOffsetStub
+	fakeReturns := fake.timeOffsetReturns
+	fake.recordInvocation("TimeOffset", []interface{}{arg1, arg2, arg3, arg4})
+	fake.timeOffsetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *OffsetIndex) TimeOffsetCallCount() int {
+	fake.timeOffsetMutex.RLock()
+	defer fake.timeOffsetMutex.RUnlock()
+	return len(fake.timeOffsetArgsForCall)
+}
+
+func (fake *OffsetIndex) TimeOffsetCalls(stub func(context.Context, kafka.Topic, kafka.Partition, time.Time) (kafka.Offset, bool, error)) {
+	fake.timeOffsetMutex.Lock()
+	defer fake.timeOffsetMutex.Unlock()
+	fake.TimeOffsetStub = stub
+}
+
+func (fake *OffsetIndex) TimeOffsetArgsForCall(i int) (context.Context, kafka.Topic, kafka.Partition, time.Time) {
+	fake.timeOffsetMutex.RLock()
+	defer fake.timeOffsetMutex.RUnlock()
+	argsForCall := fake.timeOffsetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *OffsetIndex) TimeOffsetReturns(result1 kafka.Offset, result2 bool, result3 error) {
+	fake.timeOffsetMutex.Lock()
+	defer fake.timeOffsetMutex.Unlock()
+	fake.TimeOffsetStub = nil
+	fake.timeOffsetReturns = struct {
+		result1 kafka.Offset
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *OffsetIndex) TimeOffsetReturnsOnCall(i int, result1 kafka.Offset, result2 bool, result3 error) {
+	fake.timeOffsetMutex.Lock()
+	defer fake.timeOffsetMutex.Unlock()
+	fake.TimeOffsetStub = nil
+	if fake.timeOffsetReturnsOnCall == nil {
+		fake.timeOffsetReturnsOnCall = make(map[int]struct {
+			result1 kafka.Offset
+			result2 bool
+			result3 error
+		})
+	}
+	fake.timeOffsetReturnsOnCall[i] = struct {
+		result1 kafka.Offset
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *OffsetIndex) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *OffsetIndex) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pkg.OffsetIndex = new(OffsetIndex)
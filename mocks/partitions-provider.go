@@ -0,0 +1,118 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/bborbe/kafka"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+type PartitionsProvider struct {
+	PartitionsStub        func(context.Context, kafka.Topic) (pkg.PartitionInfos, error)
+	partitionsMutex       sync.RWMutex
+	partitionsArgsForCall []struct {
+		arg1 context.Context
+		arg2 kafka.Topic
+	}
+	partitionsReturns struct {
+		result1 pkg.PartitionInfos
+		result2 error
+	}
+	partitionsReturnsOnCall map[int]struct {
+		result1 pkg.PartitionInfos
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *PartitionsProvider) Partitions(arg1 context.Context, arg2 kafka.Topic) (pkg.PartitionInfos, error) {
+	fake.partitionsMutex.Lock()
+	ret, specificReturn := fake.partitionsReturnsOnCall[len(fake.partitionsArgsForCall)]
+	fake.partitionsArgsForCall = append(fake.partitionsArgsForCall, struct {
+		arg1 context.Context
+		arg2 kafka.Topic
+	}{arg1, arg2})
+	stub := fake.PartitionsStub
+	fakeReturns := fake.partitionsReturns
+	fake.recordInvocation("Partitions", []interface{}{arg1, arg2})
+	fake.partitionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *PartitionsProvider) PartitionsCallCount() int {
+	fake.partitionsMutex.RLock()
+	defer fake.partitionsMutex.RUnlock()
+	return len(fake.partitionsArgsForCall)
+}
+
+func (fake *PartitionsProvider) PartitionsCalls(stub func(context.Context, kafka.Topic) (pkg.PartitionInfos, error)) {
+	fake.partitionsMutex.Lock()
+	defer fake.partitionsMutex.Unlock()
+	fake.PartitionsStub = stub
+}
+
+func (fake *PartitionsProvider) PartitionsArgsForCall(i int) (context.Context, kafka.Topic) {
+	fake.partitionsMutex.RLock()
+	defer fake.partitionsMutex.RUnlock()
+	argsForCall := fake.partitionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *PartitionsProvider) PartitionsReturns(result1 pkg.PartitionInfos, result2 error) {
+	fake.partitionsMutex.Lock()
+	defer fake.partitionsMutex.Unlock()
+	fake.PartitionsStub = nil
+	fake.partitionsReturns = struct {
+		result1 pkg.PartitionInfos
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *PartitionsProvider) PartitionsReturnsOnCall(i int, result1 pkg.PartitionInfos, result2 error) {
+	fake.partitionsMutex.Lock()
+	defer fake.partitionsMutex.Unlock()
+	fake.PartitionsStub = nil
+	if fake.partitionsReturnsOnCall == nil {
+		fake.partitionsReturnsOnCall = make(map[int]struct {
+			result1 pkg.PartitionInfos
+			result2 error
+		})
+	}
+	fake.partitionsReturnsOnCall[i] = struct {
+		result1 pkg.PartitionInfos
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *PartitionsProvider) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *PartitionsProvider) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pkg.PartitionsProvider = new(PartitionsProvider)
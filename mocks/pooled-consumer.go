@@ -0,0 +1,144 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/IBM/sarama"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+type PooledConsumer struct {
+	NextStub        func(context.Context) (*sarama.ConsumerMessage, error)
+	nextMutex       sync.RWMutex
+	nextArgsForCall []struct {
+		arg1 context.Context
+	}
+	nextReturns struct {
+		result1 *sarama.ConsumerMessage
+		result2 error
+	}
+	nextReturnsOnCall map[int]struct {
+		result1 *sarama.ConsumerMessage
+		result2 error
+	}
+	ReleaseStub        func()
+	releaseMutex       sync.RWMutex
+	releaseArgsForCall []struct {
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *PooledConsumer) Next(arg1 context.Context) (*sarama.ConsumerMessage, error) {
+	fake.nextMutex.Lock()
+	ret, specificReturn := fake.nextReturnsOnCall[len(fake.nextArgsForCall)]
+	fake.nextArgsForCall = append(fake.nextArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.NextStub
+	fakeReturns := fake.nextReturns
+	fake.recordInvocation("Next", []interface{}{arg1})
+	fake.nextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *PooledConsumer) NextCallCount() int {
+	fake.nextMutex.RLock()
+	defer fake.nextMutex.RUnlock()
+	return len(fake.nextArgsForCall)
+}
+
+func (fake *PooledConsumer) NextCalls(stub func(context.Context) (*sarama.ConsumerMessage, error)) {
+	fake.nextMutex.Lock()
+	defer fake.nextMutex.Unlock()
+	fake.NextStub = stub
+}
+
+func (fake *PooledConsumer) NextArgsForCall(i int) context.Context {
+	fake.nextMutex.RLock()
+	defer fake.nextMutex.RUnlock()
+	argsForCall := fake.nextArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *PooledConsumer) NextReturns(result1 *sarama.ConsumerMessage, result2 error) {
+	fake.nextMutex.Lock()
+	defer fake.nextMutex.Unlock()
+	fake.NextStub = nil
+	fake.nextReturns = struct {
+		result1 *sarama.ConsumerMessage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *PooledConsumer) NextReturnsOnCall(i int, result1 *sarama.ConsumerMessage, result2 error) {
+	fake.nextMutex.Lock()
+	defer fake.nextMutex.Unlock()
+	fake.NextStub = nil
+	if fake.nextReturnsOnCall == nil {
+		fake.nextReturnsOnCall = make(map[int]struct {
+			result1 *sarama.ConsumerMessage
+			result2 error
+		})
+	}
+	fake.nextReturnsOnCall[i] = struct {
+		result1 *sarama.ConsumerMessage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *PooledConsumer) Release() {
+	fake.releaseMutex.Lock()
+	fake.releaseArgsForCall = append(fake.releaseArgsForCall, struct {
+	}{})
+	stub := fake.ReleaseStub
+	fake.recordInvocation("Release", []interface{}{})
+	fake.releaseMutex.Unlock()
+	if stub != nil {
+		fake.ReleaseStub()
+	}
+}
+
+func (fake *PooledConsumer) ReleaseCallCount() int {
+	fake.releaseMutex.RLock()
+	defer fake.releaseMutex.RUnlock()
+	return len(fake.releaseArgsForCall)
+}
+
+func (fake *PooledConsumer) ReleaseCalls(stub func()) {
+	fake.releaseMutex.Lock()
+	defer fake.releaseMutex.Unlock()
+	fake.ReleaseStub = stub
+}
+
+func (fake *PooledConsumer) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *PooledConsumer) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pkg.PooledConsumer = new(PooledConsumer)
@@ -0,0 +1,147 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/bborbe/kafka"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+type Redactor struct {
+	RedactStub        func(*pkg.Record)
+	redactMutex       sync.RWMutex
+	redactArgsForCall []struct {
+		arg1 *pkg.Record
+	}
+	RedactsStub        func(kafka.Topic) bool
+	redactsMutex       sync.RWMutex
+	redactsArgsForCall []struct {
+		arg1 kafka.Topic
+	}
+	redactsReturns struct {
+		result1 bool
+	}
+	redactsReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *Redactor) Redact(arg1 *pkg.Record) {
+	fake.redactMutex.Lock()
+	fake.redactArgsForCall = append(fake.redactArgsForCall, struct {
+		arg1 *pkg.Record
+	}{arg1})
+	stub := fake.RedactStub
+	fake.recordInvocation("Redact", []interface{}{arg1})
+	fake.redactMutex.Unlock()
+	if stub != nil {
+		fake.RedactStub(arg1)
+	}
+}
+
+func (fake *Redactor) RedactCallCount() int {
+	fake.redactMutex.RLock()
+	defer fake.redactMutex.RUnlock()
+	return len(fake.redactArgsForCall)
+}
+
+func (fake *Redactor) RedactCalls(stub func(*pkg.Record)) {
+	fake.redactMutex.Lock()
+	defer fake.redactMutex.Unlock()
+	fake.RedactStub = stub
+}
+
+func (fake *Redactor) RedactArgsForCall(i int) *pkg.Record {
+	fake.redactMutex.RLock()
+	defer fake.redactMutex.RUnlock()
+	argsForCall := fake.redactArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Redactor) Redacts(arg1 kafka.Topic) bool {
+	fake.redactsMutex.Lock()
+	ret, specificReturn := fake.redactsReturnsOnCall[len(fake.redactsArgsForCall)]
+	fake.redactsArgsForCall = append(fake.redactsArgsForCall, struct {
+		arg1 kafka.Topic
+	}{arg1})
+	stub := fake.RedactsStub
+	fakeReturns := fake.redactsReturns
+	fake.recordInvocation("Redacts", []interface{}{arg1})
+	fake.redactsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Redactor) RedactsCallCount() int {
+	fake.redactsMutex.RLock()
+	defer fake.redactsMutex.RUnlock()
+	return len(fake.redactsArgsForCall)
+}
+
+func (fake *Redactor) RedactsCalls(stub func(kafka.Topic) bool) {
+	fake.redactsMutex.Lock()
+	defer fake.redactsMutex.Unlock()
+	fake.RedactsStub = stub
+}
+
+func (fake *Redactor) RedactsArgsForCall(i int) kafka.Topic {
+	fake.redactsMutex.RLock()
+	defer fake.redactsMutex.RUnlock()
+	argsForCall := fake.redactsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Redactor) RedactsReturns(result1 bool) {
+	fake.redactsMutex.Lock()
+	defer fake.redactsMutex.Unlock()
+	fake.RedactsStub = nil
+	fake.redactsReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *Redactor) RedactsReturnsOnCall(i int, result1 bool) {
+	fake.redactsMutex.Lock()
+	defer fake.redactsMutex.Unlock()
+	fake.RedactsStub = nil
+	if fake.redactsReturnsOnCall == nil {
+		fake.redactsReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.redactsReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *Redactor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *Redactor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pkg.Redactor = new(Redactor)
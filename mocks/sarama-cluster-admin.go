@@ -0,0 +1,2912 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/IBM/sarama"
+)
+
+type SaramaClusterAdmin struct {
+	AlterClientQuotasStub        func([]sarama.QuotaEntityComponent, sarama.ClientQuotasOp, bool) error
+	alterClientQuotasMutex       sync.RWMutex
+	alterClientQuotasArgsForCall []struct {
+		arg1 []sarama.QuotaEntityComponent
+		arg2 sarama.ClientQuotasOp
+		arg3 bool
+	}
+	alterClientQuotasReturns struct {
+		result1 error
+	}
+	alterClientQuotasReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AlterConfigStub        func(sarama.ConfigResourceType, string, map[string]*string, bool) error
+	alterConfigMutex       sync.RWMutex
+	alterConfigArgsForCall []struct {
+		arg1 sarama.ConfigResourceType
+		arg2 string
+		arg3 map[string]*string
+		arg4 bool
+	}
+	alterConfigReturns struct {
+		result1 error
+	}
+	alterConfigReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AlterConsumerGroupOffsetsStub        func(string, map[string]map[int32]sarama.OffsetAndMetadata, *sarama.AlterConsumerGroupOffsetsOptions) (*sarama.OffsetCommitResponse, error)
+	alterConsumerGroupOffsetsMutex       sync.RWMutex
+	alterConsumerGroupOffsetsArgsForCall []struct {
+		arg1 string
+		arg2 map[string]map[int32]sarama.OffsetAndMetadata
+		arg3 *sarama.AlterConsumerGroupOffsetsOptions
+	}
+	alterConsumerGroupOffsetsReturns struct {
+		result1 *sarama.OffsetCommitResponse
+		result2 error
+	}
+	alterConsumerGroupOffsetsReturnsOnCall map[int]struct {
+		result1 *sarama.OffsetCommitResponse
+		result2 error
+	}
+	AlterPartitionReassignmentsStub        func(string, [][]int32) error
+	alterPartitionReassignmentsMutex       sync.RWMutex
+	alterPartitionReassignmentsArgsForCall []struct {
+		arg1 string
+		arg2 [][]int32
+	}
+	alterPartitionReassignmentsReturns struct {
+		result1 error
+	}
+	alterPartitionReassignmentsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CloseStub        func() error
+	closeMutex       sync.RWMutex
+	closeArgsForCall []struct {
+	}
+	closeReturns struct {
+		result1 error
+	}
+	closeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ControllerStub        func() (*sarama.Broker, error)
+	controllerMutex       sync.RWMutex
+	controllerArgsForCall []struct {
+	}
+	controllerReturns struct {
+		result1 *sarama.Broker
+		result2 error
+	}
+	controllerReturnsOnCall map[int]struct {
+		result1 *sarama.Broker
+		result2 error
+	}
+	CoordinatorStub        func(string) (*sarama.Broker, error)
+	coordinatorMutex       sync.RWMutex
+	coordinatorArgsForCall []struct {
+		arg1 string
+	}
+	coordinatorReturns struct {
+		result1 *sarama.Broker
+		result2 error
+	}
+	coordinatorReturnsOnCall map[int]struct {
+		result1 *sarama.Broker
+		result2 error
+	}
+	CreateACLStub        func(sarama.Resource, sarama.Acl) error
+	createACLMutex       sync.RWMutex
+	createACLArgsForCall []struct {
+		arg1 sarama.Resource
+		arg2 sarama.Acl
+	}
+	createACLReturns struct {
+		result1 error
+	}
+	createACLReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateACLsStub        func([]*sarama.ResourceAcls) error
+	createACLsMutex       sync.RWMutex
+	createACLsArgsForCall []struct {
+		arg1 []*sarama.ResourceAcls
+	}
+	createACLsReturns struct {
+		result1 error
+	}
+	createACLsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreatePartitionsStub        func(string, int32, [][]int32, bool) error
+	createPartitionsMutex       sync.RWMutex
+	createPartitionsArgsForCall []struct {
+		arg1 string
+		arg2 int32
+		arg3 [][]int32
+		arg4 bool
+	}
+	createPartitionsReturns struct {
+		result1 error
+	}
+	createPartitionsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateTopicStub        func(string, *sarama.TopicDetail, bool) error
+	createTopicMutex       sync.RWMutex
+	createTopicArgsForCall []struct {
+		arg1 string
+		arg2 *sarama.TopicDetail
+		arg3 bool
+	}
+	createTopicReturns struct {
+		result1 error
+	}
+	createTopicReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteACLStub        func(sarama.AclFilter, bool) ([]sarama.MatchingAcl, error)
+	deleteACLMutex       sync.RWMutex
+	deleteACLArgsForCall []struct {
+		arg1 sarama.AclFilter
+		arg2 bool
+	}
+	deleteACLReturns struct {
+		result1 []sarama.MatchingAcl
+		result2 error
+	}
+	deleteACLReturnsOnCall map[int]struct {
+		result1 []sarama.MatchingAcl
+		result2 error
+	}
+	DeleteConsumerGroupStub        func(string) error
+	deleteConsumerGroupMutex       sync.RWMutex
+	deleteConsumerGroupArgsForCall []struct {
+		arg1 string
+	}
+	deleteConsumerGroupReturns struct {
+		result1 error
+	}
+	deleteConsumerGroupReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteConsumerGroupOffsetStub        func(string, string, int32) error
+	deleteConsumerGroupOffsetMutex       sync.RWMutex
+	deleteConsumerGroupOffsetArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 int32
+	}
+	deleteConsumerGroupOffsetReturns struct {
+		result1 error
+	}
+	deleteConsumerGroupOffsetReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteRecordsStub        func(string, map[int32]int64) error
+	deleteRecordsMutex       sync.RWMutex
+	deleteRecordsArgsForCall []struct {
+		arg1 string
+		arg2 map[int32]int64
+	}
+	deleteRecordsReturns struct {
+		result1 error
+	}
+	deleteRecordsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteTopicStub        func(string) error
+	deleteTopicMutex       sync.RWMutex
+	deleteTopicArgsForCall []struct {
+		arg1 string
+	}
+	deleteTopicReturns struct {
+		result1 error
+	}
+	deleteTopicReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteUserScramCredentialsStub        func([]sarama.AlterUserScramCredentialsDelete) ([]*sarama.AlterUserScramCredentialsResult, error)
+	deleteUserScramCredentialsMutex       sync.RWMutex
+	deleteUserScramCredentialsArgsForCall []struct {
+		arg1 []sarama.AlterUserScramCredentialsDelete
+	}
+	deleteUserScramCredentialsReturns struct {
+		result1 []*sarama.AlterUserScramCredentialsResult
+		result2 error
+	}
+	deleteUserScramCredentialsReturnsOnCall map[int]struct {
+		result1 []*sarama.AlterUserScramCredentialsResult
+		result2 error
+	}
+	DescribeClientQuotasStub        func([]sarama.QuotaFilterComponent, bool) ([]sarama.DescribeClientQuotasEntry, error)
+	describeClientQuotasMutex       sync.RWMutex
+	describeClientQuotasArgsForCall []struct {
+		arg1 []sarama.QuotaFilterComponent
+		arg2 bool
+	}
+	describeClientQuotasReturns struct {
+		result1 []sarama.DescribeClientQuotasEntry
+		result2 error
+	}
+	describeClientQuotasReturnsOnCall map[int]struct {
+		result1 []sarama.DescribeClientQuotasEntry
+		result2 error
+	}
+	DescribeClusterStub        func() ([]*sarama.Broker, int32, error)
+	describeClusterMutex       sync.RWMutex
+	describeClusterArgsForCall []struct {
+	}
+	describeClusterReturns struct {
+		result1 []*sarama.Broker
+		result2 int32
+		result3 error
+	}
+	describeClusterReturnsOnCall map[int]struct {
+		result1 []*sarama.Broker
+		result2 int32
+		result3 error
+	}
+	DescribeConfigStub        func(sarama.ConfigResource) ([]sarama.ConfigEntry, error)
+	describeConfigMutex       sync.RWMutex
+	describeConfigArgsForCall []struct {
+		arg1 sarama.ConfigResource
+	}
+	describeConfigReturns struct {
+		result1 []sarama.ConfigEntry
+		result2 error
+	}
+	describeConfigReturnsOnCall map[int]struct {
+		result1 []sarama.ConfigEntry
+		result2 error
+	}
+	DescribeConfigsStub        func([]*sarama.ConfigResource, sarama.DescribeConfigsOptions) ([]*sarama.ConfigResourceResult, error)
+	describeConfigsMutex       sync.RWMutex
+	describeConfigsArgsForCall []struct {
+		arg1 []*sarama.ConfigResource
+		arg2 sarama.DescribeConfigsOptions
+	}
+	describeConfigsReturns struct {
+		result1 []*sarama.ConfigResourceResult
+		result2 error
+	}
+	describeConfigsReturnsOnCall map[int]struct {
+		result1 []*sarama.ConfigResourceResult
+		result2 error
+	}
+	DescribeConsumerGroupsStub        func([]string) ([]*sarama.GroupDescription, error)
+	describeConsumerGroupsMutex       sync.RWMutex
+	describeConsumerGroupsArgsForCall []struct {
+		arg1 []string
+	}
+	describeConsumerGroupsReturns struct {
+		result1 []*sarama.GroupDescription
+		result2 error
+	}
+	describeConsumerGroupsReturnsOnCall map[int]struct {
+		result1 []*sarama.GroupDescription
+		result2 error
+	}
+	DescribeLogDirsStub        func([]int32) (map[int32][]sarama.DescribeLogDirsResponseDirMetadata, error)
+	describeLogDirsMutex       sync.RWMutex
+	describeLogDirsArgsForCall []struct {
+		arg1 []int32
+	}
+	describeLogDirsReturns struct {
+		result1 map[int32][]sarama.DescribeLogDirsResponseDirMetadata
+		result2 error
+	}
+	describeLogDirsReturnsOnCall map[int]struct {
+		result1 map[int32][]sarama.DescribeLogDirsResponseDirMetadata
+		result2 error
+	}
+	DescribeTopicsStub        func([]string) ([]*sarama.TopicMetadata, error)
+	describeTopicsMutex       sync.RWMutex
+	describeTopicsArgsForCall []struct {
+		arg1 []string
+	}
+	describeTopicsReturns struct {
+		result1 []*sarama.TopicMetadata
+		result2 error
+	}
+	describeTopicsReturnsOnCall map[int]struct {
+		result1 []*sarama.TopicMetadata
+		result2 error
+	}
+	DescribeUserScramCredentialsStub        func([]string) ([]*sarama.DescribeUserScramCredentialsResult, error)
+	describeUserScramCredentialsMutex       sync.RWMutex
+	describeUserScramCredentialsArgsForCall []struct {
+		arg1 []string
+	}
+	describeUserScramCredentialsReturns struct {
+		result1 []*sarama.DescribeUserScramCredentialsResult
+		result2 error
+	}
+	describeUserScramCredentialsReturnsOnCall map[int]struct {
+		result1 []*sarama.DescribeUserScramCredentialsResult
+		result2 error
+	}
+	ElectLeadersStub        func(sarama.ElectionType, map[string][]int32) (map[string]map[int32]*sarama.PartitionResult, error)
+	electLeadersMutex       sync.RWMutex
+	electLeadersArgsForCall []struct {
+		arg1 sarama.ElectionType
+		arg2 map[string][]int32
+	}
+	electLeadersReturns struct {
+		result1 map[string]map[int32]*sarama.PartitionResult
+		result2 error
+	}
+	electLeadersReturnsOnCall map[int]struct {
+		result1 map[string]map[int32]*sarama.PartitionResult
+		result2 error
+	}
+	IncrementalAlterConfigStub        func(sarama.ConfigResourceType, string, map[string]sarama.IncrementalAlterConfigsEntry, bool) error
+	incrementalAlterConfigMutex       sync.RWMutex
+	incrementalAlterConfigArgsForCall []struct {
+		arg1 sarama.ConfigResourceType
+		arg2 string
+		arg3 map[string]sarama.IncrementalAlterConfigsEntry
+		arg4 bool
+	}
+	incrementalAlterConfigReturns struct {
+		result1 error
+	}
+	incrementalAlterConfigReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ListAclsStub        func(sarama.AclFilter) ([]sarama.ResourceAcls, error)
+	listAclsMutex       sync.RWMutex
+	listAclsArgsForCall []struct {
+		arg1 sarama.AclFilter
+	}
+	listAclsReturns struct {
+		result1 []sarama.ResourceAcls
+		result2 error
+	}
+	listAclsReturnsOnCall map[int]struct {
+		result1 []sarama.ResourceAcls
+		result2 error
+	}
+	ListConsumerGroupOffsetsStub        func(string, map[string][]int32) (*sarama.OffsetFetchResponse, error)
+	listConsumerGroupOffsetsMutex       sync.RWMutex
+	listConsumerGroupOffsetsArgsForCall []struct {
+		arg1 string
+		arg2 map[string][]int32
+	}
+	listConsumerGroupOffsetsReturns struct {
+		result1 *sarama.OffsetFetchResponse
+		result2 error
+	}
+	listConsumerGroupOffsetsReturnsOnCall map[int]struct {
+		result1 *sarama.OffsetFetchResponse
+		result2 error
+	}
+	ListConsumerGroupOffsetsBatchStub        func(map[string]map[string][]int32) (map[string]*sarama.OffsetFetchResponseGroup, error)
+	listConsumerGroupOffsetsBatchMutex       sync.RWMutex
+	listConsumerGroupOffsetsBatchArgsForCall []struct {
+		arg1 map[string]map[string][]int32
+	}
+	listConsumerGroupOffsetsBatchReturns struct {
+		result1 map[string]*sarama.OffsetFetchResponseGroup
+		result2 error
+	}
+	listConsumerGroupOffsetsBatchReturnsOnCall map[int]struct {
+		result1 map[string]*sarama.OffsetFetchResponseGroup
+		result2 error
+	}
+	ListConsumerGroupsStub        func() (map[string]string, error)
+	listConsumerGroupsMutex       sync.RWMutex
+	listConsumerGroupsArgsForCall []struct {
+	}
+	listConsumerGroupsReturns struct {
+		result1 map[string]string
+		result2 error
+	}
+	listConsumerGroupsReturnsOnCall map[int]struct {
+		result1 map[string]string
+		result2 error
+	}
+	ListOffsetsStub        func(map[string]map[int32]int64, *sarama.ListOffsetsOptions) (map[string]map[int32]*sarama.OffsetResult, error)
+	listOffsetsMutex       sync.RWMutex
+	listOffsetsArgsForCall []struct {
+		arg1 map[string]map[int32]int64
+		arg2 *sarama.ListOffsetsOptions
+	}
+	listOffsetsReturns struct {
+		result1 map[string]map[int32]*sarama.OffsetResult
+		result2 error
+	}
+	listOffsetsReturnsOnCall map[int]struct {
+		result1 map[string]map[int32]*sarama.OffsetResult
+		result2 error
+	}
+	ListPartitionReassignmentsStub        func(string, []int32) (map[string]map[int32]*sarama.PartitionReplicaReassignmentsStatus, error)
+	listPartitionReassignmentsMutex       sync.RWMutex
+	listPartitionReassignmentsArgsForCall []struct {
+		arg1 string
+		arg2 []int32
+	}
+	listPartitionReassignmentsReturns struct {
+		result1 map[string]map[int32]*sarama.PartitionReplicaReassignmentsStatus
+		result2 error
+	}
+	listPartitionReassignmentsReturnsOnCall map[int]struct {
+		result1 map[string]map[int32]*sarama.PartitionReplicaReassignmentsStatus
+		result2 error
+	}
+	ListTopicsStub        func() (map[string]sarama.TopicDetail, error)
+	listTopicsMutex       sync.RWMutex
+	listTopicsArgsForCall []struct {
+	}
+	listTopicsReturns struct {
+		result1 map[string]sarama.TopicDetail
+		result2 error
+	}
+	listTopicsReturnsOnCall map[int]struct {
+		result1 map[string]sarama.TopicDetail
+		result2 error
+	}
+	RemoveMemberFromConsumerGroupStub        func(string, []string) (*sarama.LeaveGroupResponse, error)
+	removeMemberFromConsumerGroupMutex       sync.RWMutex
+	removeMemberFromConsumerGroupArgsForCall []struct {
+		arg1 string
+		arg2 []string
+	}
+	removeMemberFromConsumerGroupReturns struct {
+		result1 *sarama.LeaveGroupResponse
+		result2 error
+	}
+	removeMemberFromConsumerGroupReturnsOnCall map[int]struct {
+		result1 *sarama.LeaveGroupResponse
+		result2 error
+	}
+	UpdateFeaturesStub        func([]sarama.FeatureUpdate) ([]sarama.UpdatableFeatureResult, error)
+	updateFeaturesMutex       sync.RWMutex
+	updateFeaturesArgsForCall []struct {
+		arg1 []sarama.FeatureUpdate
+	}
+	updateFeaturesReturns struct {
+		result1 []sarama.UpdatableFeatureResult
+		result2 error
+	}
+	updateFeaturesReturnsOnCall map[int]struct {
+		result1 []sarama.UpdatableFeatureResult
+		result2 error
+	}
+	UpsertUserScramCredentialsStub        func([]sarama.AlterUserScramCredentialsUpsert) ([]*sarama.AlterUserScramCredentialsResult, error)
+	upsertUserScramCredentialsMutex       sync.RWMutex
+	upsertUserScramCredentialsArgsForCall []struct {
+		arg1 []sarama.AlterUserScramCredentialsUpsert
+	}
+	upsertUserScramCredentialsReturns struct {
+		result1 []*sarama.AlterUserScramCredentialsResult
+		result2 error
+	}
+	upsertUserScramCredentialsReturnsOnCall map[int]struct {
+		result1 []*sarama.AlterUserScramCredentialsResult
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *SaramaClusterAdmin) AlterClientQuotas(arg1 []sarama.QuotaEntityComponent, arg2 sarama.ClientQuotasOp, arg3 bool) error {
+	var arg1Copy []sarama.QuotaEntityComponent
+	if arg1 != nil {
+		arg1Copy = make([]sarama.QuotaEntityComponent, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.alterClientQuotasMutex.Lock()
+	ret, specificReturn := fake.alterClientQuotasReturnsOnCall[len(fake.alterClientQuotasArgsForCall)]
+	fake.alterClientQuotasArgsForCall = append(fake.alterClientQuotasArgsForCall, struct {
+		arg1 []sarama.QuotaEntityComponent
+		arg2 sarama.ClientQuotasOp
+		arg3 bool
+	}{arg1Copy, arg2, arg3})
+	stub := fake.AlterClientQuotasStub
+	fakeReturns := fake.alterClientQuotasReturns
+	fake.recordInvocation("AlterClientQuotas", []interface{}{arg1Copy, arg2, arg3})
+	fake.alterClientQuotasMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *SaramaClusterAdmin) AlterClientQuotasCallCount() int {
+	fake.alterClientQuotasMutex.RLock()
+	defer fake.alterClientQuotasMutex.RUnlock()
+	return len(fake.alterClientQuotasArgsForCall)
+}
+
+func (fake *SaramaClusterAdmin) AlterClientQuotasCalls(stub func([]sarama.QuotaEntityComponent, sarama.ClientQuotasOp, bool) error) {
+	fake.alterClientQuotasMutex.Lock()
+	defer fake.alterClientQuotasMutex.Unlock()
+	fake.AlterClientQuotasStub = stub
+}
+
+func (fake *SaramaClusterAdmin) AlterClientQuotasArgsForCall(i int) ([]sarama.QuotaEntityComponent, sarama.ClientQuotasOp, bool) {
+	fake.alterClientQuotasMutex.RLock()
+	defer fake.alterClientQuotasMutex.RUnlock()
+	argsForCall := fake.alterClientQuotasArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *SaramaClusterAdmin) AlterClientQuotasReturns(result1 error) {
+	fake.alterClientQuotasMutex.Lock()
+	defer fake.alterClientQuotasMutex.Unlock()
+	fake.AlterClientQuotasStub = nil
+	fake.alterClientQuotasReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *SaramaClusterAdmin) AlterClientQuotasReturnsOnCall(i int, result1 error) {
+	fake.alterClientQuotasMutex.Lock()
+	defer fake.alterClientQuotasMutex.Unlock()
+	fake.AlterClientQuotasStub = nil
+	if fake.alterClientQuotasReturnsOnCall == nil {
+		fake.alterClientQuotasReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.alterClientQuotasReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *SaramaClusterAdmin) AlterConfig(arg1 sarama.ConfigResourceType, arg2 string, arg3 map[string]*string, arg4 bool) error {
+	fake.alterConfigMutex.Lock()
+	ret, specificReturn := fake.alterConfigReturnsOnCall[len(fake.alterConfigArgsForCall)]
+	fake.alterConfigArgsForCall = append(fake.alterConfigArgsForCall, struct {
+		arg1 sarama.ConfigResourceType
+		arg2 string
+		arg3 map[string]*string
+		arg4 bool
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.AlterConfigStub
+	fakeReturns := fake.alterConfigReturns
+	fake.recordInvocation("AlterConfig", []interface{}{arg1, arg2, arg3, arg4})
+	fake.alterConfigMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *SaramaClusterAdmin) AlterConfigCallCount() int {
+	fake.alterConfigMutex.RLock()
+	defer fake.alterConfigMutex.RUnlock()
+	return len(fake.alterConfigArgsForCall)
+}
+
+func (fake *SaramaClusterAdmin) AlterConfigCalls(stub func(sarama.ConfigResourceType, string, map[string]*string, bool) error) {
+	fake.alterConfigMutex.Lock()
+	defer fake.alterConfigMutex.Unlock()
+	fake.AlterConfigStub = stub
+}
+
+func (fake *SaramaClusterAdmin) AlterConfigArgsForCall(i int) (sarama.ConfigResourceType, string, map[string]*string, bool) {
+	fake.alterConfigMutex.RLock()
+	defer fake.alterConfigMutex.RUnlock()
+	argsForCall := fake.alterConfigArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *SaramaClusterAdmin) AlterConfigReturns(result1 error) {
+	fake.alterConfigMutex.Lock()
+	defer fake.alterConfigMutex.Unlock()
+	fake.AlterConfigStub = nil
+	fake.alterConfigReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *SaramaClusterAdmin) AlterConfigReturnsOnCall(i int, result1 error) {
+	fake.alterConfigMutex.Lock()
+	defer fake.alterConfigMutex.Unlock()
+	fake.AlterConfigStub = nil
+	if fake.alterConfigReturnsOnCall == nil {
+		fake.alterConfigReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.alterConfigReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *SaramaClusterAdmin) AlterConsumerGroupOffsets(arg1 string, arg2 map[string]map[int32]sarama.OffsetAndMetadata, arg3 *sarama.AlterConsumerGroupOffsetsOptions) (*sarama.OffsetCommitResponse, error) {
+	fake.alterConsumerGroupOffsetsMutex.Lock()
+	ret, specificReturn := fake.alterConsumerGroupOffsetsReturnsOnCall[len(fake.alterConsumerGroupOffsetsArgsForCall)]
+	fake.alterConsumerGroupOffsetsArgsForCall = append(fake.alterConsumerGroupOffsetsArgsForCall, struct {
+		arg1 string
+		arg2 map[string]map[int32]sarama.OffsetAndMetadata
+		arg3 *sarama.AlterConsumerGroupOffsetsOptions
+	}{arg1, arg2, arg3})
+	stub := fake.AlterConsumerGroupOffsetsStub
+	fakeReturns := fake.alterConsumerGroupOffsetsReturns
+	fake.recordInvocation("AlterConsumerGroupOffsets", []interface{}{arg1, arg2, arg3})
+	fake.alterConsumerGroupOffsetsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *SaramaClusterAdmin) AlterConsumerGroupOffsetsCallCount() int {
+	fake.alterConsumerGroupOffsetsMutex.RLock()
+	defer fake.alterConsumerGroupOffsetsMutex.RUnlock()
+	return len(fake.alterConsumerGroupOffsetsArgsForCall)
+}
+
+func (fake *SaramaClusterAdmin) AlterConsumerGroupOffsetsCalls(stub func(string, map[string]map[int32]sarama.OffsetAndMetadata, *sarama.AlterConsumerGroupOffsetsOptions) (*sarama.OffsetCommitResponse, error)) {
+	fake.alterConsumerGroupOffsetsMutex.Lock()
+	defer fake.alterConsumerGroupOffsetsMutex.Unlock()
+	fake.AlterConsumerGroupOffsetsStub = stub
+}
+
+func (fake *SaramaClusterAdmin) AlterConsumerGroupOffsetsArgsForCall(i int) (string, map[string]map[int32]sarama.OffsetAndMetadata, *sarama.AlterConsumerGroupOffsetsOptions) {
+	fake.alterConsumerGroupOffsetsMutex.RLock()
+	defer fake.alterConsumerGroupOffsetsMutex.RUnlock()
+	argsForCall := fake.alterConsumerGroupOffsetsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *SaramaClusterAdmin) AlterConsumerGroupOffsetsReturns(result1 *sarama.OffsetCommitResponse, result2 error) {
+	fake.alterConsumerGroupOffsetsMutex.Lock()
+	defer fake.alterConsumerGroupOffsetsMutex.Unlock()
+	fake.AlterConsumerGroupOffsetsStub = nil
+	fake.alterConsumerGroupOffsetsReturns = struct {
+		result1 *sarama.OffsetCommitResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) AlterConsumerGroupOffsetsReturnsOnCall(i int, result1 *sarama.OffsetCommitResponse, result2 error) {
+	fake.alterConsumerGroupOffsetsMutex.Lock()
+	defer fake.alterConsumerGroupOffsetsMutex.Unlock()
+	fake.AlterConsumerGroupOffsetsStub = nil
+	if fake.alterConsumerGroupOffsetsReturnsOnCall == nil {
+		fake.alterConsumerGroupOffsetsReturnsOnCall = make(map[int]struct {
+			result1 *sarama.OffsetCommitResponse
+			result2 error
+		})
+	}
+	fake.alterConsumerGroupOffsetsReturnsOnCall[i] = struct {
+		result1 *sarama.OffsetCommitResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) AlterPartitionReassignments(arg1 string, arg2 [][]int32) error {
+	var arg2Copy [][]int32
+	if arg2 != nil {
+		arg2Copy = make([][]int32, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.alterPartitionReassignmentsMutex.Lock()
+	ret, specificReturn := fake.alterPartitionReassignmentsReturnsOnCall[len(fake.alterPartitionReassignmentsArgsForCall)]
+	fake.alterPartitionReassignmentsArgsForCall = append(fake.alterPartitionReassignmentsArgsForCall, struct {
+		arg1 string
+		arg2 [][]int32
+	}{arg1, arg2Copy})
+	stub := fake.AlterPartitionReassignmentsStub
+	fakeReturns := fake.alterPartitionReassignmentsReturns
+	fake.recordInvocation("AlterPartitionReassignments", []interface{}{arg1, arg2Copy})
+	fake.alterPartitionReassignmentsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *SaramaClusterAdmin) AlterPartitionReassignmentsCallCount() int {
+	fake.alterPartitionReassignmentsMutex.RLock()
+	defer fake.alterPartitionReassignmentsMutex.RUnlock()
+	return len(fake.alterPartitionReassignmentsArgsForCall)
+}
+
+func (fake *SaramaClusterAdmin) AlterPartitionReassignmentsCalls(stub func(string, [][]int32) error) {
+	fake.alterPartitionReassignmentsMutex.Lock()
+	defer fake.alterPartitionReassignmentsMutex.Unlock()
+	fake.AlterPartitionReassignmentsStub = stub
+}
+
+func (fake *SaramaClusterAdmin) AlterPartitionReassignmentsArgsForCall(i int) (string, [][]int32) {
+	fake.alterPartitionReassignmentsMutex.RLock()
+	defer fake.alterPartitionReassignmentsMutex.RUnlock()
+	argsForCall := fake.alterPartitionReassignmentsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *SaramaClusterAdmin) AlterPartitionReassignmentsReturns(result1 error) {
+	fake.alterPartitionReassignmentsMutex.Lock()
+	defer fake.alterPartitionReassignmentsMutex.Unlock()
+	fake.AlterPartitionReassignmentsStub = nil
+	fake.alterPartitionReassignmentsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *SaramaClusterAdmin) AlterPartitionReassignmentsReturnsOnCall(i int, result1 error) {
+	fake.alterPartitionReassignmentsMutex.Lock()
+	defer fake.alterPartitionReassignmentsMutex.Unlock()
+	fake.AlterPartitionReassignmentsStub = nil
+	if fake.alterPartitionReassignmentsReturnsOnCall == nil {
+		fake.alterPartitionReassignmentsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.alterPartitionReassignmentsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *SaramaClusterAdmin) Close() error {
+	fake.closeMutex.Lock()
+	ret, specificReturn := fake.closeReturnsOnCall[len(fake.closeArgsForCall)]
+	fake.closeArgsForCall = append(fake.closeArgsForCall, struct {
+	}{})
+	stub := fake.CloseStub
+	fakeReturns := fake.closeReturns
+	fake.recordInvocation("Close", []interface{}{})
+	fake.closeMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *SaramaClusterAdmin) CloseCallCount() int {
+	fake.closeMutex.RLock()
+	defer fake.closeMutex.RUnlock()
+	return len(fake.closeArgsForCall)
+}
+
+func (fake *SaramaClusterAdmin) CloseCalls(stub func() error) {
+	fake.closeMutex.Lock()
+	defer fake.closeMutex.Unlock()
+	fake.CloseStub = stub
+}
+
+func (fake *SaramaClusterAdmin) CloseReturns(result1 error) {
+	fake.closeMutex.Lock()
+	defer fake.closeMutex.Unlock()
+	fake.CloseStub = nil
+	fake.closeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *SaramaClusterAdmin) CloseReturnsOnCall(i int, result1 error) {
+	fake.closeMutex.Lock()
+	defer fake.closeMutex.Unlock()
+	fake.CloseStub = nil
+	if fake.closeReturnsOnCall == nil {
+		fake.closeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.closeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *SaramaClusterAdmin) Controller() (*sarama.Broker, error) {
+	fake.controllerMutex.Lock()
+	ret, specificReturn := fake.controllerReturnsOnCall[len(fake.controllerArgsForCall)]
+	fake.controllerArgsForCall = append(fake.controllerArgsForCall, struct {
+	}{})
+	stub := fake.ControllerStub
+	fakeReturns := fake.controllerReturns
+	fake.recordInvocation("Controller", []interface{}{})
+	fake.controllerMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *SaramaClusterAdmin) ControllerCallCount() int {
+	fake.controllerMutex.RLock()
+	defer fake.controllerMutex.RUnlock()
+	return len(fake.controllerArgsForCall)
+}
+
+func (fake *SaramaClusterAdmin) ControllerCalls(stub func() (*sarama.Broker, error)) {
+	fake.controllerMutex.Lock()
+	defer fake.controllerMutex.Unlock()
+	fake.ControllerStub = stub
+}
+
+func (fake *SaramaClusterAdmin) ControllerReturns(result1 *sarama.Broker, result2 error) {
+	fake.controllerMutex.Lock()
+	defer fake.controllerMutex.Unlock()
+	fake.ControllerStub = nil
+	fake.controllerReturns = struct {
+		result1 *sarama.Broker
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) ControllerReturnsOnCall(i int, result1 *sarama.Broker, result2 error) {
+	fake.controllerMutex.Lock()
+	defer fake.controllerMutex.Unlock()
+	fake.ControllerStub = nil
+	if fake.controllerReturnsOnCall == nil {
+		fake.controllerReturnsOnCall = make(map[int]struct {
+			result1 *sarama.Broker
+			result2 error
+		})
+	}
+	fake.controllerReturnsOnCall[i] = struct {
+		result1 *sarama.Broker
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) Coordinator(arg1 string) (*sarama.Broker, error) {
+	fake.coordinatorMutex.Lock()
+	ret, specificReturn := fake.coordinatorReturnsOnCall[len(fake.coordinatorArgsForCall)]
+	fake.coordinatorArgsForCall = append(fake.coordinatorArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.CoordinatorStub
+	fakeReturns := fake.coordinatorReturns
+	fake.recordInvocation("Coordinator", []interface{}{arg1})
+	fake.coordinatorMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *SaramaClusterAdmin) CoordinatorCallCount() int {
+	fake.coordinatorMutex.RLock()
+	defer fake.coordinatorMutex.RUnlock()
+	return len(fake.coordinatorArgsForCall)
+}
+
+func (fake *SaramaClusterAdmin) CoordinatorCalls(stub func(string) (*sarama.Broker, error)) {
+	fake.coordinatorMutex.Lock()
+	defer fake.coordinatorMutex.Unlock()
+	fake.CoordinatorStub = stub
+}
+
+func (fake *SaramaClusterAdmin) CoordinatorArgsForCall(i int) string {
+	fake.coordinatorMutex.RLock()
+	defer fake.coordinatorMutex.RUnlock()
+	argsForCall := fake.coordinatorArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *SaramaClusterAdmin) CoordinatorReturns(result1 *sarama.Broker, result2 error) {
+	fake.coordinatorMutex.Lock()
+	defer fake.coordinatorMutex.Unlock()
+	fake.CoordinatorStub = nil
+	fake.coordinatorReturns = struct {
+		result1 *sarama.Broker
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) CoordinatorReturnsOnCall(i int, result1 *sarama.Broker, result2 error) {
+	fake.coordinatorMutex.Lock()
+	defer fake.coordinatorMutex.Unlock()
+	fake.CoordinatorStub = nil
+	if fake.coordinatorReturnsOnCall == nil {
+		fake.coordinatorReturnsOnCall = make(map[int]struct {
+			result1 *sarama.Broker
+			result2 error
+		})
+	}
+	fake.coordinatorReturnsOnCall[i] = struct {
+		result1 *sarama.Broker
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) CreateACL(arg1 sarama.Resource, arg2 sarama.Acl) error {
+	fake.createACLMutex.Lock()
+	ret, specificReturn := fake.createACLReturnsOnCall[len(fake.createACLArgsForCall)]
+	fake.createACLArgsForCall = append(fake.createACLArgsForCall, struct {
+		arg1 sarama.Resource
+		arg2 sarama.Acl
+	}{arg1, arg2})
+	stub := fake.CreateACLStub
+	fakeReturns := fake.createACLReturns
+	fake.recordInvocation("CreateACL", []interface{}{arg1, arg2})
+	fake.createACLMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *SaramaClusterAdmin) CreateACLCallCount() int {
+	fake.createACLMutex.RLock()
+	defer fake.createACLMutex.RUnlock()
+	return len(fake.createACLArgsForCall)
+}
+
+func (fake *SaramaClusterAdmin) CreateACLCalls(stub func(sarama.Resource, sarama.Acl) error) {
+	fake.createACLMutex.Lock()
+	defer fake.createACLMutex.Unlock()
+	fake.CreateACLStub = stub
+}
+
+func (fake *SaramaClusterAdmin) CreateACLArgsForCall(i int) (sarama.Resource, sarama.Acl) {
+	fake.createACLMutex.RLock()
+	defer fake.createACLMutex.RUnlock()
+	argsForCall := fake.createACLArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *SaramaClusterAdmin) CreateACLReturns(result1 error) {
+	fake.createACLMutex.Lock()
+	defer fake.createACLMutex.Unlock()
+	fake.CreateACLStub = nil
+	fake.createACLReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *SaramaClusterAdmin) CreateACLReturnsOnCall(i int, result1 error) {
+	fake.createACLMutex.Lock()
+	defer fake.createACLMutex.Unlock()
+	fake.CreateACLStub = nil
+	if fake.createACLReturnsOnCall == nil {
+		fake.createACLReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createACLReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *SaramaClusterAdmin) CreateACLs(arg1 []*sarama.ResourceAcls) error {
+	var arg1Copy []*sarama.ResourceAcls
+	if arg1 != nil {
+		arg1Copy = make([]*sarama.ResourceAcls, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.createACLsMutex.Lock()
+	ret, specificReturn := fake.createACLsReturnsOnCall[len(fake.createACLsArgsForCall)]
+	fake.createACLsArgsForCall = append(fake.createACLsArgsForCall, struct {
+		arg1 []*sarama.ResourceAcls
+	}{arg1Copy})
+	stub := fake.CreateACLsStub
+	fakeReturns := fake.createACLsReturns
+	fake.recordInvocation("CreateACLs", []interface{}{arg1Copy})
+	fake.createACLsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *SaramaClusterAdmin) CreateACLsCallCount() int {
+	fake.createACLsMutex.RLock()
+	defer fake.createACLsMutex.RUnlock()
+	return len(fake.createACLsArgsForCall)
+}
+
+func (fake *SaramaClusterAdmin) CreateACLsCalls(stub func([]*sarama.ResourceAcls) error) {
+	fake.createACLsMutex.Lock()
+	defer fake.createACLsMutex.Unlock()
+	fake.CreateACLsStub = stub
+}
+
+func (fake *SaramaClusterAdmin) CreateACLsArgsForCall(i int) []*sarama.ResourceAcls {
+	fake.createACLsMutex.RLock()
+	defer fake.createACLsMutex.RUnlock()
+	argsForCall := fake.createACLsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *SaramaClusterAdmin) CreateACLsReturns(result1 error) {
+	fake.createACLsMutex.Lock()
+	defer fake.createACLsMutex.Unlock()
+	fake.CreateACLsStub = nil
+	fake.createACLsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *SaramaClusterAdmin) CreateACLsReturnsOnCall(i int, result1 error) {
+	fake.createACLsMutex.Lock()
+	defer fake.createACLsMutex.Unlock()
+	fake.CreateACLsStub = nil
+	if fake.createACLsReturnsOnCall == nil {
+		fake.createACLsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createACLsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *SaramaClusterAdmin) CreatePartitions(arg1 string, arg2 int32, arg3 [][]int32, arg4 bool) error {
+	var arg3Copy [][]int32
+	if arg3 != nil {
+		arg3Copy = make([][]int32, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.createPartitionsMutex.Lock()
+	ret, specificReturn := fake.createPartitionsReturnsOnCall[len(fake.createPartitionsArgsForCall)]
+	fake.createPartitionsArgsForCall = append(fake.createPartitionsArgsForCall, struct {
+		arg1 string
+		arg2 int32
+		arg3 [][]int32
+		arg4 bool
+	}{arg1, arg2, arg3Copy, arg4})
+	stub := fake.CreatePartitionsStub
+	fakeReturns := fake.createPartitionsReturns
+	fake.recordInvocation("CreatePartitions", []interface{}{arg1, arg2, arg3Copy, arg4})
+	fake.createPartitionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *SaramaClusterAdmin) CreatePartitionsCallCount() int {
+	fake.createPartitionsMutex.RLock()
+	defer fake.createPartitionsMutex.RUnlock()
+	return len(fake.createPartitionsArgsForCall)
+}
+
+func (fake *SaramaClusterAdmin) CreatePartitionsCalls(stub func(string, int32, [][]int32, bool) error) {
+	fake.createPartitionsMutex.Lock()
+	defer fake.createPartitionsMutex.Unlock()
+	fake.CreatePartitionsStub = stub
+}
+
+func (fake *SaramaClusterAdmin) CreatePartitionsArgsForCall(i int) (string, int32, [][]int32, bool) {
+	fake.createPartitionsMutex.RLock()
+	defer fake.createPartitionsMutex.RUnlock()
+	argsForCall := fake.createPartitionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *SaramaClusterAdmin) CreatePartitionsReturns(result1 error) {
+	fake.createPartitionsMutex.Lock()
+	defer fake.createPartitionsMutex.Unlock()
+	fake.CreatePartitionsStub = nil
+	fake.createPartitionsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *SaramaClusterAdmin) CreatePartitionsReturnsOnCall(i int, result1 error) {
+	fake.createPartitionsMutex.Lock()
+	defer fake.createPartitionsMutex.Unlock()
+	fake.CreatePartitionsStub = nil
+	if fake.createPartitionsReturnsOnCall == nil {
+		fake.createPartitionsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createPartitionsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *SaramaClusterAdmin) CreateTopic(arg1 string, arg2 *sarama.TopicDetail, arg3 bool) error {
+	fake.createTopicMutex.Lock()
+	ret, specificReturn := fake.createTopicReturnsOnCall[len(fake.createTopicArgsForCall)]
+	fake.createTopicArgsForCall = append(fake.createTopicArgsForCall, struct {
+		arg1 string
+		arg2 *sarama.TopicDetail
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.CreateTopicStub
+	fakeReturns := fake.createTopicReturns
+	fake.recordInvocation("CreateTopic", []interface{}{arg1, arg2, arg3})
+	fake.createTopicMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *SaramaClusterAdmin) CreateTopicCallCount() int {
+	fake.createTopicMutex.RLock()
+	defer fake.createTopicMutex.RUnlock()
+	return len(fake.createTopicArgsForCall)
+}
+
+func (fake *SaramaClusterAdmin) CreateTopicCalls(stub func(string, *sarama.TopicDetail, bool) error) {
+	fake.createTopicMutex.Lock()
+	defer fake.createTopicMutex.Unlock()
+	fake.CreateTopicStub = stub
+}
+
+func (fake *SaramaClusterAdmin) CreateTopicArgsForCall(i int) (string, *sarama.TopicDetail, bool) {
+	fake.createTopicMutex.RLock()
+	defer fake.createTopicMutex.RUnlock()
+	argsForCall := fake.createTopicArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *SaramaClusterAdmin) CreateTopicReturns(result1 error) {
+	fake.createTopicMutex.Lock()
+	defer fake.createTopicMutex.Unlock()
+	fake.CreateTopicStub = nil
+	fake.createTopicReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *SaramaClusterAdmin) CreateTopicReturnsOnCall(i int, result1 error) {
+	fake.createTopicMutex.Lock()
+	defer fake.createTopicMutex.Unlock()
+	fake.CreateTopicStub = nil
+	if fake.createTopicReturnsOnCall == nil {
+		fake.createTopicReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createTopicReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *SaramaClusterAdmin) DeleteACL(arg1 sarama.AclFilter, arg2 bool) ([]sarama.MatchingAcl, error) {
+	fake.deleteACLMutex.Lock()
+	ret, specificReturn := fake.deleteACLReturnsOnCall[len(fake.deleteACLArgsForCall)]
+	fake.deleteACLArgsForCall = append(fake.deleteACLArgsForCall, struct {
+		arg1 sarama.AclFilter
+		arg2 bool
+	}{arg1, arg2})
+	stub := fake.DeleteACLStub
+	fakeReturns := fake.deleteACLReturns
+	fake.recordInvocation("DeleteACL", []interface{}{arg1, arg2})
+	fake.deleteACLMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *SaramaClusterAdmin) DeleteACLCallCount() int {
+	fake.deleteACLMutex.RLock()
+	defer fake.deleteACLMutex.RUnlock()
+	return len(fake.deleteACLArgsForCall)
+}
+
+func (fake *SaramaClusterAdmin) DeleteACLCalls(stub func(sarama.AclFilter, bool) ([]sarama.MatchingAcl, error)) {
+	fake.deleteACLMutex.Lock()
+	defer fake.deleteACLMutex.Unlock()
+	fake.DeleteACLStub = stub
+}
+
+func (fake *SaramaClusterAdmin) DeleteACLArgsForCall(i int) (sarama.AclFilter, bool) {
+	fake.deleteACLMutex.RLock()
+	defer fake.deleteACLMutex.RUnlock()
+	argsForCall := fake.deleteACLArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *SaramaClusterAdmin) DeleteACLReturns(result1 []sarama.MatchingAcl, result2 error) {
+	fake.deleteACLMutex.Lock()
+	defer fake.deleteACLMutex.Unlock()
+	fake.DeleteACLStub = nil
+	fake.deleteACLReturns = struct {
+		result1 []sarama.MatchingAcl
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) DeleteACLReturnsOnCall(i int, result1 []sarama.MatchingAcl, result2 error) {
+	fake.deleteACLMutex.Lock()
+	defer fake.deleteACLMutex.Unlock()
+	fake.DeleteACLStub = nil
+	if fake.deleteACLReturnsOnCall == nil {
+		fake.deleteACLReturnsOnCall = make(map[int]struct {
+			result1 []sarama.MatchingAcl
+			result2 error
+		})
+	}
+	fake.deleteACLReturnsOnCall[i] = struct {
+		result1 []sarama.MatchingAcl
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) DeleteConsumerGroup(arg1 string) error {
+	fake.deleteConsumerGroupMutex.Lock()
+	ret, specificReturn := fake.deleteConsumerGroupReturnsOnCall[len(fake.deleteConsumerGroupArgsForCall)]
+	fake.deleteConsumerGroupArgsForCall = append(fake.deleteConsumerGroupArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.DeleteConsumerGroupStub
+	fakeReturns := fake.deleteConsumerGroupReturns
+	fake.recordInvocation("DeleteConsumerGroup", []interface{}{arg1})
+	fake.deleteConsumerGroupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *SaramaClusterAdmin) DeleteConsumerGroupCallCount() int {
+	fake.deleteConsumerGroupMutex.RLock()
+	defer fake.deleteConsumerGroupMutex.RUnlock()
+	return len(fake.deleteConsumerGroupArgsForCall)
+}
+
+func (fake *SaramaClusterAdmin) DeleteConsumerGroupCalls(stub func(string) error) {
+	fake.deleteConsumerGroupMutex.Lock()
+	defer fake.deleteConsumerGroupMutex.Unlock()
+	fake.DeleteConsumerGroupStub = stub
+}
+
+func (fake *SaramaClusterAdmin) DeleteConsumerGroupArgsForCall(i int) string {
+	fake.deleteConsumerGroupMutex.RLock()
+	defer fake.deleteConsumerGroupMutex.RUnlock()
+	argsForCall := fake.deleteConsumerGroupArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *SaramaClusterAdmin) DeleteConsumerGroupReturns(result1 error) {
+	fake.deleteConsumerGroupMutex.Lock()
+	defer fake.deleteConsumerGroupMutex.Unlock()
+	fake.DeleteConsumerGroupStub = nil
+	fake.deleteConsumerGroupReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *SaramaClusterAdmin) DeleteConsumerGroupReturnsOnCall(i int, result1 error) {
+	fake.deleteConsumerGroupMutex.Lock()
+	defer fake.deleteConsumerGroupMutex.Unlock()
+	fake.DeleteConsumerGroupStub = nil
+	if fake.deleteConsumerGroupReturnsOnCall == nil {
+		fake.deleteConsumerGroupReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteConsumerGroupReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *SaramaClusterAdmin) DeleteConsumerGroupOffset(arg1 string, arg2 string, arg3 int32) error {
+	fake.deleteConsumerGroupOffsetMutex.Lock()
+	ret, specificReturn := fake.deleteConsumerGroupOffsetReturnsOnCall[len(fake.deleteConsumerGroupOffsetArgsForCall)]
+	fake.deleteConsumerGroupOffsetArgsForCall = append(fake.deleteConsumerGroupOffsetArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 int32
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteConsumerGroupOffsetStub
+	fakeReturns := fake.deleteConsumerGroupOffsetReturns
+	fake.recordInvocation("DeleteConsumerGroupOffset", []interface{}{arg1, arg2, arg3})
+	fake.deleteConsumerGroupOffsetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *SaramaClusterAdmin) DeleteConsumerGroupOffsetCallCount() int {
+	fake.deleteConsumerGroupOffsetMutex.RLock()
+	defer fake.deleteConsumerGroupOffsetMutex.RUnlock()
+	return len(fake.deleteConsumerGroupOffsetArgsForCall)
+}
+
+func (fake *SaramaClusterAdmin) DeleteConsumerGroupOffsetCalls(stub func(string, string, int32) error) {
+	fake.deleteConsumerGroupOffsetMutex.Lock()
+	defer fake.deleteConsumerGroupOffsetMutex.Unlock()
+	fake.DeleteConsumerGroupOffsetStub = stub
+}
+
+func (fake *SaramaClusterAdmin) DeleteConsumerGroupOffsetArgsForCall(i int) (string, string, int32) {
+	fake.deleteConsumerGroupOffsetMutex.RLock()
+	defer fake.deleteConsumerGroupOffsetMutex.RUnlock()
+	argsForCall := fake.deleteConsumerGroupOffsetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *SaramaClusterAdmin) DeleteConsumerGroupOffsetReturns(result1 error) {
+	fake.deleteConsumerGroupOffsetMutex.Lock()
+	defer fake.deleteConsumerGroupOffsetMutex.Unlock()
+	fake.DeleteConsumerGroupOffsetStub = nil
+	fake.deleteConsumerGroupOffsetReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *SaramaClusterAdmin) DeleteConsumerGroupOffsetReturnsOnCall(i int, result1 error) {
+	fake.deleteConsumerGroupOffsetMutex.Lock()
+	defer fake.deleteConsumerGroupOffsetMutex.Unlock()
+	fake.DeleteConsumerGroupOffsetStub = nil
+	if fake.deleteConsumerGroupOffsetReturnsOnCall == nil {
+		fake.deleteConsumerGroupOffsetReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteConsumerGroupOffsetReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *SaramaClusterAdmin) DeleteRecords(arg1 string, arg2 map[int32]int64) error {
+	fake.deleteRecordsMutex.Lock()
+	ret, specificReturn := fake.deleteRecordsReturnsOnCall[len(fake.deleteRecordsArgsForCall)]
+	fake.deleteRecordsArgsForCall = append(fake.deleteRecordsArgsForCall, struct {
+		arg1 string
+		arg2 map[int32]int64
+	}{arg1, arg2})
+	stub := fake.DeleteRecordsStub
+	fakeReturns := fake.deleteRecordsReturns
+	fake.recordInvocation("DeleteRecords", []interface{}{arg1, arg2})
+	fake.deleteRecordsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *SaramaClusterAdmin) DeleteRecordsCallCount() int {
+	fake.deleteRecordsMutex.RLock()
+	defer fake.deleteRecordsMutex.RUnlock()
+	return len(fake.deleteRecordsArgsForCall)
+}
+
+func (fake *SaramaClusterAdmin) DeleteRecordsCalls(stub func(string, map[int32]int64) error) {
+	fake.deleteRecordsMutex.Lock()
+	defer fake.deleteRecordsMutex.Unlock()
+	fake.DeleteRecordsStub = stub
+}
+
+func (fake *SaramaClusterAdmin) DeleteRecordsArgsForCall(i int) (string, map[int32]int64) {
+	fake.deleteRecordsMutex.RLock()
+	defer fake.deleteRecordsMutex.RUnlock()
+	argsForCall := fake.deleteRecordsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *SaramaClusterAdmin) DeleteRecordsReturns(result1 error) {
+	fake.deleteRecordsMutex.Lock()
+	defer fake.deleteRecordsMutex.Unlock()
+	fake.DeleteRecordsStub = nil
+	fake.deleteRecordsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *SaramaClusterAdmin) DeleteRecordsReturnsOnCall(i int, result1 error) {
+	fake.deleteRecordsMutex.Lock()
+	defer fake.deleteRecordsMutex.Unlock()
+	fake.DeleteRecordsStub = nil
+	if fake.deleteRecordsReturnsOnCall == nil {
+		fake.deleteRecordsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteRecordsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *SaramaClusterAdmin) DeleteTopic(arg1 string) error {
+	fake.deleteTopicMutex.Lock()
+	ret, specificReturn := fake.deleteTopicReturnsOnCall[len(fake.deleteTopicArgsForCall)]
+	fake.deleteTopicArgsForCall = append(fake.deleteTopicArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.DeleteTopicStub
+	fakeReturns := fake.deleteTopicReturns
+	fake.recordInvocation("DeleteTopic", []interface{}{arg1})
+	fake.deleteTopicMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *SaramaClusterAdmin) DeleteTopicCallCount() int {
+	fake.deleteTopicMutex.RLock()
+	defer fake.deleteTopicMutex.RUnlock()
+	return len(fake.deleteTopicArgsForCall)
+}
+
+func (fake *SaramaClusterAdmin) DeleteTopicCalls(stub func(string) error) {
+	fake.deleteTopicMutex.Lock()
+	defer fake.deleteTopicMutex.Unlock()
+	fake.DeleteTopicStub = stub
+}
+
+func (fake *SaramaClusterAdmin) DeleteTopicArgsForCall(i int) string {
+	fake.deleteTopicMutex.RLock()
+	defer fake.deleteTopicMutex.RUnlock()
+	argsForCall := fake.deleteTopicArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *SaramaClusterAdmin) DeleteTopicReturns(result1 error) {
+	fake.deleteTopicMutex.Lock()
+	defer fake.deleteTopicMutex.Unlock()
+	fake.DeleteTopicStub = nil
+	fake.deleteTopicReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *SaramaClusterAdmin) DeleteTopicReturnsOnCall(i int, result1 error) {
+	fake.deleteTopicMutex.Lock()
+	defer fake.deleteTopicMutex.Unlock()
+	fake.DeleteTopicStub = nil
+	if fake.deleteTopicReturnsOnCall == nil {
+		fake.deleteTopicReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteTopicReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *SaramaClusterAdmin) DeleteUserScramCredentials(arg1 []sarama.AlterUserScramCredentialsDelete) ([]*sarama.AlterUserScramCredentialsResult, error) {
+	var arg1Copy []sarama.AlterUserScramCredentialsDelete
+	if arg1 != nil {
+		arg1Copy = make([]sarama.AlterUserScramCredentialsDelete, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.deleteUserScramCredentialsMutex.Lock()
+	ret, specificReturn := fake.deleteUserScramCredentialsReturnsOnCall[len(fake.deleteUserScramCredentialsArgsForCall)]
+	fake.deleteUserScramCredentialsArgsForCall = append(fake.deleteUserScramCredentialsArgsForCall, struct {
+		arg1 []sarama.AlterUserScramCredentialsDelete
+	}{arg1Copy})
+	stub := fake.DeleteUserScramCredentialsStub
+	fakeReturns := fake.deleteUserScramCredentialsReturns
+	fake.recordInvocation("DeleteUserScramCredentials", []interface{}{arg1Copy})
+	fake.deleteUserScramCredentialsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *SaramaClusterAdmin) DeleteUserScramCredentialsCallCount() int {
+	fake.deleteUserScramCredentialsMutex.RLock()
+	defer fake.deleteUserScramCredentialsMutex.RUnlock()
+	return len(fake.deleteUserScramCredentialsArgsForCall)
+}
+
+func (fake *SaramaClusterAdmin) DeleteUserScramCredentialsCalls(stub func([]sarama.AlterUserScramCredentialsDelete) ([]*sarama.AlterUserScramCredentialsResult, error)) {
+	fake.deleteUserScramCredentialsMutex.Lock()
+	defer fake.deleteUserScramCredentialsMutex.Unlock()
+	fake.DeleteUserScramCredentialsStub = stub
+}
+
+func (fake *SaramaClusterAdmin) DeleteUserScramCredentialsArgsForCall(i int) []sarama.AlterUserScramCredentialsDelete {
+	fake.deleteUserScramCredentialsMutex.RLock()
+	defer fake.deleteUserScramCredentialsMutex.RUnlock()
+	argsForCall := fake.deleteUserScramCredentialsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *SaramaClusterAdmin) DeleteUserScramCredentialsReturns(result1 []*sarama.AlterUserScramCredentialsResult, result2 error) {
+	fake.deleteUserScramCredentialsMutex.Lock()
+	defer fake.deleteUserScramCredentialsMutex.Unlock()
+	fake.DeleteUserScramCredentialsStub = nil
+	fake.deleteUserScramCredentialsReturns = struct {
+		result1 []*sarama.AlterUserScramCredentialsResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) DeleteUserScramCredentialsReturnsOnCall(i int, result1 []*sarama.AlterUserScramCredentialsResult, result2 error) {
+	fake.deleteUserScramCredentialsMutex.Lock()
+	defer fake.deleteUserScramCredentialsMutex.Unlock()
+	fake.DeleteUserScramCredentialsStub = nil
+	if fake.deleteUserScramCredentialsReturnsOnCall == nil {
+		fake.deleteUserScramCredentialsReturnsOnCall = make(map[int]struct {
+			result1 []*sarama.AlterUserScramCredentialsResult
+			result2 error
+		})
+	}
+	fake.deleteUserScramCredentialsReturnsOnCall[i] = struct {
+		result1 []*sarama.AlterUserScramCredentialsResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) DescribeClientQuotas(arg1 []sarama.QuotaFilterComponent, arg2 bool) ([]sarama.DescribeClientQuotasEntry, error) {
+	var arg1Copy []sarama.QuotaFilterComponent
+	if arg1 != nil {
+		arg1Copy = make([]sarama.QuotaFilterComponent, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.describeClientQuotasMutex.Lock()
+	ret, specificReturn := fake.describeClientQuotasReturnsOnCall[len(fake.describeClientQuotasArgsForCall)]
+	fake.describeClientQuotasArgsForCall = append(fake.describeClientQuotasArgsForCall, struct {
+		arg1 []sarama.QuotaFilterComponent
+		arg2 bool
+	}{arg1Copy, arg2})
+	stub := fake.DescribeClientQuotasStub
+	fakeReturns := fake.describeClientQuotasReturns
+	fake.recordInvocation("DescribeClientQuotas", []interface{}{arg1Copy, arg2})
+	fake.describeClientQuotasMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *SaramaClusterAdmin) DescribeClientQuotasCallCount() int {
+	fake.describeClientQuotasMutex.RLock()
+	defer fake.describeClientQuotasMutex.RUnlock()
+	return len(fake.describeClientQuotasArgsForCall)
+}
+
+func (fake *SaramaClusterAdmin) DescribeClientQuotasCalls(stub func([]sarama.QuotaFilterComponent, bool) ([]sarama.DescribeClientQuotasEntry, error)) {
+	fake.describeClientQuotasMutex.Lock()
+	defer fake.describeClientQuotasMutex.Unlock()
+	fake.DescribeClientQuotasStub = stub
+}
+
+func (fake *SaramaClusterAdmin) DescribeClientQuotasArgsForCall(i int) ([]sarama.QuotaFilterComponent, bool) {
+	fake.describeClientQuotasMutex.RLock()
+	defer fake.describeClientQuotasMutex.RUnlock()
+	argsForCall := fake.describeClientQuotasArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *SaramaClusterAdmin) DescribeClientQuotasReturns(result1 []sarama.DescribeClientQuotasEntry, result2 error) {
+	fake.describeClientQuotasMutex.Lock()
+	defer fake.describeClientQuotasMutex.Unlock()
+	fake.DescribeClientQuotasStub = nil
+	fake.describeClientQuotasReturns = struct {
+		result1 []sarama.DescribeClientQuotasEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) DescribeClientQuotasReturnsOnCall(i int, result1 []sarama.DescribeClientQuotasEntry, result2 error) {
+	fake.describeClientQuotasMutex.Lock()
+	defer fake.describeClientQuotasMutex.Unlock()
+	fake.DescribeClientQuotasStub = nil
+	if fake.describeClientQuotasReturnsOnCall == nil {
+		fake.describeClientQuotasReturnsOnCall = make(map[int]struct {
+			result1 []sarama.DescribeClientQuotasEntry
+			result2 error
+		})
+	}
+	fake.describeClientQuotasReturnsOnCall[i] = struct {
+		result1 []sarama.DescribeClientQuotasEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) DescribeCluster() ([]*sarama.Broker, int32, error) {
+	fake.describeClusterMutex.Lock()
+	ret, specificReturn := fake.describeClusterReturnsOnCall[len(fake.describeClusterArgsForCall)]
+	fake.describeClusterArgsForCall = append(fake.describeClusterArgsForCall, struct {
+	}{})
+	stub := fake.DescribeClusterStub
+	fakeReturns := fake.describeClusterReturns
+	fake.recordInvocation("DescribeCluster", []interface{}{})
+	fake.describeClusterMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *SaramaClusterAdmin) DescribeClusterCallCount() int {
+	fake.describeClusterMutex.RLock()
+	defer fake.describeClusterMutex.RUnlock()
+	return len(fake.describeClusterArgsForCall)
+}
+
+func (fake *SaramaClusterAdmin) DescribeClusterCalls(stub func() ([]*sarama.Broker, int32, error)) {
+	fake.describeClusterMutex.Lock()
+	defer fake.describeClusterMutex.Unlock()
+	fake.DescribeClusterStub = stub
+}
+
+func (fake *SaramaClusterAdmin) DescribeClusterReturns(result1 []*sarama.Broker, result2 int32, result3 error) {
+	fake.describeClusterMutex.Lock()
+	defer fake.describeClusterMutex.Unlock()
+	fake.DescribeClusterStub = nil
+	fake.describeClusterReturns = struct {
+		result1 []*sarama.Broker
+		result2 int32
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *SaramaClusterAdmin) DescribeClusterReturnsOnCall(i int, result1 []*sarama.Broker, result2 int32, result3 error) {
+	fake.describeClusterMutex.Lock()
+	defer fake.describeClusterMutex.Unlock()
+	fake.DescribeClusterStub = nil
+	if fake.describeClusterReturnsOnCall == nil {
+		fake.describeClusterReturnsOnCall = make(map[int]struct {
+			result1 []*sarama.Broker
+			result2 int32
+			result3 error
+		})
+	}
+	fake.describeClusterReturnsOnCall[i] = struct {
+		result1 []*sarama.Broker
+		result2 int32
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *SaramaClusterAdmin) DescribeConfig(arg1 sarama.ConfigResource) ([]sarama.ConfigEntry, error) {
+	fake.describeConfigMutex.Lock()
+	ret, specificReturn := fake.describeConfigReturnsOnCall[len(fake.describeConfigArgsForCall)]
+	fake.describeConfigArgsForCall = append(fake.describeConfigArgsForCall, struct {
+		arg1 sarama.ConfigResource
+	}{arg1})
+	stub := fake.DescribeConfigStub
+	fakeReturns := fake.describeConfigReturns
+	fake.recordInvocation("DescribeConfig", []interface{}{arg1})
+	fake.describeConfigMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *SaramaClusterAdmin) DescribeConfigCallCount() int {
+	fake.describeConfigMutex.RLock()
+	defer fake.describeConfigMutex.RUnlock()
+	return len(fake.describeConfigArgsForCall)
+}
+
+func (fake *SaramaClusterAdmin) DescribeConfigCalls(stub func(sarama.ConfigResource) ([]sarama.ConfigEntry, error)) {
+	fake.describeConfigMutex.Lock()
+	defer fake.describeConfigMutex.Unlock()
+	fake.DescribeConfigStub = stub
+}
+
+func (fake *SaramaClusterAdmin) DescribeConfigArgsForCall(i int) sarama.ConfigResource {
+	fake.describeConfigMutex.RLock()
+	defer fake.describeConfigMutex.RUnlock()
+	argsForCall := fake.describeConfigArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *SaramaClusterAdmin) DescribeConfigReturns(result1 []sarama.ConfigEntry, result2 error) {
+	fake.describeConfigMutex.Lock()
+	defer fake.describeConfigMutex.Unlock()
+	fake.DescribeConfigStub = nil
+	fake.describeConfigReturns = struct {
+		result1 []sarama.ConfigEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) DescribeConfigReturnsOnCall(i int, result1 []sarama.ConfigEntry, result2 error) {
+	fake.describeConfigMutex.Lock()
+	defer fake.describeConfigMutex.Unlock()
+	fake.DescribeConfigStub = nil
+	if fake.describeConfigReturnsOnCall == nil {
+		fake.describeConfigReturnsOnCall = make(map[int]struct {
+			result1 []sarama.ConfigEntry
+			result2 error
+		})
+	}
+	fake.describeConfigReturnsOnCall[i] = struct {
+		result1 []sarama.ConfigEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) DescribeConfigs(arg1 []*sarama.ConfigResource, arg2 sarama.DescribeConfigsOptions) ([]*sarama.ConfigResourceResult, error) {
+	var arg1Copy []*sarama.ConfigResource
+	if arg1 != nil {
+		arg1Copy = make([]*sarama.ConfigResource, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.describeConfigsMutex.Lock()
+	ret, specificReturn := fake.describeConfigsReturnsOnCall[len(fake.describeConfigsArgsForCall)]
+	fake.describeConfigsArgsForCall = append(fake.describeConfigsArgsForCall, struct {
+		arg1 []*sarama.ConfigResource
+		arg2 sarama.DescribeConfigsOptions
+	}{arg1Copy, arg2})
+	stub := fake.DescribeConfigsStub
+	fakeReturns := fake.describeConfigsReturns
+	fake.recordInvocation("DescribeConfigs", []interface{}{arg1Copy, arg2})
+	fake.describeConfigsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *SaramaClusterAdmin) DescribeConfigsCallCount() int {
+	fake.describeConfigsMutex.RLock()
+	defer fake.describeConfigsMutex.RUnlock()
+	return len(fake.describeConfigsArgsForCall)
+}
+
+func (fake *SaramaClusterAdmin) DescribeConfigsCalls(stub func([]*sarama.ConfigResource, sarama.DescribeConfigsOptions) ([]*sarama.ConfigResourceResult, error)) {
+	fake.describeConfigsMutex.Lock()
+	defer fake.describeConfigsMutex.Unlock()
+	fake.DescribeConfigsStub = stub
+}
+
+func (fake *SaramaClusterAdmin) DescribeConfigsArgsForCall(i int) ([]*sarama.ConfigResource, sarama.DescribeConfigsOptions) {
+	fake.describeConfigsMutex.RLock()
+	defer fake.describeConfigsMutex.RUnlock()
+	argsForCall := fake.describeConfigsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *SaramaClusterAdmin) DescribeConfigsReturns(result1 []*sarama.ConfigResourceResult, result2 error) {
+	fake.describeConfigsMutex.Lock()
+	defer fake.describeConfigsMutex.Unlock()
+	fake.DescribeConfigsStub = nil
+	fake.describeConfigsReturns = struct {
+		result1 []*sarama.ConfigResourceResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) DescribeConfigsReturnsOnCall(i int, result1 []*sarama.ConfigResourceResult, result2 error) {
+	fake.describeConfigsMutex.Lock()
+	defer fake.describeConfigsMutex.Unlock()
+	fake.DescribeConfigsStub = nil
+	if fake.describeConfigsReturnsOnCall == nil {
+		fake.describeConfigsReturnsOnCall = make(map[int]struct {
+			result1 []*sarama.ConfigResourceResult
+			result2 error
+		})
+	}
+	fake.describeConfigsReturnsOnCall[i] = struct {
+		result1 []*sarama.ConfigResourceResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) DescribeConsumerGroups(arg1 []string) ([]*sarama.GroupDescription, error) {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.describeConsumerGroupsMutex.Lock()
+	ret, specificReturn := fake.describeConsumerGroupsReturnsOnCall[len(fake.describeConsumerGroupsArgsForCall)]
+	fake.describeConsumerGroupsArgsForCall = append(fake.describeConsumerGroupsArgsForCall, struct {
+		arg1 []string
+	}{arg1Copy})
+	stub := fake.DescribeConsumerGroupsStub
+	fakeReturns := fake.describeConsumerGroupsReturns
+	fake.recordInvocation("DescribeConsumerGroups", []interface{}{arg1Copy})
+	fake.describeConsumerGroupsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *SaramaClusterAdmin) DescribeConsumerGroupsCallCount() int {
+	fake.describeConsumerGroupsMutex.RLock()
+	defer fake.describeConsumerGroupsMutex.RUnlock()
+	return len(fake.describeConsumerGroupsArgsForCall)
+}
+
+func (fake *SaramaClusterAdmin) DescribeConsumerGroupsCalls(stub func([]string) ([]*sarama.GroupDescription, error)) {
+	fake.describeConsumerGroupsMutex.Lock()
+	defer fake.describeConsumerGroupsMutex.Unlock()
+	fake.DescribeConsumerGroupsStub = stub
+}
+
+func (fake *SaramaClusterAdmin) DescribeConsumerGroupsArgsForCall(i int) []string {
+	fake.describeConsumerGroupsMutex.RLock()
+	defer fake.describeConsumerGroupsMutex.RUnlock()
+	argsForCall := fake.describeConsumerGroupsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *SaramaClusterAdmin) DescribeConsumerGroupsReturns(result1 []*sarama.GroupDescription, result2 error) {
+	fake.describeConsumerGroupsMutex.Lock()
+	defer fake.describeConsumerGroupsMutex.Unlock()
+	fake.DescribeConsumerGroupsStub = nil
+	fake.describeConsumerGroupsReturns = struct {
+		result1 []*sarama.GroupDescription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) DescribeConsumerGroupsReturnsOnCall(i int, result1 []*sarama.GroupDescription, result2 error) {
+	fake.describeConsumerGroupsMutex.Lock()
+	defer fake.describeConsumerGroupsMutex.Unlock()
+	fake.DescribeConsumerGroupsStub = nil
+	if fake.describeConsumerGroupsReturnsOnCall == nil {
+		fake.describeConsumerGroupsReturnsOnCall = make(map[int]struct {
+			result1 []*sarama.GroupDescription
+			result2 error
+		})
+	}
+	fake.describeConsumerGroupsReturnsOnCall[i] = struct {
+		result1 []*sarama.GroupDescription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) DescribeLogDirs(arg1 []int32) (map[int32][]sarama.DescribeLogDirsResponseDirMetadata, error) {
+	var arg1Copy []int32
+	if arg1 != nil {
+		arg1Copy = make([]int32, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.describeLogDirsMutex.Lock()
+	ret, specificReturn := fake.describeLogDirsReturnsOnCall[len(fake.describeLogDirsArgsForCall)]
+	fake.describeLogDirsArgsForCall = append(fake.describeLogDirsArgsForCall, struct {
+		arg1 []int32
+	}{arg1Copy})
+	stub := fake.DescribeLogDirsStub
+	fakeReturns := fake.describeLogDirsReturns
+	fake.recordInvocation("DescribeLogDirs", []interface{}{arg1Copy})
+	fake.describeLogDirsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *SaramaClusterAdmin) DescribeLogDirsCallCount() int {
+	fake.describeLogDirsMutex.RLock()
+	defer fake.describeLogDirsMutex.RUnlock()
+	return len(fake.describeLogDirsArgsForCall)
+}
+
+func (fake *SaramaClusterAdmin) DescribeLogDirsCalls(stub func([]int32) (map[int32][]sarama.DescribeLogDirsResponseDirMetadata, error)) {
+	fake.describeLogDirsMutex.Lock()
+	defer fake.describeLogDirsMutex.Unlock()
+	fake.DescribeLogDirsStub = stub
+}
+
+func (fake *SaramaClusterAdmin) DescribeLogDirsArgsForCall(i int) []int32 {
+	fake.describeLogDirsMutex.RLock()
+	defer fake.describeLogDirsMutex.RUnlock()
+	argsForCall := fake.describeLogDirsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *SaramaClusterAdmin) DescribeLogDirsReturns(result1 map[int32][]sarama.DescribeLogDirsResponseDirMetadata, result2 error) {
+	fake.describeLogDirsMutex.Lock()
+	defer fake.describeLogDirsMutex.Unlock()
+	fake.DescribeLogDirsStub = nil
+	fake.describeLogDirsReturns = struct {
+		result1 map[int32][]sarama.DescribeLogDirsResponseDirMetadata
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) DescribeLogDirsReturnsOnCall(i int, result1 map[int32][]sarama.DescribeLogDirsResponseDirMetadata, result2 error) {
+	fake.describeLogDirsMutex.Lock()
+	defer fake.describeLogDirsMutex.Unlock()
+	fake.DescribeLogDirsStub = nil
+	if fake.describeLogDirsReturnsOnCall == nil {
+		fake.describeLogDirsReturnsOnCall = make(map[int]struct {
+			result1 map[int32][]sarama.DescribeLogDirsResponseDirMetadata
+			result2 error
+		})
+	}
+	fake.describeLogDirsReturnsOnCall[i] = struct {
+		result1 map[int32][]sarama.DescribeLogDirsResponseDirMetadata
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) DescribeTopics(arg1 []string) ([]*sarama.TopicMetadata, error) {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.describeTopicsMutex.Lock()
+	ret, specificReturn := fake.describeTopicsReturnsOnCall[len(fake.describeTopicsArgsForCall)]
+	fake.describeTopicsArgsForCall = append(fake.describeTopicsArgsForCall, struct {
+		arg1 []string
+	}{arg1Copy})
+	stub := fake.DescribeTopicsStub
+	fakeReturns := fake.describeTopicsReturns
+	fake.recordInvocation("DescribeTopics", []interface{}{arg1Copy})
+	fake.describeTopicsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *SaramaClusterAdmin) DescribeTopicsCallCount() int {
+	fake.describeTopicsMutex.RLock()
+	defer fake.describeTopicsMutex.RUnlock()
+	return len(fake.describeTopicsArgsForCall)
+}
+
+func (fake *SaramaClusterAdmin) DescribeTopicsCalls(stub func([]string) ([]*sarama.TopicMetadata, error)) {
+	fake.describeTopicsMutex.Lock()
+	defer fake.describeTopicsMutex.Unlock()
+	fake.DescribeTopicsStub = stub
+}
+
+func (fake *SaramaClusterAdmin) DescribeTopicsArgsForCall(i int) []string {
+	fake.describeTopicsMutex.RLock()
+	defer fake.describeTopicsMutex.RUnlock()
+	argsForCall := fake.describeTopicsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *SaramaClusterAdmin) DescribeTopicsReturns(result1 []*sarama.TopicMetadata, result2 error) {
+	fake.describeTopicsMutex.Lock()
+	defer fake.describeTopicsMutex.Unlock()
+	fake.DescribeTopicsStub = nil
+	fake.describeTopicsReturns = struct {
+		result1 []*sarama.TopicMetadata
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) DescribeTopicsReturnsOnCall(i int, result1 []*sarama.TopicMetadata, result2 error) {
+	fake.describeTopicsMutex.Lock()
+	defer fake.describeTopicsMutex.Unlock()
+	fake.DescribeTopicsStub = nil
+	if fake.describeTopicsReturnsOnCall == nil {
+		fake.describeTopicsReturnsOnCall = make(map[int]struct {
+			result1 []*sarama.TopicMetadata
+			result2 error
+		})
+	}
+	fake.describeTopicsReturnsOnCall[i] = struct {
+		result1 []*sarama.TopicMetadata
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) DescribeUserScramCredentials(arg1 []string) ([]*sarama.DescribeUserScramCredentialsResult, error) {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.describeUserScramCredentialsMutex.Lock()
+	ret, specificReturn := fake.describeUserScramCredentialsReturnsOnCall[len(fake.describeUserScramCredentialsArgsForCall)]
+	fake.describeUserScramCredentialsArgsForCall = append(fake.describeUserScramCredentialsArgsForCall, struct {
+		arg1 []string
+	}{arg1Copy})
+	stub := fake.DescribeUserScramCredentialsStub
+	fakeReturns := fake.describeUserScramCredentialsReturns
+	fake.recordInvocation("DescribeUserScramCredentials", []interface{}{arg1Copy})
+	fake.describeUserScramCredentialsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *SaramaClusterAdmin) DescribeUserScramCredentialsCallCount() int {
+	fake.describeUserScramCredentialsMutex.RLock()
+	defer fake.describeUserScramCredentialsMutex.RUnlock()
+	return len(fake.describeUserScramCredentialsArgsForCall)
+}
+
+func (fake *SaramaClusterAdmin) DescribeUserScramCredentialsCalls(stub func([]string) ([]*sarama.DescribeUserScramCredentialsResult, error)) {
+	fake.describeUserScramCredentialsMutex.Lock()
+	defer fake.describeUserScramCredentialsMutex.Unlock()
+	fake.DescribeUserScramCredentialsStub = stub
+}
+
+func (fake *SaramaClusterAdmin) DescribeUserScramCredentialsArgsForCall(i int) []string {
+	fake.describeUserScramCredentialsMutex.RLock()
+	defer fake.describeUserScramCredentialsMutex.RUnlock()
+	argsForCall := fake.describeUserScramCredentialsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *SaramaClusterAdmin) DescribeUserScramCredentialsReturns(result1 []*sarama.DescribeUserScramCredentialsResult, result2 error) {
+	fake.describeUserScramCredentialsMutex.Lock()
+	defer fake.describeUserScramCredentialsMutex.Unlock()
+	fake.DescribeUserScramCredentialsStub = nil
+	fake.describeUserScramCredentialsReturns = struct {
+		result1 []*sarama.DescribeUserScramCredentialsResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) DescribeUserScramCredentialsReturnsOnCall(i int, result1 []*sarama.DescribeUserScramCredentialsResult, result2 error) {
+	fake.describeUserScramCredentialsMutex.Lock()
+	defer fake.describeUserScramCredentialsMutex.Unlock()
+	fake.DescribeUserScramCredentialsStub = nil
+	if fake.describeUserScramCredentialsReturnsOnCall == nil {
+		fake.describeUserScramCredentialsReturnsOnCall = make(map[int]struct {
+			result1 []*sarama.DescribeUserScramCredentialsResult
+			result2 error
+		})
+	}
+	fake.describeUserScramCredentialsReturnsOnCall[i] = struct {
+		result1 []*sarama.DescribeUserScramCredentialsResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) ElectLeaders(arg1 sarama.ElectionType, arg2 map[string][]int32) (map[string]map[int32]*sarama.PartitionResult, error) {
+	fake.electLeadersMutex.Lock()
+	ret, specificReturn := fake.electLeadersReturnsOnCall[len(fake.electLeadersArgsForCall)]
+	fake.electLeadersArgsForCall = append(fake.electLeadersArgsForCall, struct {
+		arg1 sarama.ElectionType
+		arg2 map[string][]int32
+	}{arg1, arg2})
+	stub := fake.ElectLeadersStub
+	fakeReturns := fake.electLeadersReturns
+	fake.recordInvocation("ElectLeaders", []interface{}{arg1, arg2})
+	fake.electLeadersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *SaramaClusterAdmin) ElectLeadersCallCount() int {
+	fake.electLeadersMutex.RLock()
+	defer fake.electLeadersMutex.RUnlock()
+	return len(fake.electLeadersArgsForCall)
+}
+
+func (fake *SaramaClusterAdmin) ElectLeadersCalls(stub func(sarama.ElectionType, map[string][]int32) (map[string]map[int32]*sarama.PartitionResult, error)) {
+	fake.electLeadersMutex.Lock()
+	defer fake.electLeadersMutex.Unlock()
+	fake.ElectLeadersStub = stub
+}
+
+func (fake *SaramaClusterAdmin) ElectLeadersArgsForCall(i int) (sarama.ElectionType, map[string][]int32) {
+	fake.electLeadersMutex.RLock()
+	defer fake.electLeadersMutex.RUnlock()
+	argsForCall := fake.electLeadersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *SaramaClusterAdmin) ElectLeadersReturns(result1 map[string]map[int32]*sarama.PartitionResult, result2 error) {
+	fake.electLeadersMutex.Lock()
+	defer fake.electLeadersMutex.Unlock()
+	fake.ElectLeadersStub = nil
+	fake.electLeadersReturns = struct {
+		result1 map[string]map[int32]*sarama.PartitionResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) ElectLeadersReturnsOnCall(i int, result1 map[string]map[int32]*sarama.PartitionResult, result2 error) {
+	fake.electLeadersMutex.Lock()
+	defer fake.electLeadersMutex.Unlock()
+	fake.ElectLeadersStub = nil
+	if fake.electLeadersReturnsOnCall == nil {
+		fake.electLeadersReturnsOnCall = make(map[int]struct {
+			result1 map[string]map[int32]*sarama.PartitionResult
+			result2 error
+		})
+	}
+	fake.electLeadersReturnsOnCall[i] = struct {
+		result1 map[string]map[int32]*sarama.PartitionResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) IncrementalAlterConfig(arg1 sarama.ConfigResourceType, arg2 string, arg3 map[string]sarama.IncrementalAlterConfigsEntry, arg4 bool) error {
+	fake.incrementalAlterConfigMutex.Lock()
+	ret, specificReturn := fake.incrementalAlterConfigReturnsOnCall[len(fake.incrementalAlterConfigArgsForCall)]
+	fake.incrementalAlterConfigArgsForCall = append(fake.incrementalAlterConfigArgsForCall, struct {
+		arg1 sarama.ConfigResourceType
+		arg2 string
+		arg3 map[string]sarama.IncrementalAlterConfigsEntry
+		arg4 bool
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.IncrementalAlterConfigStub
+	fakeReturns := fake.incrementalAlterConfigReturns
+	fake.recordInvocation("IncrementalAlterConfig", []interface{}{arg1, arg2, arg3, arg4})
+	fake.incrementalAlterConfigMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *SaramaClusterAdmin) IncrementalAlterConfigCallCount() int {
+	fake.incrementalAlterConfigMutex.RLock()
+	defer fake.incrementalAlterConfigMutex.RUnlock()
+	return len(fake.incrementalAlterConfigArgsForCall)
+}
+
+func (fake *SaramaClusterAdmin) IncrementalAlterConfigCalls(stub func(sarama.ConfigResourceType, string, map[string]sarama.IncrementalAlterConfigsEntry, bool) error) {
+	fake.incrementalAlterConfigMutex.Lock()
+	defer fake.incrementalAlterConfigMutex.Unlock()
+	fake.IncrementalAlterConfigStub = stub
+}
+
+func (fake *SaramaClusterAdmin) IncrementalAlterConfigArgsForCall(i int) (sarama.ConfigResourceType, string, map[string]sarama.IncrementalAlterConfigsEntry, bool) {
+	fake.incrementalAlterConfigMutex.RLock()
+	defer fake.incrementalAlterConfigMutex.RUnlock()
+	argsForCall := fake.incrementalAlterConfigArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *SaramaClusterAdmin) IncrementalAlterConfigReturns(result1 error) {
+	fake.incrementalAlterConfigMutex.Lock()
+	defer fake.incrementalAlterConfigMutex.Unlock()
+	fake.IncrementalAlterConfigStub = nil
+	fake.incrementalAlterConfigReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *SaramaClusterAdmin) IncrementalAlterConfigReturnsOnCall(i int, result1 error) {
+	fake.incrementalAlterConfigMutex.Lock()
+	defer fake.incrementalAlterConfigMutex.Unlock()
+	fake.IncrementalAlterConfigStub = nil
+	if fake.incrementalAlterConfigReturnsOnCall == nil {
+		fake.incrementalAlterConfigReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.incrementalAlterConfigReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *SaramaClusterAdmin) ListAcls(arg1 sarama.AclFilter) ([]sarama.ResourceAcls, error) {
+	fake.listAclsMutex.Lock()
+	ret, specificReturn := fake.listAclsReturnsOnCall[len(fake.listAclsArgsForCall)]
+	fake.listAclsArgsForCall = append(fake.listAclsArgsForCall, struct {
+		arg1 sarama.AclFilter
+	}{arg1})
+	stub := fake.ListAclsStub
+	fakeReturns := fake.listAclsReturns
+	fake.recordInvocation("ListAcls", []interface{}{arg1})
+	fake.listAclsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *SaramaClusterAdmin) ListAclsCallCount() int {
+	fake.listAclsMutex.RLock()
+	defer fake.listAclsMutex.RUnlock()
+	return len(fake.listAclsArgsForCall)
+}
+
+func (fake *SaramaClusterAdmin) ListAclsCalls(stub func(sarama.AclFilter) ([]sarama.ResourceAcls, error)) {
+	fake.listAclsMutex.Lock()
+	defer fake.listAclsMutex.Unlock()
+	fake.ListAclsStub = stub
+}
+
+func (fake *SaramaClusterAdmin) ListAclsArgsForCall(i int) sarama.AclFilter {
+	fake.listAclsMutex.RLock()
+	defer fake.listAclsMutex.RUnlock()
+	argsForCall := fake.listAclsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *SaramaClusterAdmin) ListAclsReturns(result1 []sarama.ResourceAcls, result2 error) {
+	fake.listAclsMutex.Lock()
+	defer fake.listAclsMutex.Unlock()
+	fake.ListAclsStub = nil
+	fake.listAclsReturns = struct {
+		result1 []sarama.ResourceAcls
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) ListAclsReturnsOnCall(i int, result1 []sarama.ResourceAcls, result2 error) {
+	fake.listAclsMutex.Lock()
+	defer fake.listAclsMutex.Unlock()
+	fake.ListAclsStub = nil
+	if fake.listAclsReturnsOnCall == nil {
+		fake.listAclsReturnsOnCall = make(map[int]struct {
+			result1 []sarama.ResourceAcls
+			result2 error
+		})
+	}
+	fake.listAclsReturnsOnCall[i] = struct {
+		result1 []sarama.ResourceAcls
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) ListConsumerGroupOffsets(arg1 string, arg2 map[string][]int32) (*sarama.OffsetFetchResponse, error) {
+	fake.listConsumerGroupOffsetsMutex.Lock()
+	ret, specificReturn := fake.listConsumerGroupOffsetsReturnsOnCall[len(fake.listConsumerGroupOffsetsArgsForCall)]
+	fake.listConsumerGroupOffsetsArgsForCall = append(fake.listConsumerGroupOffsetsArgsForCall, struct {
+		arg1 string
+		arg2 map[string][]int32
+	}{arg1, arg2})
+	stub := fake.ListConsumerGroupOffsetsStub
+	fakeReturns := fake.listConsumerGroupOffsetsReturns
+	fake.recordInvocation("ListConsumerGroupOffsets", []interface{}{arg1, arg2})
+	fake.listConsumerGroupOffsetsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *SaramaClusterAdmin) ListConsumerGroupOffsetsCallCount() int {
+	fake.listConsumerGroupOffsetsMutex.RLock()
+	defer fake.listConsumerGroupOffsetsMutex.RUnlock()
+	return len(fake.listConsumerGroupOffsetsArgsForCall)
+}
+
+func (fake *SaramaClusterAdmin) ListConsumerGroupOffsetsCalls(stub func(string, map[string][]int32) (*sarama.OffsetFetchResponse, error)) {
+	fake.listConsumerGroupOffsetsMutex.Lock()
+	defer fake.listConsumerGroupOffsetsMutex.Unlock()
+	fake.ListConsumerGroupOffsetsStub = stub
+}
+
+func (fake *SaramaClusterAdmin) ListConsumerGroupOffsetsArgsForCall(i int) (string, map[string][]int32) {
+	fake.listConsumerGroupOffsetsMutex.RLock()
+	defer fake.listConsumerGroupOffsetsMutex.RUnlock()
+	argsForCall := fake.listConsumerGroupOffsetsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *SaramaClusterAdmin) ListConsumerGroupOffsetsReturns(result1 *sarama.OffsetFetchResponse, result2 error) {
+	fake.listConsumerGroupOffsetsMutex.Lock()
+	defer fake.listConsumerGroupOffsetsMutex.Unlock()
+	fake.ListConsumerGroupOffsetsStub = nil
+	fake.listConsumerGroupOffsetsReturns = struct {
+		result1 *sarama.OffsetFetchResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) ListConsumerGroupOffsetsReturnsOnCall(i int, result1 *sarama.OffsetFetchResponse, result2 error) {
+	fake.listConsumerGroupOffsetsMutex.Lock()
+	defer fake.listConsumerGroupOffsetsMutex.Unlock()
+	fake.ListConsumerGroupOffsetsStub = nil
+	if fake.listConsumerGroupOffsetsReturnsOnCall == nil {
+		fake.listConsumerGroupOffsetsReturnsOnCall = make(map[int]struct {
+			result1 *sarama.OffsetFetchResponse
+			result2 error
+		})
+	}
+	fake.listConsumerGroupOffsetsReturnsOnCall[i] = struct {
+		result1 *sarama.OffsetFetchResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) ListConsumerGroupOffsetsBatch(arg1 map[string]map[string][]int32) (map[string]*sarama.OffsetFetchResponseGroup, error) {
+	fake.listConsumerGroupOffsetsBatchMutex.Lock()
+	ret, specificReturn := fake.listConsumerGroupOffsetsBatchReturnsOnCall[len(fake.listConsumerGroupOffsetsBatchArgsForCall)]
+	fake.listConsumerGroupOffsetsBatchArgsForCall = append(fake.listConsumerGroupOffsetsBatchArgsForCall, struct {
+		arg1 map[string]map[string][]int32
+	}{arg1})
+	stub := fake.ListConsumerGroupOffsetsBatchStub
+	fakeReturns := fake.listConsumerGroupOffsetsBatchReturns
+	fake.recordInvocation("ListConsumerGroupOffsetsBatch", []interface{}{arg1})
+	fake.listConsumerGroupOffsetsBatchMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *SaramaClusterAdmin) ListConsumerGroupOffsetsBatchCallCount() int {
+	fake.listConsumerGroupOffsetsBatchMutex.RLock()
+	defer fake.listConsumerGroupOffsetsBatchMutex.RUnlock()
+	return len(fake.listConsumerGroupOffsetsBatchArgsForCall)
+}
+
+func (fake *SaramaClusterAdmin) ListConsumerGroupOffsetsBatchCalls(stub func(map[string]map[string][]int32) (map[string]*sarama.OffsetFetchResponseGroup, error)) {
+	fake.listConsumerGroupOffsetsBatchMutex.Lock()
+	defer fake.listConsumerGroupOffsetsBatchMutex.Unlock()
+	fake.ListConsumerGroupOffsetsBatchStub = stub
+}
+
+func (fake *SaramaClusterAdmin) ListConsumerGroupOffsetsBatchArgsForCall(i int) map[string]map[string][]int32 {
+	fake.listConsumerGroupOffsetsBatchMutex.RLock()
+	defer fake.listConsumerGroupOffsetsBatchMutex.RUnlock()
+	argsForCall := fake.listConsumerGroupOffsetsBatchArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *SaramaClusterAdmin) ListConsumerGroupOffsetsBatchReturns(result1 map[string]*sarama.OffsetFetchResponseGroup, result2 error) {
+	fake.listConsumerGroupOffsetsBatchMutex.Lock()
+	defer fake.listConsumerGroupOffsetsBatchMutex.Unlock()
+	fake.ListConsumerGroupOffsetsBatchStub = nil
+	fake.listConsumerGroupOffsetsBatchReturns = struct {
+		result1 map[string]*sarama.OffsetFetchResponseGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) ListConsumerGroupOffsetsBatchReturnsOnCall(i int, result1 map[string]*sarama.OffsetFetchResponseGroup, result2 error) {
+	fake.listConsumerGroupOffsetsBatchMutex.Lock()
+	defer fake.listConsumerGroupOffsetsBatchMutex.Unlock()
+	fake.ListConsumerGroupOffsetsBatchStub = nil
+	if fake.listConsumerGroupOffsetsBatchReturnsOnCall == nil {
+		fake.listConsumerGroupOffsetsBatchReturnsOnCall = make(map[int]struct {
+			result1 map[string]*sarama.OffsetFetchResponseGroup
+			result2 error
+		})
+	}
+	fake.listConsumerGroupOffsetsBatchReturnsOnCall[i] = struct {
+		result1 map[string]*sarama.OffsetFetchResponseGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) ListConsumerGroups() (map[string]string, error) {
+	fake.listConsumerGroupsMutex.Lock()
+	ret, specificReturn := fake.listConsumerGroupsReturnsOnCall[len(fake.listConsumerGroupsArgsForCall)]
+	fake.listConsumerGroupsArgsForCall = append(fake.listConsumerGroupsArgsForCall, struct {
+	}{})
+	stub := fake.ListConsumerGroupsStub
+	fakeReturns := fake.listConsumerGroupsReturns
+	fake.recordInvocation("ListConsumerGroups", []interface{}{})
+	fake.listConsumerGroupsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *SaramaClusterAdmin) ListConsumerGroupsCallCount() int {
+	fake.listConsumerGroupsMutex.RLock()
+	defer fake.listConsumerGroupsMutex.RUnlock()
+	return len(fake.listConsumerGroupsArgsForCall)
+}
+
+func (fake *SaramaClusterAdmin) ListConsumerGroupsCalls(stub func() (map[string]string, error)) {
+	fake.listConsumerGroupsMutex.Lock()
+	defer fake.listConsumerGroupsMutex.Unlock()
+	fake.ListConsumerGroupsStub = stub
+}
+
+func (fake *SaramaClusterAdmin) ListConsumerGroupsReturns(result1 map[string]string, result2 error) {
+	fake.listConsumerGroupsMutex.Lock()
+	defer fake.listConsumerGroupsMutex.Unlock()
+	fake.ListConsumerGroupsStub = nil
+	fake.listConsumerGroupsReturns = struct {
+		result1 map[string]string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) ListConsumerGroupsReturnsOnCall(i int, result1 map[string]string, result2 error) {
+	fake.listConsumerGroupsMutex.Lock()
+	defer fake.listConsumerGroupsMutex.Unlock()
+	fake.ListConsumerGroupsStub = nil
+	if fake.listConsumerGroupsReturnsOnCall == nil {
+		fake.listConsumerGroupsReturnsOnCall = make(map[int]struct {
+			result1 map[string]string
+			result2 error
+		})
+	}
+	fake.listConsumerGroupsReturnsOnCall[i] = struct {
+		result1 map[string]string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) ListOffsets(arg1 map[string]map[int32]int64, arg2 *sarama.ListOffsetsOptions) (map[string]map[int32]*sarama.OffsetResult, error) {
+	fake.listOffsetsMutex.Lock()
+	ret, specificReturn := fake.listOffsetsReturnsOnCall[len(fake.listOffsetsArgsForCall)]
+	fake.listOffsetsArgsForCall = append(fake.listOffsetsArgsForCall, struct {
+		arg1 map[string]map[int32]int64
+		arg2 *sarama.ListOffsetsOptions
+	}{arg1, arg2})
+	stub := fake.ListOffsetsStub
+	fakeReturns := fake.listOffsetsReturns
+	fake.recordInvocation("ListOffsets", []interface{}{arg1, arg2})
+	fake.listOffsetsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *SaramaClusterAdmin) ListOffsetsCallCount() int {
+	fake.listOffsetsMutex.RLock()
+	defer fake.listOffsetsMutex.RUnlock()
+	return len(fake.listOffsetsArgsForCall)
+}
+
+func (fake *SaramaClusterAdmin) ListOffsetsCalls(stub func(map[string]map[int32]int64, *sarama.ListOffsetsOptions) (map[string]map[int32]*sarama.OffsetResult, error)) {
+	fake.listOffsetsMutex.Lock()
+	defer fake.listOffsetsMutex.Unlock()
+	fake.ListOffsetsStub = stub
+}
+
+func (fake *SaramaClusterAdmin) ListOffsetsArgsForCall(i int) (map[string]map[int32]int64, *sarama.ListOffsetsOptions) {
+	fake.listOffsetsMutex.RLock()
+	defer fake.listOffsetsMutex.RUnlock()
+	argsForCall := fake.listOffsetsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *SaramaClusterAdmin) ListOffsetsReturns(result1 map[string]map[int32]*sarama.OffsetResult, result2 error) {
+	fake.listOffsetsMutex.Lock()
+	defer fake.listOffsetsMutex.Unlock()
+	fake.ListOffsetsStub = nil
+	fake.listOffsetsReturns = struct {
+		result1 map[string]map[int32]*sarama.OffsetResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) ListOffsetsReturnsOnCall(i int, result1 map[string]map[int32]*sarama.OffsetResult, result2 error) {
+	fake.listOffsetsMutex.Lock()
+	defer fake.listOffsetsMutex.Unlock()
+	fake.ListOffsetsStub = nil
+	if fake.listOffsetsReturnsOnCall == nil {
+		fake.listOffsetsReturnsOnCall = make(map[int]struct {
+			result1 map[string]map[int32]*sarama.OffsetResult
+			result2 error
+		})
+	}
+	fake.listOffsetsReturnsOnCall[i] = struct {
+		result1 map[string]map[int32]*sarama.OffsetResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) ListPartitionReassignments(arg1 string, arg2 []int32) (map[string]map[int32]*sarama.PartitionReplicaReassignmentsStatus, error) {
+	var arg2Copy []int32
+	if arg2 != nil {
+		arg2Copy = make([]int32, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.listPartitionReassignmentsMutex.Lock()
+	ret, specificReturn := fake.listPartitionReassignmentsReturnsOnCall[len(fake.listPartitionReassignmentsArgsForCall)]
+	fake.listPartitionReassignmentsArgsForCall = append(fake.listPartitionReassignmentsArgsForCall, struct {
+		arg1 string
+		arg2 []int32
+	}{arg1, arg2Copy})
+	stub := fake.ListPartitionReassignmentsStub
+	fakeReturns := fake.listPartitionReassignmentsReturns
+	fake.recordInvocation("ListPartitionReassignments", []interface{}{arg1, arg2Copy})
+	fake.listPartitionReassignmentsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *SaramaClusterAdmin) ListPartitionReassignmentsCallCount() int {
+	fake.listPartitionReassignmentsMutex.RLock()
+	defer fake.listPartitionReassignmentsMutex.RUnlock()
+	return len(fake.listPartitionReassignmentsArgsForCall)
+}
+
+func (fake *SaramaClusterAdmin) ListPartitionReassignmentsCalls(stub func(string, []int32) (map[string]map[int32]*sarama.PartitionReplicaReassignmentsStatus, error)) {
+	fake.listPartitionReassignmentsMutex.Lock()
+	defer fake.listPartitionReassignmentsMutex.Unlock()
+	fake.ListPartitionReassignmentsStub = stub
+}
+
+func (fake *SaramaClusterAdmin) ListPartitionReassignmentsArgsForCall(i int) (string, []int32) {
+	fake.listPartitionReassignmentsMutex.RLock()
+	defer fake.listPartitionReassignmentsMutex.RUnlock()
+	argsForCall := fake.listPartitionReassignmentsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *SaramaClusterAdmin) ListPartitionReassignmentsReturns(result1 map[string]map[int32]*sarama.PartitionReplicaReassignmentsStatus, result2 error) {
+	fake.listPartitionReassignmentsMutex.Lock()
+	defer fake.listPartitionReassignmentsMutex.Unlock()
+	fake.ListPartitionReassignmentsStub = nil
+	fake.listPartitionReassignmentsReturns = struct {
+		result1 map[string]map[int32]*sarama.PartitionReplicaReassignmentsStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) ListPartitionReassignmentsReturnsOnCall(i int, result1 map[string]map[int32]*sarama.PartitionReplicaReassignmentsStatus, result2 error) {
+	fake.listPartitionReassignmentsMutex.Lock()
+	defer fake.listPartitionReassignmentsMutex.Unlock()
+	fake.ListPartitionReassignmentsStub = nil
+	if fake.listPartitionReassignmentsReturnsOnCall == nil {
+		fake.listPartitionReassignmentsReturnsOnCall = make(map[int]struct {
+			result1 map[string]map[int32]*sarama.PartitionReplicaReassignmentsStatus
+			result2 error
+		})
+	}
+	fake.listPartitionReassignmentsReturnsOnCall[i] = struct {
+		result1 map[string]map[int32]*sarama.PartitionReplicaReassignmentsStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) ListTopics() (map[string]sarama.TopicDetail, error) {
+	fake.listTopicsMutex.Lock()
+	ret, specificReturn := fake.listTopicsReturnsOnCall[len(fake.listTopicsArgsForCall)]
+	fake.listTopicsArgsForCall = append(fake.listTopicsArgsForCall, struct {
+	}{})
+	stub := fake.ListTopicsStub
+	fakeReturns := fake.listTopicsReturns
+	fake.recordInvocation("ListTopics", []interface{}{})
+	fake.listTopicsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *SaramaClusterAdmin) ListTopicsCallCount() int {
+	fake.listTopicsMutex.RLock()
+	defer fake.listTopicsMutex.RUnlock()
+	return len(fake.listTopicsArgsForCall)
+}
+
+func (fake *SaramaClusterAdmin) ListTopicsCalls(stub func() (map[string]sarama.TopicDetail, error)) {
+	fake.listTopicsMutex.Lock()
+	defer fake.listTopicsMutex.Unlock()
+	fake.ListTopicsStub = stub
+}
+
+func (fake *SaramaClusterAdmin) ListTopicsReturns(result1 map[string]sarama.TopicDetail, result2 error) {
+	fake.listTopicsMutex.Lock()
+	defer fake.listTopicsMutex.Unlock()
+	fake.ListTopicsStub = nil
+	fake.listTopicsReturns = struct {
+		result1 map[string]sarama.TopicDetail
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) ListTopicsReturnsOnCall(i int, result1 map[string]sarama.TopicDetail, result2 error) {
+	fake.listTopicsMutex.Lock()
+	defer fake.listTopicsMutex.Unlock()
+	fake.ListTopicsStub = nil
+	if fake.listTopicsReturnsOnCall == nil {
+		fake.listTopicsReturnsOnCall = make(map[int]struct {
+			result1 map[string]sarama.TopicDetail
+			result2 error
+		})
+	}
+	fake.listTopicsReturnsOnCall[i] = struct {
+		result1 map[string]sarama.TopicDetail
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) RemoveMemberFromConsumerGroup(arg1 string, arg2 []string) (*sarama.LeaveGroupResponse, error) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.removeMemberFromConsumerGroupMutex.Lock()
+	ret, specificReturn := fake.removeMemberFromConsumerGroupReturnsOnCall[len(fake.removeMemberFromConsumerGroupArgsForCall)]
+	fake.removeMemberFromConsumerGroupArgsForCall = append(fake.removeMemberFromConsumerGroupArgsForCall, struct {
+		arg1 string
+		arg2 []string
+	}{arg1, arg2Copy})
+	stub := fake.RemoveMemberFromConsumerGroupStub
+	fakeReturns := fake.removeMemberFromConsumerGroupReturns
+	fake.recordInvocation("RemoveMemberFromConsumerGroup", []interface{}{arg1, arg2Copy})
+	fake.removeMemberFromConsumerGroupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *SaramaClusterAdmin) RemoveMemberFromConsumerGroupCallCount() int {
+	fake.removeMemberFromConsumerGroupMutex.RLock()
+	defer fake.removeMemberFromConsumerGroupMutex.RUnlock()
+	return len(fake.removeMemberFromConsumerGroupArgsForCall)
+}
+
+func (fake *SaramaClusterAdmin) RemoveMemberFromConsumerGroupCalls(stub func(string, []string) (*sarama.LeaveGroupResponse, error)) {
+	fake.removeMemberFromConsumerGroupMutex.Lock()
+	defer fake.removeMemberFromConsumerGroupMutex.Unlock()
+	fake.RemoveMemberFromConsumerGroupStub = stub
+}
+
+func (fake *SaramaClusterAdmin) RemoveMemberFromConsumerGroupArgsForCall(i int) (string, []string) {
+	fake.removeMemberFromConsumerGroupMutex.RLock()
+	defer fake.removeMemberFromConsumerGroupMutex.RUnlock()
+	argsForCall := fake.removeMemberFromConsumerGroupArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *SaramaClusterAdmin) RemoveMemberFromConsumerGroupReturns(result1 *sarama.LeaveGroupResponse, result2 error) {
+	fake.removeMemberFromConsumerGroupMutex.Lock()
+	defer fake.removeMemberFromConsumerGroupMutex.Unlock()
+	fake.RemoveMemberFromConsumerGroupStub = nil
+	fake.removeMemberFromConsumerGroupReturns = struct {
+		result1 *sarama.LeaveGroupResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) RemoveMemberFromConsumerGroupReturnsOnCall(i int, result1 *sarama.LeaveGroupResponse, result2 error) {
+	fake.removeMemberFromConsumerGroupMutex.Lock()
+	defer fake.removeMemberFromConsumerGroupMutex.Unlock()
+	fake.RemoveMemberFromConsumerGroupStub = nil
+	if fake.removeMemberFromConsumerGroupReturnsOnCall == nil {
+		fake.removeMemberFromConsumerGroupReturnsOnCall = make(map[int]struct {
+			result1 *sarama.LeaveGroupResponse
+			result2 error
+		})
+	}
+	fake.removeMemberFromConsumerGroupReturnsOnCall[i] = struct {
+		result1 *sarama.LeaveGroupResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) UpdateFeatures(arg1 []sarama.FeatureUpdate) ([]sarama.UpdatableFeatureResult, error) {
+	var arg1Copy []sarama.FeatureUpdate
+	if arg1 != nil {
+		arg1Copy = make([]sarama.FeatureUpdate, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.updateFeaturesMutex.Lock()
+	ret, specificReturn := fake.updateFeaturesReturnsOnCall[len(fake.updateFeaturesArgsForCall)]
+	fake.updateFeaturesArgsForCall = append(fake.updateFeaturesArgsForCall, struct {
+		arg1 []sarama.FeatureUpdate
+	}{arg1Copy})
+	stub := fake.UpdateFeaturesStub
+	fakeReturns := fake.updateFeaturesReturns
+	fake.recordInvocation("UpdateFeatures", []interface{}{arg1Copy})
+	fake.updateFeaturesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *SaramaClusterAdmin) UpdateFeaturesCallCount() int {
+	fake.updateFeaturesMutex.RLock()
+	defer fake.updateFeaturesMutex.RUnlock()
+	return len(fake.updateFeaturesArgsForCall)
+}
+
+func (fake *SaramaClusterAdmin) UpdateFeaturesCalls(stub func([]sarama.FeatureUpdate) ([]sarama.UpdatableFeatureResult, error)) {
+	fake.updateFeaturesMutex.Lock()
+	defer fake.updateFeaturesMutex.Unlock()
+	fake.UpdateFeaturesStub = stub
+}
+
+func (fake *SaramaClusterAdmin) UpdateFeaturesArgsForCall(i int) []sarama.FeatureUpdate {
+	fake.updateFeaturesMutex.RLock()
+	defer fake.updateFeaturesMutex.RUnlock()
+	argsForCall := fake.updateFeaturesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *SaramaClusterAdmin) UpdateFeaturesReturns(result1 []sarama.UpdatableFeatureResult, result2 error) {
+	fake.updateFeaturesMutex.Lock()
+	defer fake.updateFeaturesMutex.Unlock()
+	fake.UpdateFeaturesStub = nil
+	fake.updateFeaturesReturns = struct {
+		result1 []sarama.UpdatableFeatureResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) UpdateFeaturesReturnsOnCall(i int, result1 []sarama.UpdatableFeatureResult, result2 error) {
+	fake.updateFeaturesMutex.Lock()
+	defer fake.updateFeaturesMutex.Unlock()
+	fake.UpdateFeaturesStub = nil
+	if fake.updateFeaturesReturnsOnCall == nil {
+		fake.updateFeaturesReturnsOnCall = make(map[int]struct {
+			result1 []sarama.UpdatableFeatureResult
+			result2 error
+		})
+	}
+	fake.updateFeaturesReturnsOnCall[i] = struct {
+		result1 []sarama.UpdatableFeatureResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) UpsertUserScramCredentials(arg1 []sarama.AlterUserScramCredentialsUpsert) ([]*sarama.AlterUserScramCredentialsResult, error) {
+	var arg1Copy []sarama.AlterUserScramCredentialsUpsert
+	if arg1 != nil {
+		arg1Copy = make([]sarama.AlterUserScramCredentialsUpsert, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.upsertUserScramCredentialsMutex.Lock()
+	ret, specificReturn := fake.upsertUserScramCredentialsReturnsOnCall[len(fake.upsertUserScramCredentialsArgsForCall)]
+	fake.upsertUserScramCredentialsArgsForCall = append(fake.upsertUserScramCredentialsArgsForCall, struct {
+		arg1 []sarama.AlterUserScramCredentialsUpsert
+	}{arg1Copy})
+	stub := fake.UpsertUserScramCredentialsStub
+	fakeReturns := fake.upsertUserScramCredentialsReturns
+	fake.recordInvocation("UpsertUserScramCredentials", []interface{}{arg1Copy})
+	fake.upsertUserScramCredentialsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *SaramaClusterAdmin) UpsertUserScramCredentialsCallCount() int {
+	fake.upsertUserScramCredentialsMutex.RLock()
+	defer fake.upsertUserScramCredentialsMutex.RUnlock()
+	return len(fake.upsertUserScramCredentialsArgsForCall)
+}
+
+func (fake *SaramaClusterAdmin) UpsertUserScramCredentialsCalls(stub func([]sarama.AlterUserScramCredentialsUpsert) ([]*sarama.AlterUserScramCredentialsResult, error)) {
+	fake.upsertUserScramCredentialsMutex.Lock()
+	defer fake.upsertUserScramCredentialsMutex.Unlock()
+	fake.UpsertUserScramCredentialsStub = stub
+}
+
+func (fake *SaramaClusterAdmin) UpsertUserScramCredentialsArgsForCall(i int) []sarama.AlterUserScramCredentialsUpsert {
+	fake.upsertUserScramCredentialsMutex.RLock()
+	defer fake.upsertUserScramCredentialsMutex.RUnlock()
+	argsForCall := fake.upsertUserScramCredentialsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *SaramaClusterAdmin) UpsertUserScramCredentialsReturns(result1 []*sarama.AlterUserScramCredentialsResult, result2 error) {
+	fake.upsertUserScramCredentialsMutex.Lock()
+	defer fake.upsertUserScramCredentialsMutex.Unlock()
+	fake.UpsertUserScramCredentialsStub = nil
+	fake.upsertUserScramCredentialsReturns = struct {
+		result1 []*sarama.AlterUserScramCredentialsResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) UpsertUserScramCredentialsReturnsOnCall(i int, result1 []*sarama.AlterUserScramCredentialsResult, result2 error) {
+	fake.upsertUserScramCredentialsMutex.Lock()
+	defer fake.upsertUserScramCredentialsMutex.Unlock()
+	fake.UpsertUserScramCredentialsStub = nil
+	if fake.upsertUserScramCredentialsReturnsOnCall == nil {
+		fake.upsertUserScramCredentialsReturnsOnCall = make(map[int]struct {
+			result1 []*sarama.AlterUserScramCredentialsResult
+			result2 error
+		})
+	}
+	fake.upsertUserScramCredentialsReturnsOnCall[i] = struct {
+		result1 []*sarama.AlterUserScramCredentialsResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SaramaClusterAdmin) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *SaramaClusterAdmin) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ sarama.ClusterAdmin = new(SaramaClusterAdmin)
@@ -0,0 +1,129 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"iter"
+	"sync"
+
+	"github.com/bborbe/kafka"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+type Scanner struct {
+	ScanStub        func(context.Context, kafka.Topic, kafka.Partition, kafka.Offset, kafka.Offset, []byte, pkg.FetchConfig) iter.Seq2[pkg.ScannedMessage, error]
+	scanMutex       sync.RWMutex
+	scanArgsForCall []struct {
+		arg1 context.Context
+		arg2 kafka.Topic
+		arg3 kafka.Partition
+		arg4 kafka.Offset
+		arg5 kafka.Offset
+		arg6 []byte
+		arg7 pkg.FetchConfig
+	}
+	scanReturns struct {
+		result1 iter.Seq2[pkg.ScannedMessage, error]
+	}
+	scanReturnsOnCall map[int]struct {
+		result1 iter.Seq2[pkg.ScannedMessage, error]
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *Scanner) Scan(arg1 context.Context, arg2 kafka.Topic, arg3 kafka.Partition, arg4 kafka.Offset, arg5 kafka.Offset, arg6 []byte, arg7 pkg.FetchConfig) iter.Seq2[pkg.ScannedMessage, error] {
+	var arg6Copy []byte
+	if arg6 != nil {
+		arg6Copy = make([]byte, len(arg6))
+		copy(arg6Copy, arg6)
+	}
+	fake.scanMutex.Lock()
+	ret, specificReturn := fake.scanReturnsOnCall[len(fake.scanArgsForCall)]
+	fake.scanArgsForCall = append(fake.scanArgsForCall, struct {
+		arg1 context.Context
+		arg2 kafka.Topic
+		arg3 kafka.Partition
+		arg4 kafka.Offset
+		arg5 kafka.Offset
+		arg6 []byte
+		arg7 pkg.FetchConfig
+	}{arg1, arg2, arg3, arg4, arg5, arg6Copy, arg7})
+	stub := fake.ScanStub
+	fakeReturns := fake.scanReturns
+	fake.recordInvocation("Scan", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6Copy, arg7})
+	fake.scanMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Scanner) ScanCallCount() int {
+	fake.scanMutex.RLock()
+	defer fake.scanMutex.RUnlock()
+	return len(fake.scanArgsForCall)
+}
+
+func (fake *Scanner) ScanCalls(stub func(context.Context, kafka.Topic, kafka.Partition, kafka.Offset, kafka.Offset, []byte, pkg.FetchConfig) iter.Seq2[pkg.ScannedMessage, error]) {
+	fake.scanMutex.Lock()
+	defer fake.scanMutex.Unlock()
+	fake.ScanStub = stub
+}
+
+func (fake *Scanner) ScanArgsForCall(i int) (context.Context, kafka.Topic, kafka.Partition, kafka.Offset, kafka.Offset, []byte, pkg.FetchConfig) {
+	fake.scanMutex.RLock()
+	defer fake.scanMutex.RUnlock()
+	argsForCall := fake.scanArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
+}
+
+func (fake *Scanner) ScanReturns(result1 iter.Seq2[pkg.ScannedMessage, error]) {
+	fake.scanMutex.Lock()
+	defer fake.scanMutex.Unlock()
+	fake.ScanStub = nil
+	fake.scanReturns = struct {
+		result1 iter.Seq2[pkg.ScannedMessage, error]
+	}{result1}
+}
+
+func (fake *Scanner) ScanReturnsOnCall(i int, result1 iter.Seq2[pkg.ScannedMessage, error]) {
+	fake.scanMutex.Lock()
+	defer fake.scanMutex.Unlock()
+	fake.ScanStub = nil
+	if fake.scanReturnsOnCall == nil {
+		fake.scanReturnsOnCall = make(map[int]struct {
+			result1 iter.Seq2[pkg.ScannedMessage, error]
+		})
+	}
+	fake.scanReturnsOnCall[i] = struct {
+		result1 iter.Seq2[pkg.ScannedMessage, error]
+	}{result1}
+}
+
+func (fake *Scanner) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *Scanner) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pkg.Scanner = new(Scanner)
@@ -0,0 +1,358 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+type SearchManager struct {
+	CancelStub        func(context.Context, pkg.SearchID) (*pkg.Search, error)
+	cancelMutex       sync.RWMutex
+	cancelArgsForCall []struct {
+		arg1 context.Context
+		arg2 pkg.SearchID
+	}
+	cancelReturns struct {
+		result1 *pkg.Search
+		result2 error
+	}
+	cancelReturnsOnCall map[int]struct {
+		result1 *pkg.Search
+		result2 error
+	}
+	GetStub        func(context.Context, pkg.SearchID) (*pkg.Search, error)
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+		arg1 context.Context
+		arg2 pkg.SearchID
+	}
+	getReturns struct {
+		result1 *pkg.Search
+		result2 error
+	}
+	getReturnsOnCall map[int]struct {
+		result1 *pkg.Search
+		result2 error
+	}
+	RecordsStub        func(context.Context, pkg.SearchID, int, int) (pkg.Records, error)
+	recordsMutex       sync.RWMutex
+	recordsArgsForCall []struct {
+		arg1 context.Context
+		arg2 pkg.SearchID
+		arg3 int
+		arg4 int
+	}
+	recordsReturns struct {
+		result1 pkg.Records
+		result2 error
+	}
+	recordsReturnsOnCall map[int]struct {
+		result1 pkg.Records
+		result2 error
+	}
+	StartStub        func(context.Context, pkg.SearchRequest) (*pkg.Search, error)
+	startMutex       sync.RWMutex
+	startArgsForCall []struct {
+		arg1 context.Context
+		arg2 pkg.SearchRequest
+	}
+	startReturns struct {
+		result1 *pkg.Search
+		result2 error
+	}
+	startReturnsOnCall map[int]struct {
+		result1 *pkg.Search
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *SearchManager) Cancel(arg1 context.Context, arg2 pkg.SearchID) (*pkg.Search, error) {
+	fake.cancelMutex.Lock()
+	ret, specificReturn := fake.cancelReturnsOnCall[len(fake.cancelArgsForCall)]
+	fake.cancelArgsForCall = append(fake.cancelArgsForCall, struct {
+		arg1 context.Context
+		arg2 pkg.SearchID
+	}{arg1, arg2})
+	stub := fake.CancelStub
+	fakeReturns := fake.cancelReturns
+	fake.recordInvocation("Cancel", []interface{}{arg1, arg2})
+	fake.cancelMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *SearchManager) CancelCallCount() int {
+	fake.cancelMutex.RLock()
+	defer fake.cancelMutex.RUnlock()
+	return len(fake.cancelArgsForCall)
+}
+
+func (fake *SearchManager) CancelCalls(stub func(context.Context, pkg.SearchID) (*pkg.Search, error)) {
+	fake.cancelMutex.Lock()
+	defer fake.cancelMutex.Unlock()
+	fake.CancelStub = stub
+}
+
+func (fake *SearchManager) CancelArgsForCall(i int) (context.Context, pkg.SearchID) {
+	fake.cancelMutex.RLock()
+	defer fake.cancelMutex.RUnlock()
+	argsForCall := fake.cancelArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *SearchManager) CancelReturns(result1 *pkg.Search, result2 error) {
+	fake.cancelMutex.Lock()
+	defer fake.cancelMutex.Unlock()
+	fake.CancelStub = nil
+	fake.cancelReturns = struct {
+		result1 *pkg.Search
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SearchManager) CancelReturnsOnCall(i int, result1 *pkg.Search, result2 error) {
+	fake.cancelMutex.Lock()
+	defer fake.cancelMutex.Unlock()
+	fake.CancelStub = nil
+	if fake.cancelReturnsOnCall == nil {
+		fake.cancelReturnsOnCall = make(map[int]struct {
+			result1 *pkg.Search
+			result2 error
+		})
+	}
+	fake.cancelReturnsOnCall[i] = struct {
+		result1 *pkg.Search
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SearchManager) Get(arg1 context.Context, arg2 pkg.SearchID) (*pkg.Search, error) {
+	fake.getMutex.Lock()
+	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 context.Context
+		arg2 pkg.SearchID
+	}{arg1, arg2})
+	stub := fake.GetStub
+	fakeReturns := fake.getReturns
+	fake.recordInvocation("Get", []interface{}{arg1, arg2})
+	fake.getMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *SearchManager) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *SearchManager) GetCalls(stub func(context.Context, pkg.SearchID) (*pkg.Search, error)) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = stub
+}
+
+func (fake *SearchManager) GetArgsForCall(i int) (context.Context, pkg.SearchID) {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	argsForCall := fake.getArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *SearchManager) GetReturns(result1 *pkg.Search, result2 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 *pkg.Search
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SearchManager) GetReturnsOnCall(i int, result1 *pkg.Search, result2 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 *pkg.Search
+			result2 error
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 *pkg.Search
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SearchManager) Records(arg1 context.Context, arg2 pkg.SearchID, arg3 int, arg4 int) (pkg.Records, error) {
+	fake.recordsMutex.Lock()
+	ret, specificReturn := fake.recordsReturnsOnCall[len(fake.recordsArgsForCall)]
+	fake.recordsArgsForCall = append(fake.recordsArgsForCall, struct {
+		arg1 context.Context
+		arg2 pkg.SearchID
+		arg3 int
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RecordsStub
+	fakeReturns := fake.recordsReturns
+	fake.recordInvocation("Records", []interface{}{arg1, arg2, arg3, arg4})
+	fake.recordsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *SearchManager) RecordsCallCount() int {
+	fake.recordsMutex.RLock()
+	defer fake.recordsMutex.RUnlock()
+	return len(fake.recordsArgsForCall)
+}
+
+func (fake *SearchManager) RecordsCalls(stub func(context.Context, pkg.SearchID, int, int) (pkg.Records, error)) {
+	fake.recordsMutex.Lock()
+	defer fake.recordsMutex.Unlock()
+	fake.RecordsStub = stub
+}
+
+func (fake *SearchManager) RecordsArgsForCall(i int) (context.Context, pkg.SearchID, int, int) {
+	fake.recordsMutex.RLock()
+	defer fake.recordsMutex.RUnlock()
+	argsForCall := fake.recordsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *SearchManager) RecordsReturns(result1 pkg.Records, result2 error) {
+	fake.recordsMutex.Lock()
+	defer fake.recordsMutex.Unlock()
+	fake.RecordsStub = nil
+	fake.recordsReturns = struct {
+		result1 pkg.Records
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SearchManager) RecordsReturnsOnCall(i int, result1 pkg.Records, result2 error) {
+	fake.recordsMutex.Lock()
+	defer fake.recordsMutex.Unlock()
+	fake.RecordsStub = nil
+	if fake.recordsReturnsOnCall == nil {
+		fake.recordsReturnsOnCall = make(map[int]struct {
+			result1 pkg.Records
+			result2 error
+		})
+	}
+	fake.recordsReturnsOnCall[i] = struct {
+		result1 pkg.Records
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SearchManager) Start(arg1 context.Context, arg2 pkg.SearchRequest) (*pkg.Search, error) {
+	fake.startMutex.Lock()
+	ret, specificReturn := fake.startReturnsOnCall[len(fake.startArgsForCall)]
+	fake.startArgsForCall = append(fake.startArgsForCall, struct {
+		arg1 context.Context
+		arg2 pkg.SearchRequest
+	}{arg1, arg2})
+	stub := fake.StartStub
+	fakeReturns := fake.startReturns
+	fake.recordInvocation("Start", []interface{}{arg1, arg2})
+	fake.startMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *SearchManager) StartCallCount() int {
+	fake.startMutex.RLock()
+	defer fake.startMutex.RUnlock()
+	return len(fake.startArgsForCall)
+}
+
+func (fake *SearchManager) StartCalls(stub func(context.Context, pkg.SearchRequest) (*pkg.Search, error)) {
+	fake.startMutex.Lock()
+	defer fake.startMutex.Unlock()
+	fake.StartStub = stub
+}
+
+func (fake *SearchManager) StartArgsForCall(i int) (context.Context, pkg.SearchRequest) {
+	fake.startMutex.RLock()
+	defer fake.startMutex.RUnlock()
+	argsForCall := fake.startArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *SearchManager) StartReturns(result1 *pkg.Search, result2 error) {
+	fake.startMutex.Lock()
+	defer fake.startMutex.Unlock()
+	fake.StartStub = nil
+	fake.startReturns = struct {
+		result1 *pkg.Search
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SearchManager) StartReturnsOnCall(i int, result1 *pkg.Search, result2 error) {
+	fake.startMutex.Lock()
+	defer fake.startMutex.Unlock()
+	fake.StartStub = nil
+	if fake.startReturnsOnCall == nil {
+		fake.startReturnsOnCall = make(map[int]struct {
+			result1 *pkg.Search
+			result2 error
+		})
+	}
+	fake.startReturnsOnCall[i] = struct {
+		result1 *pkg.Search
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SearchManager) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *SearchManager) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pkg.SearchManager = new(SearchManager)
@@ -0,0 +1,319 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+type SearchResults struct {
+	AddStub        func(context.Context, pkg.Record) error
+	addMutex       sync.RWMutex
+	addArgsForCall []struct {
+		arg1 context.Context
+		arg2 pkg.Record
+	}
+	addReturns struct {
+		result1 error
+	}
+	addReturnsOnCall map[int]struct {
+		result1 error
+	}
+	LenStub        func() int
+	lenMutex       sync.RWMutex
+	lenArgsForCall []struct {
+	}
+	lenReturns struct {
+		result1 int
+	}
+	lenReturnsOnCall map[int]struct {
+		result1 int
+	}
+	RecordsStub        func(context.Context, int, int) (pkg.Records, error)
+	recordsMutex       sync.RWMutex
+	recordsArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+		arg3 int
+	}
+	recordsReturns struct {
+		result1 pkg.Records
+		result2 error
+	}
+	recordsReturnsOnCall map[int]struct {
+		result1 pkg.Records
+		result2 error
+	}
+	RemoveStub        func() error
+	removeMutex       sync.RWMutex
+	removeArgsForCall []struct {
+	}
+	removeReturns struct {
+		result1 error
+	}
+	removeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *SearchResults) Add(arg1 context.Context, arg2 pkg.Record) error {
+	fake.addMutex.Lock()
+	ret, specificReturn := fake.addReturnsOnCall[len(fake.addArgsForCall)]
+	fake.addArgsForCall = append(fake.addArgsForCall, struct {
+		arg1 context.Context
+		arg2 pkg.Record
+	}{arg1, arg2})
+	stub := fake.AddStub
+	fakeReturns := fake.addReturns
+	fake.recordInvocation("Add", []interface{}{arg1, arg2})
+	fake.addMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *SearchResults) AddCallCount() int {
+	fake.addMutex.RLock()
+	defer fake.addMutex.RUnlock()
+	return len(fake.addArgsForCall)
+}
+
+func (fake *SearchResults) AddCalls(stub func(context.Context, pkg.Record) error) {
+	fake.addMutex.Lock()
+	defer fake.addMutex.Unlock()
+	fake.AddStub = stub
+}
+
+func (fake *SearchResults) AddArgsForCall(i int) (context.Context, pkg.Record) {
+	fake.addMutex.RLock()
+	defer fake.addMutex.RUnlock()
+	argsForCall := fake.addArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *SearchResults) AddReturns(result1 error) {
+	fake.addMutex.Lock()
+	defer fake.addMutex.Unlock()
+	fake.AddStub = nil
+	fake.addReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *SearchResults) AddReturnsOnCall(i int, result1 error) {
+	fake.addMutex.Lock()
+	defer fake.addMutex.Unlock()
+	fake.AddStub = nil
+	if fake.addReturnsOnCall == nil {
+		fake.addReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *SearchResults) Len() int {
+	fake.lenMutex.Lock()
+	ret, specificReturn := fake.lenReturnsOnCall[len(fake.lenArgsForCall)]
+	fake.lenArgsForCall = append(fake.lenArgsForCall, struct {
+	}{})
+	stub := fake.LenStub
+	fakeReturns := fake.lenReturns
+	fake.recordInvocation("Len", []interface{}{})
+	fake.lenMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *SearchResults) LenCallCount() int {
+	fake.lenMutex.RLock()
+	defer fake.lenMutex.RUnlock()
+	return len(fake.lenArgsForCall)
+}
+
+func (fake *SearchResults) LenCalls(stub func() int) {
+	fake.lenMutex.Lock()
+	defer fake.lenMutex.Unlock()
+	fake.LenStub = stub
+}
+
+func (fake *SearchResults) LenReturns(result1 int) {
+	fake.lenMutex.Lock()
+	defer fake.lenMutex.Unlock()
+	fake.LenStub = nil
+	fake.lenReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *SearchResults) LenReturnsOnCall(i int, result1 int) {
+	fake.lenMutex.Lock()
+	defer fake.lenMutex.Unlock()
+	fake.LenStub = nil
+	if fake.lenReturnsOnCall == nil {
+		fake.lenReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.lenReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *SearchResults) Records(arg1 context.Context, arg2 int, arg3 int) (pkg.Records, error) {
+	fake.recordsMutex.Lock()
+	ret, specificReturn := fake.recordsReturnsOnCall[len(fake.recordsArgsForCall)]
+	fake.recordsArgsForCall = append(fake.recordsArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.RecordsStub
+	fakeReturns := fake.recordsReturns
+	fake.recordInvocation("Records", []interface{}{arg1, arg2, arg3})
+	fake.recordsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *SearchResults) RecordsCallCount() int {
+	fake.recordsMutex.RLock()
+	defer fake.recordsMutex.RUnlock()
+	return len(fake.recordsArgsForCall)
+}
+
+func (fake *SearchResults) RecordsCalls(stub func(context.Context, int, int) (pkg.Records, error)) {
+	fake.recordsMutex.Lock()
+	defer fake.recordsMutex.Unlock()
+	fake.RecordsStub = stub
+}
+
+func (fake *SearchResults) RecordsArgsForCall(i int) (context.Context, int, int) {
+	fake.recordsMutex.RLock()
+	defer fake.recordsMutex.RUnlock()
+	argsForCall := fake.recordsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *SearchResults) RecordsReturns(result1 pkg.Records, result2 error) {
+	fake.recordsMutex.Lock()
+	defer fake.recordsMutex.Unlock()
+	fake.RecordsStub = nil
+	fake.recordsReturns = struct {
+		result1 pkg.Records
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SearchResults) RecordsReturnsOnCall(i int, result1 pkg.Records, result2 error) {
+	fake.recordsMutex.Lock()
+	defer fake.recordsMutex.Unlock()
+	fake.RecordsStub = nil
+	if fake.recordsReturnsOnCall == nil {
+		fake.recordsReturnsOnCall = make(map[int]struct {
+			result1 pkg.Records
+			result2 error
+		})
+	}
+	fake.recordsReturnsOnCall[i] = struct {
+		result1 pkg.Records
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SearchResults) Remove() error {
+	fake.removeMutex.Lock()
+	ret, specificReturn := fake.removeReturnsOnCall[len(fake.removeArgsForCall)]
+	fake.removeArgsForCall = append(fake.removeArgsForCall, struct {
+	}{})
+	stub := fake.RemoveStub
+	fakeReturns := fake.removeReturns
+	fake.recordInvocation("Remove", []interface{}{})
+	fake.removeMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *SearchResults) RemoveCallCount() int {
+	fake.removeMutex.RLock()
+	defer fake.removeMutex.RUnlock()
+	return len(fake.removeArgsForCall)
+}
+
+func (fake *SearchResults) RemoveCalls(stub func() error) {
+	fake.removeMutex.Lock()
+	defer fake.removeMutex.Unlock()
+	fake.RemoveStub = stub
+}
+
+func (fake *SearchResults) RemoveReturns(result1 error) {
+	fake.removeMutex.Lock()
+	defer fake.removeMutex.Unlock()
+	fake.RemoveStub = nil
+	fake.removeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *SearchResults) RemoveReturnsOnCall(i int, result1 error) {
+	fake.removeMutex.Lock()
+	defer fake.removeMutex.Unlock()
+	fake.RemoveStub = nil
+	if fake.removeReturnsOnCall == nil {
+		fake.removeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *SearchResults) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *SearchResults) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pkg.SearchResults = new(SearchResults)
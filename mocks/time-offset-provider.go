@@ -0,0 +1,123 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/bborbe/kafka"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+type TimeOffsetProvider struct {
+	OffsetStub        func(context.Context, kafka.Topic, kafka.Partition, time.Time) (kafka.Offset, error)
+	offsetMutex       sync.RWMutex
+	offsetArgsForCall []struct {
+		arg1 context.Context
+		arg2 kafka.Topic
+		arg3 kafka.Partition
+		arg4 time.Time
+	}
+	offsetReturns struct {
+		result1 kafka.Offset
+		result2 error
+	}
+	offsetReturnsOnCall map[int]struct {
+		result1 kafka.Offset
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *TimeOffsetProvider) Offset(arg1 context.Context, arg2 kafka.Topic, arg3 kafka.Partition, arg4 time.Time) (kafka.Offset, error) {
+	fake.offsetMutex.Lock()
+	ret, specificReturn := fake.offsetReturnsOnCall[len(fake.offsetArgsForCall)]
+	fake.offsetArgsForCall = append(fake.offsetArgsForCall, struct {
+		arg1 context.Context
+		arg2 kafka.Topic
+		arg3 kafka.Partition
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.OffsetStub
+	fakeReturns := fake.offsetReturns
+	fake.recordInvocation("Offset", []interface{}{arg1, arg2, arg3, arg4})
+	fake.offsetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *TimeOffsetProvider) OffsetCallCount() int {
+	fake.offsetMutex.RLock()
+	defer fake.offsetMutex.RUnlock()
+	return len(fake.offsetArgsForCall)
+}
+
+func (fake *TimeOffsetProvider) OffsetCalls(stub func(context.Context, kafka.Topic, kafka.Partition, time.Time) (kafka.Offset, error)) {
+	fake.offsetMutex.Lock()
+	defer fake.offsetMutex.Unlock()
+	fake.OffsetStub = stub
+}
+
+func (fake *TimeOffsetProvider) OffsetArgsForCall(i int) (context.Context, kafka.Topic, kafka.Partition, time.Time) {
+	fake.offsetMutex.RLock()
+	defer fake.offsetMutex.RUnlock()
+	argsForCall := fake.offsetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *TimeOffsetProvider) OffsetReturns(result1 kafka.Offset, result2 error) {
+	fake.offsetMutex.Lock()
+	defer fake.offsetMutex.Unlock()
+	fake.OffsetStub = nil
+	fake.offsetReturns = struct {
+		result1 kafka.Offset
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *TimeOffsetProvider) OffsetReturnsOnCall(i int, result1 kafka.Offset, result2 error) {
+	fake.offsetMutex.Lock()
+	defer fake.offsetMutex.Unlock()
+	fake.OffsetStub = nil
+	if fake.offsetReturnsOnCall == nil {
+		fake.offsetReturnsOnCall = make(map[int]struct {
+			result1 kafka.Offset
+			result2 error
+		})
+	}
+	fake.offsetReturnsOnCall[i] = struct {
+		result1 kafka.Offset
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *TimeOffsetProvider) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *TimeOffsetProvider) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pkg.TimeOffsetProvider = new(TimeOffsetProvider)
@@ -0,0 +1,118 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/bborbe/kafka"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+type TopicConfigProvider struct {
+	TopicConfigStub        func(context.Context, kafka.Topic) (pkg.TopicConfigEntries, error)
+	topicConfigMutex       sync.RWMutex
+	topicConfigArgsForCall []struct {
+		arg1 context.Context
+		arg2 kafka.Topic
+	}
+	topicConfigReturns struct {
+		result1 pkg.TopicConfigEntries
+		result2 error
+	}
+	topicConfigReturnsOnCall map[int]struct {
+		result1 pkg.TopicConfigEntries
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *TopicConfigProvider) TopicConfig(arg1 context.Context, arg2 kafka.Topic) (pkg.TopicConfigEntries, error) {
+	fake.topicConfigMutex.Lock()
+	ret, specificReturn := fake.topicConfigReturnsOnCall[len(fake.topicConfigArgsForCall)]
+	fake.topicConfigArgsForCall = append(fake.topicConfigArgsForCall, struct {
+		arg1 context.Context
+		arg2 kafka.Topic
+	}{arg1, arg2})
+	stub := fake.TopicConfigStub
+	fakeReturns := fake.topicConfigReturns
+	fake.recordInvocation("TopicConfig", []interface{}{arg1, arg2})
+	fake.topicConfigMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *TopicConfigProvider) TopicConfigCallCount() int {
+	fake.topicConfigMutex.RLock()
+	defer fake.topicConfigMutex.RUnlock()
+	return len(fake.topicConfigArgsForCall)
+}
+
+func (fake *TopicConfigProvider) TopicConfigCalls(stub func(context.Context, kafka.Topic) (pkg.TopicConfigEntries, error)) {
+	fake.topicConfigMutex.Lock()
+	defer fake.topicConfigMutex.Unlock()
+	fake.TopicConfigStub = stub
+}
+
+func (fake *TopicConfigProvider) TopicConfigArgsForCall(i int) (context.Context, kafka.Topic) {
+	fake.topicConfigMutex.RLock()
+	defer fake.topicConfigMutex.RUnlock()
+	argsForCall := fake.topicConfigArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *TopicConfigProvider) TopicConfigReturns(result1 pkg.TopicConfigEntries, result2 error) {
+	fake.topicConfigMutex.Lock()
+	defer fake.topicConfigMutex.Unlock()
+	fake.TopicConfigStub = nil
+	fake.topicConfigReturns = struct {
+		result1 pkg.TopicConfigEntries
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *TopicConfigProvider) TopicConfigReturnsOnCall(i int, result1 pkg.TopicConfigEntries, result2 error) {
+	fake.topicConfigMutex.Lock()
+	defer fake.topicConfigMutex.Unlock()
+	fake.TopicConfigStub = nil
+	if fake.topicConfigReturnsOnCall == nil {
+		fake.topicConfigReturnsOnCall = make(map[int]struct {
+			result1 pkg.TopicConfigEntries
+			result2 error
+		})
+	}
+	fake.topicConfigReturnsOnCall[i] = struct {
+		result1 pkg.TopicConfigEntries
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *TopicConfigProvider) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *TopicConfigProvider) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pkg.TopicConfigProvider = new(TopicConfigProvider)
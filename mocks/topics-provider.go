@@ -0,0 +1,115 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+type TopicsProvider struct {
+	TopicsStub        func(context.Context) (pkg.TopicInfos, error)
+	topicsMutex       sync.RWMutex
+	topicsArgsForCall []struct {
+		arg1 context.Context
+	}
+	topicsReturns struct {
+		result1 pkg.TopicInfos
+		result2 error
+	}
+	topicsReturnsOnCall map[int]struct {
+		result1 pkg.TopicInfos
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *TopicsProvider) Topics(arg1 context.Context) (pkg.TopicInfos, error) {
+	fake.topicsMutex.Lock()
+	ret, specificReturn := fake.topicsReturnsOnCall[len(fake.topicsArgsForCall)]
+	fake.topicsArgsForCall = append(fake.topicsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.TopicsStub
+	fakeReturns := fake.topicsReturns
+	fake.recordInvocation("Topics", []interface{}{arg1})
+	fake.topicsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *TopicsProvider) TopicsCallCount() int {
+	fake.topicsMutex.RLock()
+	defer fake.topicsMutex.RUnlock()
+	return len(fake.topicsArgsForCall)
+}
+
+func (fake *TopicsProvider) TopicsCalls(stub func(context.Context) (pkg.TopicInfos, error)) {
+	fake.topicsMutex.Lock()
+	defer fake.topicsMutex.Unlock()
+	fake.TopicsStub = stub
+}
+
+func (fake *TopicsProvider) TopicsArgsForCall(i int) context.Context {
+	fake.topicsMutex.RLock()
+	defer fake.topicsMutex.RUnlock()
+	argsForCall := fake.topicsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *TopicsProvider) TopicsReturns(result1 pkg.TopicInfos, result2 error) {
+	fake.topicsMutex.Lock()
+	defer fake.topicsMutex.Unlock()
+	fake.TopicsStub = nil
+	fake.topicsReturns = struct {
+		result1 pkg.TopicInfos
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *TopicsProvider) TopicsReturnsOnCall(i int, result1 pkg.TopicInfos, result2 error) {
+	fake.topicsMutex.Lock()
+	defer fake.topicsMutex.Unlock()
+	fake.TopicsStub = nil
+	if fake.topicsReturnsOnCall == nil {
+		fake.topicsReturnsOnCall = make(map[int]struct {
+			result1 pkg.TopicInfos
+			result2 error
+		})
+	}
+	fake.topicsReturnsOnCall[i] = struct {
+		result1 pkg.TopicInfos
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *TopicsProvider) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *TopicsProvider) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ pkg.TopicsProvider = new(TopicsProvider)
@@ -0,0 +1,86 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"os"
+
+	"github.com/bborbe/errors"
+)
+
+type APIKeys []APIKey
+
+// APIKey is a static token, the name is used as subject of the identity.
+type APIKey struct {
+	Name   string   `json:"name"`
+	Key    string   `json:"key"`
+	Groups []string `json:"groups,omitempty"`
+}
+
+// ParseAPIKeysFile reads a JSON array of API keys.
+func ParseAPIKeysFile(ctx context.Context, path string) (APIKeys, error) {
+	content, err := os.ReadFile(path) // #nosec G304 -- path is configured by the operator
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "read api keys file %s failed", path)
+	}
+	var result APIKeys
+	if err := json.Unmarshal(content, &result); err != nil {
+		return nil, errors.Wrapf(ctx, err, "parse api keys file %s failed", path)
+	}
+	if err := result.Validate(ctx); err != nil {
+		return nil, errors.Wrapf(ctx, err, "validate api keys file %s failed", path)
+	}
+	return result, nil
+}
+
+// Validate ensures all keys have a unique name and a key.
+func (a APIKeys) Validate(ctx context.Context) error {
+	names := map[string]bool{}
+	for _, apiKey := range a {
+		if apiKey.Name == "" {
+			return errors.New(ctx, "api key name missing")
+		}
+		if apiKey.Key == "" {
+			return errors.Errorf(ctx, "key of api key %s missing", apiKey.Name)
+		}
+		if names[apiKey.Name] {
+			return errors.Errorf(ctx, "api key %s configured twice", apiKey.Name)
+		}
+		names[apiKey.Name] = true
+	}
+	return nil
+}
+
+// NewAPIKeyAuthenticator accepts tokens matching one of the given API keys.
+func NewAPIKeyAuthenticator(apiKeys APIKeys) Authenticator {
+	return &apiKeyAuthenticator{
+		apiKeys: apiKeys,
+	}
+}
+
+type apiKeyAuthenticator struct {
+	apiKeys APIKeys
+}
+
+func (a *apiKeyAuthenticator) Authenticate(ctx context.Context, token string) (*Identity, error) {
+	var match *APIKey
+	for i, apiKey := range a.apiKeys {
+		// compare all keys in constant time to not leak which key matched
+		if subtle.ConstantTimeCompare([]byte(apiKey.Key), []byte(token)) == 1 {
+			match = &a.apiKeys[i]
+		}
+	}
+	if match == nil {
+		return nil, errors.Wrap(ctx, ErrUnauthenticated, "api key unknown")
+	}
+	return &Identity{
+		Subject: match.Name,
+		Groups:  match.Groups,
+		Method:  AuthMethodAPIKey,
+	}, nil
+}
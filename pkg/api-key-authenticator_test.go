@@ -0,0 +1,81 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/bborbe/errors"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("APIKeyAuthenticator", func() {
+	var ctx context.Context
+	var authenticator pkg.Authenticator
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		authenticator = pkg.NewAPIKeyAuthenticator(pkg.APIKeys{
+			{Name: "ci", Key: "secret-ci", Groups: []string{"readers"}},
+			{Name: "ops", Key: "secret-ops"},
+		})
+	})
+
+	It("returns identity for known key", func() {
+		identity, err := authenticator.Authenticate(ctx, "secret-ci")
+		Expect(err).To(BeNil())
+		Expect(identity).To(Equal(&pkg.Identity{
+			Subject: "ci",
+			Groups:  []string{"readers"},
+			Method:  pkg.AuthMethodAPIKey,
+		}))
+	})
+
+	It("returns error for unknown key", func() {
+		_, err := authenticator.Authenticate(ctx, "secret")
+		Expect(errors.Is(err, pkg.ErrUnauthenticated)).To(BeTrue())
+	})
+
+	Context("ParseAPIKeysFile", func() {
+		var dir string
+
+		BeforeEach(func() {
+			dir = GinkgoT().TempDir()
+		})
+
+		writeFile := func(content string) string {
+			path := filepath.Join(dir, "api-keys.json")
+			Expect(os.WriteFile(path, []byte(content), 0600)).To(Succeed())
+			return path
+		}
+
+		It("parses keys", func() {
+			apiKeys, err := pkg.ParseAPIKeysFile(
+				ctx,
+				writeFile(`[{"name": "ci", "key": "secret-ci", "groups": ["readers"]}]`),
+			)
+			Expect(err).To(BeNil())
+			Expect(apiKeys).To(Equal(pkg.APIKeys{
+				{Name: "ci", Key: "secret-ci", Groups: []string{"readers"}},
+			}))
+		})
+
+		DescribeTable("returns error",
+			func(content string) {
+				_, err := pkg.ParseAPIKeysFile(ctx, writeFile(content))
+				Expect(err).NotTo(BeNil())
+			},
+			Entry("invalid json", `[`),
+			Entry("missing name", `[{"key": "secret"}]`),
+			Entry("missing key", `[{"name": "ci"}]`),
+			Entry("duplicate name", `[{"name": "ci", "key": "a"}, {"name": "ci", "key": "b"}]`),
+		)
+	})
+})
@@ -0,0 +1,89 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+	"time"
+
+	libkafka "github.com/bborbe/kafka"
+)
+
+// AuditStage tells if an audit event was logged before or after the request was served.
+type AuditStage string
+
+const (
+	// AuditStageStarted is logged before the request is served, with the request
+	// details only.
+	AuditStageStarted AuditStage = "started"
+	// AuditStageCompleted is logged after the request was served, with status,
+	// duration and the details added by the handler.
+	AuditStageCompleted AuditStage = "completed"
+)
+
+// AuditEvent records one access to topic data.
+type AuditEvent struct {
+	Stage        AuditStage          `json:"stage"`
+	Time         time.Time           `json:"time"`
+	Cluster      ClusterName         `json:"cluster"`
+	Subject      string              `json:"subject"`
+	AuthMethod   AuthMethod          `json:"authMethod,omitempty"`
+	RemoteAddr   string              `json:"remoteAddr"`
+	ForwardedFor string              `json:"forwardedFor,omitempty"`
+	Method       string              `json:"method"`
+	Path         string              `json:"path"`
+	Operation    Operation           `json:"operation"`
+	Topic        libkafka.Topic      `json:"topic,omitempty"`
+	Partition    *libkafka.Partition `json:"partition,omitempty"`
+	FromOffset   *libkafka.Offset    `json:"fromOffset,omitempty"`
+	ToOffset     *libkafka.Offset    `json:"toOffset,omitempty"`
+	Filter       string              `json:"filter,omitempty"`
+	Group        string              `json:"group,omitempty"`
+	RecordCount  int                 `json:"recordCount"`
+	DurationMs   int64               `json:"durationMs"`
+	Status       int                 `json:"status"`
+}
+
+type auditEventContextKey struct{}
+
+// WithAuditEvent returns a context carrying the event handlers fill with request details.
+func WithAuditEvent(ctx context.Context, event *AuditEvent) context.Context {
+	return context.WithValue(ctx, auditEventContextKey{}, event)
+}
+
+// AuditEventFromContext returns the event of the request or nil if not audited.
+func AuditEventFromContext(ctx context.Context) *AuditEvent {
+	event, _ := ctx.Value(auditEventContextKey{}).(*AuditEvent)
+	return event
+}
+
+// SetRead fills the event with the parameters and result of a read.
+func (a *AuditEvent) SetRead(params *requestParams, records Records) {
+	if a == nil {
+		return
+	}
+	partition := params.partition
+	fromOffset := params.offset
+	a.Topic = params.topic
+	a.Partition = &partition
+	a.FromOffset = &fromOffset
+	a.Filter = string(params.filter)
+	a.Group = params.group
+	a.RecordCount = len(records)
+	if len(records) > 0 {
+		toOffset := records[len(records)-1].Offset
+		a.ToOffset = &toOffset
+	}
+}
+
+// SetSearch fills the event with the search and the returned matches.
+func (a *AuditEvent) SetSearch(search *Search, records Records) {
+	if a == nil {
+		return
+	}
+	a.Topic = search.Topic
+	a.Filter = search.Filter
+	a.RecordCount = len(records)
+}
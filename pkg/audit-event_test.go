@@ -0,0 +1,26 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("AuditEvent", func() {
+	It("returns nil without event in context", func() {
+		Expect(pkg.AuditEventFromContext(context.Background())).To(BeNil())
+	})
+
+	It("returns event from context", func() {
+		event := &pkg.AuditEvent{Subject: "alice"}
+		ctx := pkg.WithAuditEvent(context.Background(), event)
+		Expect(pkg.AuditEventFromContext(ctx)).To(BeIdenticalTo(event))
+	})
+})
@@ -0,0 +1,81 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"net/http"
+	"time"
+
+	libkafka "github.com/bborbe/kafka"
+	"github.com/golang/glog"
+	"github.com/gorilla/mux"
+)
+
+// NewAuditHandler logs an audit event for every request before it is served, and
+// answers 500 without serving if that fails. The event is passed in the context, so
+// the handler can add details like offsets and record count. It is logged again with
+// status and duration after the handler returned.
+func NewAuditHandler(
+	auditLogger AuditLogger,
+	cluster ClusterName,
+	operation Operation,
+	handler http.Handler,
+) http.Handler {
+	return http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+		start := time.Now()
+		topic := libkafka.Topic(mux.Vars(req)["topic"])
+		if topic == "" {
+			topic = libkafka.Topic(req.FormValue("topic"))
+		}
+		event := &AuditEvent{
+			Stage:        AuditStageStarted,
+			Time:         start.UTC(),
+			Cluster:      cluster,
+			RemoteAddr:   req.RemoteAddr,
+			ForwardedFor: req.Header.Get("X-Forwarded-For"),
+			Method:       req.Method,
+			Path:         req.URL.Path,
+			Operation:    operation,
+			Topic:        topic,
+		}
+		ctx := req.Context()
+		identity := IdentityFromContext(ctx)
+		event.Subject = identitySubject(identity)
+		if identity != nil {
+			event.AuthMethod = identity.Method
+		}
+
+		if err := auditLogger.Log(ctx, *event); err != nil {
+			glog.Errorf("log audit event of %s for %s failed: %v", event.Path, event.Subject, err)
+			http.Error(resp, "audit log failed", http.StatusInternalServerError)
+			return
+		}
+
+		statusWriter := &statusResponseWriter{ResponseWriter: resp, status: http.StatusOK}
+		handler.ServeHTTP(statusWriter, req.WithContext(WithAuditEvent(ctx, event)))
+
+		event.Stage = AuditStageCompleted
+		event.Status = statusWriter.status
+		event.DurationMs = time.Since(start).Milliseconds()
+		if err := auditLogger.Log(ctx, *event); err != nil {
+			// the response is already sent, the started event shows the access
+			glog.Errorf("log audit event of %s for %s failed: %v", event.Path, event.Subject, err)
+		}
+	})
+}
+
+type statusResponseWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (s *statusResponseWriter) WriteHeader(status int) {
+	s.status = status
+	s.ResponseWriter.WriteHeader(status)
+}
+
+func (s *statusResponseWriter) Unwrap() http.ResponseWriter {
+	return s.ResponseWriter
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/bborbe/errors"
+	libkafka "github.com/bborbe/kafka"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/mocks"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("AuditHandler", func() {
+	var auditLogger *mocks.AuditLogger
+	var served bool
+	var inner http.Handler
+	var request *http.Request
+	var response *httptest.ResponseRecorder
+
+	BeforeEach(func() {
+		auditLogger = &mocks.AuditLogger{}
+		served = false
+		inner = http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+			served = true
+			event := pkg.AuditEventFromContext(req.Context())
+			event.RecordCount = 2
+			resp.WriteHeader(http.StatusForbidden)
+		})
+		request = httptest.NewRequest(http.MethodGet, "/read?topic=orders", nil)
+		request.Header.Set("X-Forwarded-For", "10.0.0.1")
+		request = request.WithContext(pkg.WithIdentity(request.Context(), &pkg.Identity{
+			Subject: "alice",
+			Method:  pkg.AuthMethodJWT,
+		}))
+		response = httptest.NewRecorder()
+	})
+
+	JustBeforeEach(func() {
+		pkg.NewAuditHandler(auditLogger, "prod", pkg.OperationRead, inner).
+			ServeHTTP(response, request)
+	})
+
+	It("logs an event before and after serving", func() {
+		Expect(auditLogger.LogCallCount()).To(Equal(2))
+		_, started := auditLogger.LogArgsForCall(0)
+		Expect(started.Stage).To(Equal(pkg.AuditStageStarted))
+		Expect(started.Status).To(Equal(0))
+		Expect(started.RecordCount).To(Equal(0))
+		_, completed := auditLogger.LogArgsForCall(1)
+		Expect(completed.Stage).To(Equal(pkg.AuditStageCompleted))
+	})
+
+	It("logs request details", func() {
+		_, event := auditLogger.LogArgsForCall(0)
+		Expect(event.Cluster).To(Equal(pkg.ClusterName("prod")))
+		Expect(event.Subject).To(Equal("alice"))
+		Expect(event.AuthMethod).To(Equal(pkg.AuthMethodJWT))
+		Expect(event.ForwardedFor).To(Equal("10.0.0.1"))
+		Expect(event.Path).To(Equal("/read"))
+		Expect(event.Operation).To(Equal(pkg.OperationRead))
+		Expect(event.Topic).To(Equal(libkafka.Topic("orders")))
+	})
+
+	It("logs status and details added by handler", func() {
+		_, event := auditLogger.LogArgsForCall(1)
+		Expect(event.Status).To(Equal(http.StatusForbidden))
+		Expect(event.RecordCount).To(Equal(2))
+		Expect(response.Code).To(Equal(http.StatusForbidden))
+	})
+
+	Context("with failing audit logger", func() {
+		BeforeEach(func() {
+			auditLogger.LogReturns(errors.New(context.Background(), "banana"))
+		})
+		It("returns internal server error without serving", func() {
+			Expect(served).To(BeFalse())
+			Expect(response.Code).To(Equal(http.StatusInternalServerError))
+			Expect(auditLogger.LogCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("with audit logger failing after serving", func() {
+		BeforeEach(func() {
+			auditLogger.LogReturnsOnCall(0, nil)
+			auditLogger.LogReturnsOnCall(1, errors.New(context.Background(), "banana"))
+		})
+		It("keeps the response of the handler", func() {
+			Expect(served).To(BeTrue())
+			Expect(response.Code).To(Equal(http.StatusForbidden))
+		})
+	})
+})
@@ -0,0 +1,124 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"os"
+	"sync"
+
+	"github.com/IBM/sarama"
+	"github.com/bborbe/errors"
+)
+
+//counterfeiter:generate -o ../mocks/audit-logger.go --fake-name AuditLogger . AuditLogger
+type AuditLogger interface {
+	Log(ctx context.Context, event AuditEvent) error
+	Close() error
+}
+
+// NewAuditLoggerList sends every event to all given loggers.
+func NewAuditLoggerList(auditLoggers ...AuditLogger) AuditLogger {
+	return auditLoggerList(auditLoggers)
+}
+
+type auditLoggerList []AuditLogger
+
+func (a auditLoggerList) Log(ctx context.Context, event AuditEvent) error {
+	var errs []error
+	for _, auditLogger := range a {
+		if err := auditLogger.Log(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return errors.Wrap(ctx, stderrors.Join(errs...), "log audit event failed")
+	}
+	return nil
+}
+
+func (a auditLoggerList) Close() error {
+	var errs []error
+	for _, auditLogger := range a {
+		if err := auditLogger.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return stderrors.Join(errs...)
+}
+
+// NewFileAuditLogger appends one JSON line per event to the given file.
+func NewFileAuditLogger(ctx context.Context, path string) (AuditLogger, error) {
+	// #nosec G304 -- path is configured by the operator
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "open audit log %s failed", path)
+	}
+	return &fileAuditLogger{
+		file: file,
+	}, nil
+}
+
+type fileAuditLogger struct {
+	mux  sync.Mutex
+	file *os.File
+}
+
+func (f *fileAuditLogger) Log(ctx context.Context, event AuditEvent) error {
+	content, err := json.Marshal(event)
+	if err != nil {
+		return errors.Wrap(ctx, err, "marshal audit event failed")
+	}
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	// a single write per line keeps lines intact with O_APPEND
+	if _, err := f.file.Write(append(content, '\n')); err != nil {
+		return errors.Wrap(ctx, err, "write audit event failed")
+	}
+	return nil
+}
+
+func (f *fileAuditLogger) Close() error {
+	return f.file.Close()
+}
+
+// NewKafkaAuditLogger sends every event as JSON to the given topic. The key is the
+// subject, so all events of a caller stay in order.
+func NewKafkaAuditLogger(
+	syncProducer sarama.SyncProducer,
+	topic string,
+) AuditLogger {
+	return &kafkaAuditLogger{
+		syncProducer: syncProducer,
+		topic:        topic,
+	}
+}
+
+type kafkaAuditLogger struct {
+	syncProducer sarama.SyncProducer
+	topic        string
+}
+
+func (k *kafkaAuditLogger) Log(ctx context.Context, event AuditEvent) error {
+	content, err := json.Marshal(event)
+	if err != nil {
+		return errors.Wrap(ctx, err, "marshal audit event failed")
+	}
+	_, _, err = k.syncProducer.SendMessage(&sarama.ProducerMessage{
+		Topic: k.topic,
+		Key:   sarama.StringEncoder(event.Subject),
+		Value: sarama.ByteEncoder(content),
+	})
+	if err != nil {
+		return errors.Wrapf(ctx, err, "send audit event to topic %s failed", k.topic)
+	}
+	return nil
+}
+
+func (k *kafkaAuditLogger) Close() error {
+	return k.syncProducer.Close()
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/IBM/sarama"
+	"github.com/bborbe/errors"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/mocks"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("AuditLogger", func() {
+	var ctx context.Context
+	var event pkg.AuditEvent
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		event = pkg.AuditEvent{
+			Subject:     "alice",
+			Operation:   pkg.OperationRead,
+			Topic:       "orders",
+			RecordCount: 3,
+			Status:      200,
+		}
+	})
+
+	Context("File", func() {
+		var path string
+
+		BeforeEach(func() {
+			path = filepath.Join(GinkgoT().TempDir(), "audit.log")
+		})
+
+		It("appends one json line per event", func() {
+			for i := 0; i < 2; i++ {
+				auditLogger, err := pkg.NewFileAuditLogger(ctx, path)
+				Expect(err).To(BeNil())
+				Expect(auditLogger.Log(ctx, event)).To(Succeed())
+				Expect(auditLogger.Close()).To(Succeed())
+			}
+
+			content, err := os.ReadFile(path)
+			Expect(err).To(BeNil())
+			lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+			Expect(lines).To(HaveLen(2))
+
+			var logged pkg.AuditEvent
+			Expect(json.Unmarshal([]byte(lines[1]), &logged)).To(Succeed())
+			Expect(logged.Subject).To(Equal("alice"))
+			Expect(logged.Topic).To(BeEquivalentTo("orders"))
+			Expect(logged.RecordCount).To(Equal(3))
+		})
+
+		It("returns error for missing directory", func() {
+			_, err := pkg.NewFileAuditLogger(ctx, filepath.Join(path, "missing", "audit.log"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("Kafka", func() {
+		var syncProducer *mocks.SaramaSyncProducer
+		var auditLogger pkg.AuditLogger
+
+		BeforeEach(func() {
+			syncProducer = &mocks.SaramaSyncProducer{}
+			auditLogger = pkg.NewKafkaAuditLogger(syncProducer, "audit")
+		})
+
+		It("sends event keyed by subject", func() {
+			Expect(auditLogger.Log(ctx, event)).To(Succeed())
+			Expect(syncProducer.SendMessageCallCount()).To(Equal(1))
+			msg := syncProducer.SendMessageArgsForCall(0)
+			Expect(msg.Topic).To(Equal("audit"))
+			Expect(msg.Key).To(Equal(sarama.StringEncoder("alice")))
+
+			value, err := msg.Value.Encode()
+			Expect(err).To(BeNil())
+			Expect(string(value)).To(ContainSubstring(`"subject":"alice"`))
+		})
+
+		It("returns send error", func() {
+			syncProducer.SendMessageReturns(0, 0, errors.New(ctx, "banana"))
+			Expect(auditLogger.Log(ctx, event)).To(HaveOccurred())
+		})
+
+		It("closes producer", func() {
+			Expect(auditLogger.Close()).To(Succeed())
+			Expect(syncProducer.CloseCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("List", func() {
+		var first *mocks.AuditLogger
+		var second *mocks.AuditLogger
+		var auditLogger pkg.AuditLogger
+
+		BeforeEach(func() {
+			first = &mocks.AuditLogger{}
+			second = &mocks.AuditLogger{}
+			auditLogger = pkg.NewAuditLoggerList(first, second)
+		})
+
+		It("logs to all loggers", func() {
+			Expect(auditLogger.Log(ctx, event)).To(Succeed())
+			Expect(first.LogCallCount()).To(Equal(1))
+			Expect(second.LogCallCount()).To(Equal(1))
+		})
+
+		It("logs to remaining loggers if one fails", func() {
+			first.LogReturns(errors.New(ctx, "banana"))
+			Expect(auditLogger.Log(ctx, event)).To(HaveOccurred())
+			Expect(second.LogCallCount()).To(Equal(1))
+		})
+	})
+})
@@ -0,0 +1,43 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/golang/glog"
+)
+
+// NewAuthHandler rejects requests without a valid token with 401 and passes the
+// identity of authenticated requests in the context to the given handler.
+// The token is read from the Authorization bearer header or the X-API-Key header.
+func NewAuthHandler(
+	authenticator Authenticator,
+	handler http.Handler,
+) http.Handler {
+	return http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+		ctx := req.Context()
+		identity, err := authenticator.Authenticate(ctx, requestToken(req))
+		if err != nil {
+			glog.V(2).Infof("authenticate %s %s failed: %v", req.Method, req.URL.Path, err)
+			resp.Header().Set("WWW-Authenticate", `Bearer realm="kafka-topic-reader"`)
+			http.Error(resp, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(resp, req.WithContext(WithIdentity(ctx, identity)))
+	})
+}
+
+func requestToken(req *http.Request) string {
+	if token := req.Header.Get("X-API-Key"); token != "" {
+		return token
+	}
+	scheme, token, ok := strings.Cut(req.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
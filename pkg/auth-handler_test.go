@@ -0,0 +1,99 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/bborbe/errors"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/mocks"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("AuthHandler", func() {
+	var authenticator *mocks.Authenticator
+	var handler http.Handler
+	var request *http.Request
+	var response *httptest.ResponseRecorder
+	var identity *pkg.Identity
+
+	BeforeEach(func() {
+		identity = nil
+		authenticator = &mocks.Authenticator{}
+		authenticator.AuthenticateReturns(&pkg.Identity{Subject: "alice"}, nil)
+		handler = pkg.NewAuthHandler(
+			authenticator,
+			http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+				identity = pkg.IdentityFromContext(req.Context())
+				_, _ = resp.Write([]byte("OK"))
+			}),
+		)
+		request = httptest.NewRequest(http.MethodGet, "/read", nil)
+		response = httptest.NewRecorder()
+	})
+
+	JustBeforeEach(func() {
+		handler.ServeHTTP(response, request)
+	})
+
+	Context("with bearer token", func() {
+		BeforeEach(func() {
+			request.Header.Set("Authorization", "Bearer my-token")
+		})
+
+		It("passes identity to handler", func() {
+			Expect(response.Code).To(Equal(http.StatusOK))
+			Expect(identity).NotTo(BeNil())
+			Expect(identity.Subject).To(Equal("alice"))
+		})
+
+		It("authenticates token", func() {
+			_, token := authenticator.AuthenticateArgsForCall(0)
+			Expect(token).To(Equal("my-token"))
+		})
+	})
+
+	Context("with api key header", func() {
+		BeforeEach(func() {
+			request.Header.Set("X-API-Key", "my-key")
+		})
+
+		It("authenticates key", func() {
+			_, token := authenticator.AuthenticateArgsForCall(0)
+			Expect(token).To(Equal("my-key"))
+		})
+	})
+
+	Context("with basic auth", func() {
+		BeforeEach(func() {
+			request.SetBasicAuth("alice", "secret")
+		})
+
+		It("ignores the header", func() {
+			_, token := authenticator.AuthenticateArgsForCall(0)
+			Expect(token).To(BeEmpty())
+		})
+	})
+
+	Context("unauthenticated", func() {
+		BeforeEach(func() {
+			authenticator.AuthenticateReturns(
+				nil,
+				errors.Wrap(context.Background(), pkg.ErrUnauthenticated, "invalid"),
+			)
+		})
+
+		It("returns unauthorized", func() {
+			Expect(response.Code).To(Equal(http.StatusUnauthorized))
+			Expect(response.Header().Get("WWW-Authenticate")).To(HavePrefix("Bearer"))
+			Expect(identity).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,45 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+	stderrors "errors"
+
+	"github.com/bborbe/errors"
+)
+
+// ErrUnauthenticated is returned if a token is missing or not accepted.
+var ErrUnauthenticated = stderrors.New("unauthenticated")
+
+//counterfeiter:generate -o ../mocks/authenticator.go --fake-name Authenticator . Authenticator
+type Authenticator interface {
+	// Authenticate returns the identity for the token or an error wrapping ErrUnauthenticated.
+	Authenticate(ctx context.Context, token string) (*Identity, error)
+}
+
+type AuthenticatorFunc func(ctx context.Context, token string) (*Identity, error)
+
+func (a AuthenticatorFunc) Authenticate(ctx context.Context, token string) (*Identity, error) {
+	return a(ctx, token)
+}
+
+// NewAuthenticatorList accepts a token if any of the given authenticators accepts it.
+func NewAuthenticatorList(authenticators ...Authenticator) Authenticator {
+	return AuthenticatorFunc(func(ctx context.Context, token string) (*Identity, error) {
+		if token == "" {
+			return nil, errors.Wrap(ctx, ErrUnauthenticated, "token missing")
+		}
+		err := errors.Wrap(ctx, ErrUnauthenticated, "no authenticator configured")
+		for _, authenticator := range authenticators {
+			var identity *Identity
+			identity, err = authenticator.Authenticate(ctx, token)
+			if err == nil {
+				return identity, nil
+			}
+		}
+		return nil, err
+	})
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+
+	"github.com/bborbe/errors"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/mocks"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("AuthenticatorList", func() {
+	var ctx context.Context
+	var first *mocks.Authenticator
+	var second *mocks.Authenticator
+	var authenticator pkg.Authenticator
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		first = &mocks.Authenticator{}
+		first.AuthenticateReturns(nil, errors.Wrap(ctx, pkg.ErrUnauthenticated, "first"))
+		second = &mocks.Authenticator{}
+		second.AuthenticateReturns(&pkg.Identity{Subject: "alice"}, nil)
+		authenticator = pkg.NewAuthenticatorList(first, second)
+	})
+
+	It("returns identity of first accepting authenticator", func() {
+		identity, err := authenticator.Authenticate(ctx, "token")
+		Expect(err).To(BeNil())
+		Expect(identity.Subject).To(Equal("alice"))
+		Expect(first.AuthenticateCallCount()).To(Equal(1))
+		Expect(second.AuthenticateCallCount()).To(Equal(1))
+	})
+
+	It("returns error if no authenticator accepts", func() {
+		second.AuthenticateReturns(nil, errors.Wrap(ctx, pkg.ErrUnauthenticated, "second"))
+		_, err := authenticator.Authenticate(ctx, "token")
+		Expect(errors.Is(err, pkg.ErrUnauthenticated)).To(BeTrue())
+	})
+
+	It("rejects empty token without asking authenticators", func() {
+		_, err := authenticator.Authenticate(ctx, "")
+		Expect(errors.Is(err, pkg.ErrUnauthenticated)).To(BeTrue())
+		Expect(first.AuthenticateCallCount()).To(Equal(0))
+	})
+
+	It("rejects all tokens without authenticators", func() {
+		_, err := pkg.NewAuthenticatorList().Authenticate(ctx, "token")
+		Expect(errors.Is(err, pkg.ErrUnauthenticated)).To(BeTrue())
+	})
+})
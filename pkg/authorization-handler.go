@@ -0,0 +1,61 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"net/http"
+
+	libkafka "github.com/bborbe/kafka"
+	"github.com/golang/glog"
+	"github.com/gorilla/mux"
+)
+
+// NewAuthorizationHandler returns 403 if the identity of the request may not perform
+// the operation on the requested topic. The topic is taken from the route variable
+// topic or the topic parameter, requests without topic are passed on. Admin requests
+// without topic affect all topics, they need the operation on the topic pattern "*".
+func NewAuthorizationHandler(
+	authorizer Authorizer,
+	cluster ClusterName,
+	operation Operation,
+	handler http.Handler,
+) http.Handler {
+	return http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+		topic := libkafka.Topic(mux.Vars(req)["topic"])
+		if topic == "" {
+			topic = libkafka.Topic(req.FormValue("topic"))
+		}
+		if topic == "" && operation == OperationAdmin {
+			topic = allTopics
+		}
+		if topic == "" {
+			handler.ServeHTTP(resp, req)
+			return
+		}
+		identity := IdentityFromContext(req.Context())
+		if !authorizer.Allowed(identity, cluster, topic, operation) {
+			glog.V(2).Infof(
+				"deny %s of topic %s in cluster %s for %s",
+				operation,
+				topic,
+				cluster,
+				identitySubject(identity),
+			)
+			http.Error(resp, "forbidden", http.StatusForbidden)
+			return
+		}
+		handler.ServeHTTP(resp, req)
+	})
+}
+
+// allTopics is covered only by rules with the topic pattern "*".
+const allTopics libkafka.Topic = "*"
+
+func identitySubject(identity *Identity) string {
+	if identity == nil {
+		return "anonymous"
+	}
+	return identity.Subject
+}
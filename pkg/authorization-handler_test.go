@@ -0,0 +1,98 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/mocks"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("AuthorizationHandler", func() {
+	var authorizer *mocks.Authorizer
+	var router *mux.Router
+	var response *httptest.ResponseRecorder
+	var identity *pkg.Identity
+	var called bool
+
+	BeforeEach(func() {
+		called = false
+		identity = &pkg.Identity{Subject: "alice"}
+		authorizer = &mocks.Authorizer{}
+		authorizer.AllowedReturns(true)
+		next := http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+			called = true
+		})
+		router = mux.NewRouter()
+		router.Path("/read").
+			Handler(pkg.NewAuthorizationHandler(authorizer, "prod", pkg.OperationRead, next))
+		router.Path("/topics/{topic}/config").
+			Handler(pkg.NewAuthorizationHandler(authorizer, "prod", pkg.OperationRead, next))
+		router.Path("/index/compact").
+			Handler(pkg.NewAuthorizationHandler(authorizer, "prod", pkg.OperationAdmin, next))
+		response = httptest.NewRecorder()
+	})
+
+	serve := func(target string) {
+		request := httptest.NewRequest(http.MethodGet, target, nil)
+		request = request.WithContext(pkg.WithIdentity(context.Background(), identity))
+		router.ServeHTTP(response, request)
+	}
+
+	It("checks topic parameter", func() {
+		serve("/read?topic=orders")
+		Expect(called).To(BeTrue())
+		Expect(authorizer.AllowedCallCount()).To(Equal(1))
+		argIdentity, cluster, topic, operation := authorizer.AllowedArgsForCall(0)
+		Expect(argIdentity).To(Equal(identity))
+		Expect(cluster).To(Equal(pkg.ClusterName("prod")))
+		Expect(topic.String()).To(Equal("orders"))
+		Expect(operation).To(Equal(pkg.OperationRead))
+	})
+
+	It("checks topic route variable", func() {
+		serve("/topics/users/config?topic=orders")
+		_, _, topic, _ := authorizer.AllowedArgsForCall(0)
+		Expect(topic.String()).To(Equal("users"))
+	})
+
+	It("returns forbidden if not allowed", func() {
+		authorizer.AllowedReturns(false)
+		serve("/read?topic=orders")
+		Expect(response.Code).To(Equal(http.StatusForbidden))
+		Expect(called).To(BeFalse())
+	})
+
+	It("passes requests without topic", func() {
+		serve("/read")
+		Expect(called).To(BeTrue())
+		Expect(authorizer.AllowedCallCount()).To(Equal(0))
+	})
+
+	It("checks admin requests without topic for all topics", func() {
+		authorizer.AllowedReturns(false)
+		serve("/index/compact")
+		Expect(response.Code).To(Equal(http.StatusForbidden))
+		Expect(called).To(BeFalse())
+		_, _, topic, operation := authorizer.AllowedArgsForCall(0)
+		Expect(topic.String()).To(Equal("*"))
+		Expect(operation).To(Equal(pkg.OperationAdmin))
+	})
+
+	It("checks admin requests with topic for the topic", func() {
+		router.Path("/index/rebuild").
+			Handler(pkg.NewAuthorizationHandler(authorizer, "prod", pkg.OperationAdmin, http.NotFoundHandler()))
+		serve("/index/rebuild?topic=orders")
+		_, _, topic, _ := authorizer.AllowedArgsForCall(0)
+		Expect(topic.String()).To(Equal("orders"))
+	})
+})
@@ -0,0 +1,178 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path"
+
+	"github.com/bborbe/errors"
+	libkafka "github.com/bborbe/kafka"
+)
+
+type Operation string
+
+const (
+	// OperationRead reads decoded messages with /read.
+	OperationRead Operation = "read"
+	// OperationRaw downloads the raw message bytes.
+	OperationRaw Operation = "raw"
+	// OperationTail follows new messages of a topic.
+	OperationTail Operation = "tail"
+	// OperationExport exports many messages at once.
+	OperationExport Operation = "export"
+	// OperationAdmin rebuilds and compacts the offset index.
+	OperationAdmin Operation = "admin"
+	// OperationAll in a rule allows all operations.
+	OperationAll Operation = "*"
+)
+
+var knownOperations = map[Operation]bool{
+	OperationRead:   true,
+	OperationRaw:    true,
+	OperationTail:   true,
+	OperationExport: true,
+	OperationAdmin:  true,
+	OperationAll:    true,
+}
+
+func (o Operation) String() string {
+	return string(o)
+}
+
+// Policy grants operations on topics. Everything not granted by a rule is denied.
+type Policy struct {
+	Rules PolicyRules `json:"rules"`
+}
+
+type PolicyRules []PolicyRule
+
+// PolicyRule applies to an identity if its subject is listed ("*" matches every
+// authenticated identity), if it is member of a listed group or if all listed claims
+// have the given value. Clusters and topics are glob patterns, no clusters means all.
+type PolicyRule struct {
+	Subjects   []string          `json:"subjects,omitempty"`
+	Groups     []string          `json:"groups,omitempty"`
+	Claims     map[string]string `json:"claims,omitempty"`
+	Clusters   []string          `json:"clusters,omitempty"`
+	Topics     []string          `json:"topics"`
+	Operations []Operation       `json:"operations"`
+}
+
+// ParsePolicyFile reads and validates a JSON policy.
+func ParsePolicyFile(ctx context.Context, path string) (*Policy, error) {
+	content, err := os.ReadFile(path) // #nosec G304 -- path is configured by the operator
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "read policy file %s failed", path)
+	}
+	var result Policy
+	if err := json.Unmarshal(content, &result); err != nil {
+		return nil, errors.Wrapf(ctx, err, "parse policy file %s failed", path)
+	}
+	if err := result.Validate(ctx); err != nil {
+		return nil, errors.Wrapf(ctx, err, "validate policy file %s failed", path)
+	}
+	return &result, nil
+}
+
+// Validate ensures every rule has a principal, valid patterns and known operations.
+func (p Policy) Validate(ctx context.Context) error {
+	for i, rule := range p.Rules {
+		if err := rule.Validate(ctx); err != nil {
+			return errors.Wrapf(ctx, err, "validate rule %d failed", i)
+		}
+	}
+	return nil
+}
+
+func (p PolicyRule) Validate(ctx context.Context) error {
+	if len(p.Subjects) == 0 && len(p.Groups) == 0 && len(p.Claims) == 0 {
+		return errors.New(ctx, "subjects, groups or claims required")
+	}
+	if len(p.Topics) == 0 {
+		return errors.New(ctx, "topics missing")
+	}
+	if len(p.Operations) == 0 {
+		return errors.New(ctx, "operations missing")
+	}
+	for _, pattern := range append(append([]string{}, p.Topics...), p.Clusters...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return errors.Wrapf(ctx, err, "invalid pattern %s", pattern)
+		}
+	}
+	for _, operation := range p.Operations {
+		if !knownOperations[operation] {
+			return errors.Errorf(ctx, "unknown operation %s", operation)
+		}
+	}
+	return nil
+}
+
+// AppliesTo reports whether the rule applies to the identity.
+func (p PolicyRule) AppliesTo(identity *Identity) bool {
+	if identity == nil {
+		return false
+	}
+	for _, subject := range p.Subjects {
+		if subject == "*" || subject == identity.Subject {
+			return true
+		}
+	}
+	for _, group := range p.Groups {
+		for _, identityGroup := range identity.Groups {
+			if group == identityGroup {
+				return true
+			}
+		}
+	}
+	if len(p.Claims) == 0 {
+		return false
+	}
+	for name, value := range p.Claims {
+		if !claimHasValue(identity.Claims[name], value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Covers reports whether the rule covers the topic of the cluster.
+func (p PolicyRule) Covers(cluster ClusterName, topic libkafka.Topic) bool {
+	if len(p.Clusters) > 0 && !matchesAny(p.Clusters, cluster.String()) {
+		return false
+	}
+	return matchesAny(p.Topics, topic.String())
+}
+
+// Grants reports whether the rule allows the operation.
+func (p PolicyRule) Grants(operation Operation) bool {
+	for _, granted := range p.Operations {
+		if granted == OperationAll || granted == operation {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesAny(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, value); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// claimHasValue compares string claims and checks membership for array claims.
+func claimHasValue(claim interface{}, value string) bool {
+	for _, item := range claimStrings(claim) {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
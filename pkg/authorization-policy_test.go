@@ -0,0 +1,180 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	libkafka "github.com/bborbe/kafka"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("Policy", func() {
+	var ctx context.Context
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	Context("ParsePolicyFile", func() {
+		var dir string
+
+		BeforeEach(func() {
+			dir = GinkgoT().TempDir()
+		})
+
+		writeFile := func(content string) string {
+			path := filepath.Join(dir, "policy.json")
+			Expect(os.WriteFile(path, []byte(content), 0600)).To(Succeed())
+			return path
+		}
+
+		It("parses rules", func() {
+			policy, err := pkg.ParsePolicyFile(ctx, writeFile(`{"rules": [
+				{"groups": ["billing"], "clusters": ["prod"], "topics": ["billing-*"], "operations": ["read", "raw"]}
+			]}`))
+			Expect(err).To(BeNil())
+			Expect(policy.Rules).To(Equal(pkg.PolicyRules{
+				{
+					Groups:     []string{"billing"},
+					Clusters:   []string{"prod"},
+					Topics:     []string{"billing-*"},
+					Operations: []pkg.Operation{pkg.OperationRead, pkg.OperationRaw},
+				},
+			}))
+		})
+
+		DescribeTable(
+			"returns error",
+			func(content string) {
+				_, err := pkg.ParsePolicyFile(ctx, writeFile(content))
+				Expect(err).NotTo(BeNil())
+			},
+			Entry("invalid json", `{`),
+			Entry(
+				"rule without principal",
+				`{"rules": [{"topics": ["*"], "operations": ["read"]}]}`,
+			),
+			Entry(
+				"rule without topics",
+				`{"rules": [{"subjects": ["*"], "operations": ["read"]}]}`,
+			),
+			Entry("rule without operations", `{"rules": [{"subjects": ["*"], "topics": ["*"]}]}`),
+			Entry(
+				"invalid pattern",
+				`{"rules": [{"subjects": ["*"], "topics": ["["], "operations": ["read"]}]}`,
+			),
+			Entry(
+				"unknown operation",
+				`{"rules": [{"subjects": ["*"], "topics": ["*"], "operations": ["write"]}]}`,
+			),
+		)
+	})
+
+	Context("PolicyRule", func() {
+		var identity *pkg.Identity
+
+		BeforeEach(func() {
+			identity = &pkg.Identity{
+				Subject: "alice",
+				Groups:  []string{"billing", "ops"},
+				Claims: map[string]interface{}{
+					"department": "finance",
+					"roles":      []interface{}{"auditor", "viewer"},
+				},
+			}
+		})
+
+		DescribeTable(
+			"AppliesTo",
+			func(rule pkg.PolicyRule, expected bool) {
+				Expect(rule.AppliesTo(identity)).To(Equal(expected))
+			},
+			Entry("subject", pkg.PolicyRule{Subjects: []string{"alice"}}, true),
+			Entry("other subject", pkg.PolicyRule{Subjects: []string{"bob"}}, false),
+			Entry("any subject", pkg.PolicyRule{Subjects: []string{"*"}}, true),
+			Entry("group", pkg.PolicyRule{Groups: []string{"ops"}}, true),
+			Entry("other group", pkg.PolicyRule{Groups: []string{"admins"}}, false),
+			Entry(
+				"string claim",
+				pkg.PolicyRule{Claims: map[string]string{"department": "finance"}},
+				true,
+			),
+			Entry(
+				"array claim",
+				pkg.PolicyRule{Claims: map[string]string{"roles": "auditor"}},
+				true,
+			),
+			Entry(
+				"all claims must match",
+				pkg.PolicyRule{
+					Claims: map[string]string{"department": "finance", "roles": "admin"},
+				},
+				false,
+			),
+			Entry("missing claim", pkg.PolicyRule{Claims: map[string]string{"team": "a"}}, false),
+		)
+
+		It("does not apply to anonymous", func() {
+			Expect(pkg.PolicyRule{Subjects: []string{"*"}}.AppliesTo(nil)).To(BeFalse())
+		})
+
+		DescribeTable(
+			"Covers",
+			func(rule pkg.PolicyRule, cluster pkg.ClusterName, topic string, expected bool) {
+				Expect(rule.Covers(cluster, libkafka.Topic(topic))).To(Equal(expected))
+			},
+			Entry(
+				"topic",
+				pkg.PolicyRule{Topics: []string{"orders"}},
+				pkg.ClusterName("dev"),
+				"orders",
+				true,
+			),
+			Entry(
+				"glob",
+				pkg.PolicyRule{Topics: []string{"orders-*"}},
+				pkg.ClusterName("dev"),
+				"orders-dlq",
+				true,
+			),
+			Entry(
+				"other topic",
+				pkg.PolicyRule{Topics: []string{"orders-*"}},
+				pkg.ClusterName("dev"),
+				"users",
+				false,
+			),
+			Entry(
+				"cluster",
+				pkg.PolicyRule{Clusters: []string{"prod"}, Topics: []string{"*"}},
+				pkg.ClusterName("prod"),
+				"orders",
+				true,
+			),
+			Entry(
+				"other cluster",
+				pkg.PolicyRule{Clusters: []string{"prod"}, Topics: []string{"*"}},
+				pkg.ClusterName("dev"),
+				"orders",
+				false,
+			),
+		)
+
+		DescribeTable("Grants",
+			func(operations []pkg.Operation, operation pkg.Operation, expected bool) {
+				Expect(pkg.PolicyRule{Operations: operations}.Grants(operation)).To(Equal(expected))
+			},
+			Entry("listed", []pkg.Operation{pkg.OperationRead}, pkg.OperationRead, true),
+			Entry("not listed", []pkg.Operation{pkg.OperationRead}, pkg.OperationExport, false),
+			Entry("all", []pkg.Operation{pkg.OperationAll}, pkg.OperationTail, true),
+		)
+	})
+})
@@ -0,0 +1,147 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+
+	"github.com/bborbe/errors"
+	libkafka "github.com/bborbe/kafka"
+)
+
+// NewAuthorizedTopicsProvider only returns topics visible to the identity of the context.
+func NewAuthorizedTopicsProvider(
+	topicsProvider TopicsProvider,
+	authorizer Authorizer,
+	cluster ClusterName,
+) TopicsProvider {
+	return &authorizedTopicsProvider{
+		topicsProvider: topicsProvider,
+		authorizer:     authorizer,
+		cluster:        cluster,
+	}
+}
+
+type authorizedTopicsProvider struct {
+	topicsProvider TopicsProvider
+	authorizer     Authorizer
+	cluster        ClusterName
+}
+
+func (a *authorizedTopicsProvider) Topics(ctx context.Context) (TopicInfos, error) {
+	topics, err := a.topicsProvider.Topics(ctx)
+	if err != nil {
+		return nil, err
+	}
+	identity := IdentityFromContext(ctx)
+	result := make(TopicInfos, 0, len(topics))
+	for _, topic := range topics {
+		if a.authorizer.Visible(identity, a.cluster, topic.Name) {
+			result = append(result, topic)
+		}
+	}
+	return result, nil
+}
+
+// NewAuthorizedConsumerGroupsProvider removes offsets and assignments of topics not
+// visible to the identity of the context from consumer group details. Groups without
+// committed offsets on visible topics are not listed and their offsets not resolved.
+func NewAuthorizedConsumerGroupsProvider(
+	consumerGroupsProvider ConsumerGroupsProvider,
+	authorizer Authorizer,
+	cluster ClusterName,
+) ConsumerGroupsProvider {
+	return &authorizedConsumerGroupsProvider{
+		consumerGroupsProvider: consumerGroupsProvider,
+		authorizer:             authorizer,
+		cluster:                cluster,
+	}
+}
+
+type authorizedConsumerGroupsProvider struct {
+	consumerGroupsProvider ConsumerGroupsProvider
+	authorizer             Authorizer
+	cluster                ClusterName
+}
+
+func (a *authorizedConsumerGroupsProvider) ConsumerGroups(
+	ctx context.Context,
+) (ConsumerGroupInfos, error) {
+	groups, err := a.consumerGroupsProvider.ConsumerGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	identity := IdentityFromContext(ctx)
+	result := make(ConsumerGroupInfos, 0, len(groups))
+	for _, group := range groups {
+		topics := make([]libkafka.Topic, 0, len(group.Topics))
+		for _, topic := range group.Topics {
+			if a.authorizer.Visible(identity, a.cluster, topic) {
+				topics = append(topics, topic)
+			}
+		}
+		if len(topics) == 0 {
+			continue
+		}
+		group.Topics = topics
+		result = append(result, group)
+	}
+	return result, nil
+}
+
+func (a *authorizedConsumerGroupsProvider) ConsumerGroup(
+	ctx context.Context,
+	group string,
+) (*ConsumerGroupDetail, error) {
+	detail, err := a.consumerGroupsProvider.ConsumerGroup(ctx, group)
+	if err != nil {
+		return nil, err
+	}
+	identity := IdentityFromContext(ctx)
+	visible := func(topic libkafka.Topic) bool {
+		return a.authorizer.Visible(identity, a.cluster, topic)
+	}
+
+	offsets := make(ConsumerGroupOffsets, 0, len(detail.Offsets))
+	var totalLag int64
+	for _, offset := range detail.Offsets {
+		if visible(offset.Topic) {
+			offsets = append(offsets, offset)
+			totalLag += offset.Lag
+		}
+	}
+	detail.Offsets = offsets
+	detail.TotalLag = totalLag
+
+	for i, member := range detail.Members {
+		assignments := make(map[libkafka.Topic][]libkafka.Partition, len(member.Assignments))
+		for topic, partitions := range member.Assignments {
+			if visible(topic) {
+				assignments[topic] = partitions
+			}
+		}
+		detail.Members[i].Assignments = assignments
+	}
+	return detail, nil
+}
+
+func (a *authorizedConsumerGroupsProvider) CommittedOffset(
+	ctx context.Context,
+	group string,
+	topic libkafka.Topic,
+	partition libkafka.Partition,
+) (*libkafka.Offset, error) {
+	if !a.authorizer.Visible(IdentityFromContext(ctx), a.cluster, topic) {
+		// same error as a missing commit, the group stays hidden
+		return nil, errors.Errorf(
+			ctx,
+			"consumer group %s has no committed offset for topic %s partition %d",
+			group,
+			topic,
+			partition,
+		)
+	}
+	return a.consumerGroupsProvider.CommittedOffset(ctx, group, topic, partition)
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+
+	"github.com/bborbe/errors"
+	libkafka "github.com/bborbe/kafka"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/mocks"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("AuthorizedProviders", func() {
+	var ctx context.Context
+	var identity *pkg.Identity
+	var authorizer *mocks.Authorizer
+
+	BeforeEach(func() {
+		identity = &pkg.Identity{Subject: "alice"}
+		ctx = pkg.WithIdentity(context.Background(), identity)
+		authorizer = &mocks.Authorizer{}
+		authorizer.VisibleStub = func(_ *pkg.Identity, _ pkg.ClusterName, topic libkafka.Topic) bool {
+			return topic != "secret"
+		}
+	})
+
+	Context("TopicsProvider", func() {
+		var topicsProvider *mocks.TopicsProvider
+		var provider pkg.TopicsProvider
+
+		BeforeEach(func() {
+			topicsProvider = &mocks.TopicsProvider{}
+			topicsProvider.TopicsReturns(pkg.TopicInfos{
+				{Name: "orders"},
+				{Name: "secret"},
+				{Name: "users"},
+			}, nil)
+			provider = pkg.NewAuthorizedTopicsProvider(topicsProvider, authorizer, "prod")
+		})
+
+		It("returns only visible topics", func() {
+			topics, err := provider.Topics(ctx)
+			Expect(err).To(BeNil())
+			Expect(topics).To(Equal(pkg.TopicInfos{{Name: "orders"}, {Name: "users"}}))
+			argIdentity, cluster, _ := authorizer.VisibleArgsForCall(0)
+			Expect(argIdentity).To(Equal(identity))
+			Expect(cluster).To(Equal(pkg.ClusterName("prod")))
+		})
+
+		It("returns error", func() {
+			topicsProvider.TopicsReturns(nil, errors.New(ctx, "banana"))
+			_, err := provider.Topics(ctx)
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Context("ConsumerGroupsProvider", func() {
+		var consumerGroupsProvider *mocks.ConsumerGroupsProvider
+		var provider pkg.ConsumerGroupsProvider
+
+		BeforeEach(func() {
+			consumerGroupsProvider = &mocks.ConsumerGroupsProvider{}
+			consumerGroupsProvider.ConsumerGroupReturns(&pkg.ConsumerGroupDetail{
+				Name: "billing",
+				Members: pkg.ConsumerGroupMembers{
+					{
+						MemberID: "member-1",
+						Assignments: map[libkafka.Topic][]libkafka.Partition{
+							"orders": {0, 1},
+							"secret": {0},
+						},
+					},
+				},
+				Offsets: pkg.ConsumerGroupOffsets{
+					{Topic: "orders", Partition: 0, Lag: 3},
+					{Topic: "secret", Partition: 0, Lag: 7},
+					{Topic: "orders", Partition: 1, Lag: 2},
+				},
+				TotalLag: 12,
+			}, nil)
+			provider = pkg.NewAuthorizedConsumerGroupsProvider(
+				consumerGroupsProvider,
+				authorizer,
+				"prod",
+			)
+		})
+
+		It("removes offsets and lag of hidden topics", func() {
+			detail, err := provider.ConsumerGroup(ctx, "billing")
+			Expect(err).To(BeNil())
+			Expect(detail.Offsets).To(Equal(pkg.ConsumerGroupOffsets{
+				{Topic: "orders", Partition: 0, Lag: 3},
+				{Topic: "orders", Partition: 1, Lag: 2},
+			}))
+			Expect(detail.TotalLag).To(Equal(int64(5)))
+		})
+
+		It("removes assignments of hidden topics", func() {
+			detail, err := provider.ConsumerGroup(ctx, "billing")
+			Expect(err).To(BeNil())
+			Expect(detail.Members[0].Assignments).To(Equal(map[libkafka.Topic][]libkafka.Partition{
+				"orders": {0, 1},
+			}))
+		})
+
+		Context("ConsumerGroups", func() {
+			BeforeEach(func() {
+				consumerGroupsProvider.ConsumerGroupsReturns(pkg.ConsumerGroupInfos{
+					{Name: "analyzer", Topics: []libkafka.Topic{"secret"}},
+					{Name: "billing", Topics: []libkafka.Topic{"orders", "secret"}},
+					{Name: "idle", Topics: []libkafka.Topic{}},
+				}, nil)
+			})
+
+			It("returns only groups with offsets on visible topics", func() {
+				groups, err := provider.ConsumerGroups(ctx)
+				Expect(err).To(BeNil())
+				Expect(groups).To(Equal(pkg.ConsumerGroupInfos{
+					{Name: "billing", Topics: []libkafka.Topic{"orders"}},
+				}))
+			})
+
+			It("returns error", func() {
+				consumerGroupsProvider.ConsumerGroupsReturns(nil, errors.New(ctx, "banana"))
+				_, err := provider.ConsumerGroups(ctx)
+				Expect(err).NotTo(BeNil())
+			})
+		})
+
+		Context("CommittedOffset", func() {
+			BeforeEach(func() {
+				offset := libkafka.Offset(42)
+				consumerGroupsProvider.CommittedOffsetReturns(&offset, nil)
+			})
+
+			It("returns committed offset of visible topic", func() {
+				committed, err := provider.CommittedOffset(ctx, "billing", "orders", 0)
+				Expect(err).To(BeNil())
+				Expect(*committed).To(Equal(libkafka.Offset(42)))
+			})
+
+			It("returns error for hidden topic", func() {
+				_, err := provider.CommittedOffset(ctx, "billing", "secret", 0)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("has no committed offset"))
+				Expect(consumerGroupsProvider.CommittedOffsetCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,75 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	libkafka "github.com/bborbe/kafka"
+)
+
+//counterfeiter:generate -o ../mocks/authorizer.go --fake-name Authorizer . Authorizer
+type Authorizer interface {
+	// Allowed reports whether the identity may perform the operation on the topic.
+	Allowed(
+		identity *Identity,
+		cluster ClusterName,
+		topic libkafka.Topic,
+		operation Operation,
+	) bool
+	// Visible reports whether the identity may perform any operation on the topic.
+	Visible(identity *Identity, cluster ClusterName, topic libkafka.Topic) bool
+}
+
+// NewAllowAllAuthorizer is used if no policy is configured.
+func NewAllowAllAuthorizer() Authorizer {
+	return &allowAllAuthorizer{}
+}
+
+type allowAllAuthorizer struct{}
+
+func (a *allowAllAuthorizer) Allowed(*Identity, ClusterName, libkafka.Topic, Operation) bool {
+	return true
+}
+
+func (a *allowAllAuthorizer) Visible(*Identity, ClusterName, libkafka.Topic) bool {
+	return true
+}
+
+// NewPolicyAuthorizer allows what a rule of the policy grants and denies everything else.
+func NewPolicyAuthorizer(policy Policy) Authorizer {
+	return &policyAuthorizer{
+		policy: policy,
+	}
+}
+
+type policyAuthorizer struct {
+	policy Policy
+}
+
+func (p *policyAuthorizer) Allowed(
+	identity *Identity,
+	cluster ClusterName,
+	topic libkafka.Topic,
+	operation Operation,
+) bool {
+	for _, rule := range p.policy.Rules {
+		if rule.Grants(operation) && rule.Covers(cluster, topic) && rule.AppliesTo(identity) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *policyAuthorizer) Visible(
+	identity *Identity,
+	cluster ClusterName,
+	topic libkafka.Topic,
+) bool {
+	for _, rule := range p.policy.Rules {
+		if rule.Covers(cluster, topic) && rule.AppliesTo(identity) {
+			return true
+		}
+	}
+	return false
+}
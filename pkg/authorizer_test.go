@@ -0,0 +1,129 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	libkafka "github.com/bborbe/kafka"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("Authorizer", func() {
+	var alice *pkg.Identity
+	var bob *pkg.Identity
+
+	BeforeEach(func() {
+		alice = &pkg.Identity{Subject: "alice", Groups: []string{"billing"}}
+		bob = &pkg.Identity{Subject: "bob"}
+	})
+
+	Context("AllowAll", func() {
+		It("allows everything", func() {
+			authorizer := pkg.NewAllowAllAuthorizer()
+			Expect(authorizer.Allowed(nil, "dev", "orders", pkg.OperationExport)).To(BeTrue())
+			Expect(authorizer.Visible(nil, "dev", "orders")).To(BeTrue())
+		})
+	})
+
+	Context("Policy", func() {
+		var authorizer pkg.Authorizer
+
+		BeforeEach(func() {
+			authorizer = pkg.NewPolicyAuthorizer(pkg.Policy{
+				Rules: pkg.PolicyRules{
+					{
+						Subjects:   []string{"*"},
+						Topics:     []string{"public-*"},
+						Operations: []pkg.Operation{pkg.OperationRead},
+					},
+					{
+						Groups:     []string{"billing"},
+						Clusters:   []string{"prod"},
+						Topics:     []string{"billing-*"},
+						Operations: []pkg.Operation{pkg.OperationRead, pkg.OperationRaw},
+					},
+				},
+			})
+		})
+
+		DescribeTable(
+			"Allowed",
+			func(
+				identity func() *pkg.Identity,
+				cluster pkg.ClusterName,
+				topic string,
+				operation pkg.Operation,
+				expected bool,
+			) {
+				Expect(authorizer.Allowed(identity(), cluster, libkafka.Topic(topic), operation)).
+					To(Equal(expected))
+			},
+			Entry(
+				"public read",
+				func() *pkg.Identity { return bob },
+				pkg.ClusterName("dev"),
+				"public-news",
+				pkg.OperationRead,
+				true,
+			),
+			Entry(
+				"public export",
+				func() *pkg.Identity { return bob },
+				pkg.ClusterName("dev"),
+				"public-news",
+				pkg.OperationExport,
+				false,
+			),
+			Entry(
+				"anonymous",
+				func() *pkg.Identity { return nil },
+				pkg.ClusterName("dev"),
+				"public-news",
+				pkg.OperationRead,
+				false,
+			),
+			Entry(
+				"group raw",
+				func() *pkg.Identity { return alice },
+				pkg.ClusterName("prod"),
+				"billing-invoices",
+				pkg.OperationRaw,
+				true,
+			),
+			Entry(
+				"group other cluster",
+				func() *pkg.Identity { return alice },
+				pkg.ClusterName("dev"),
+				"billing-invoices",
+				pkg.OperationRead,
+				false,
+			),
+			Entry(
+				"not in group",
+				func() *pkg.Identity { return bob },
+				pkg.ClusterName("prod"),
+				"billing-invoices",
+				pkg.OperationRead,
+				false,
+			),
+			Entry(
+				"uncovered topic",
+				func() *pkg.Identity { return alice },
+				pkg.ClusterName("prod"),
+				"users",
+				pkg.OperationRead,
+				false,
+			),
+		)
+
+		It("shows topics with any granted operation", func() {
+			Expect(authorizer.Visible(alice, "prod", "billing-invoices")).To(BeTrue())
+			Expect(authorizer.Visible(bob, "prod", "billing-invoices")).To(BeFalse())
+			Expect(authorizer.Visible(bob, "prod", "public-news")).To(BeTrue())
+		})
+	})
+})
@@ -6,17 +6,54 @@ package pkg
 
 import (
 	"context"
-	"runtime"
+	"iter"
+	"time"
 
 	"github.com/IBM/sarama"
 	"github.com/bborbe/errors"
 	libkafka "github.com/bborbe/kafka"
-	"github.com/bborbe/log"
-	"github.com/bborbe/run"
 	"github.com/bborbe/sentry"
 	"github.com/golang/glog"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// ReadStatus tells why a read stopped.
+type ReadStatus string
+
+const (
+	// ReadStatusComplete means the offset was already at the high watermark, nothing was left to read.
+	ReadStatusComplete ReadStatus = "complete"
+	// ReadStatusLimitReached means limit records matched, more may follow.
+	ReadStatusLimitReached ReadStatus = "limitReached"
+	// ReadStatusTimeout means the read was stopped by the deadline with the records read so far.
+	ReadStatusTimeout ReadStatus = "timeout"
+	// ReadStatusHighWaterMarkReached means all records up to the high watermark at read time were read.
+	ReadStatusHighWaterMarkReached ReadStatus = "highWaterMarkReached"
+	// ReadStatusLastStableOffsetReached means a read of committed records reached an open
+	// transaction, records after it are returned once the transaction ends.
+	ReadStatusLastStableOffsetReached ReadStatus = "lastStableOffsetReached"
+)
+
+// endIdleTimeout is added to the fetch max wait to detect the end of a read. Offsets of
+// transaction markers, and of aborted records for read_committed, are skipped by the
+// consumer, so a read whose last offsets are such offsets gets no message for them.
+const endIdleTimeout = time.Second
+
+// ChangesResult contains the matched records and how the read ended.
+type ChangesResult struct {
+	Records       Records
+	Status        ReadStatus
+	HighWaterMark libkafka.Offset
+	// LastStableOffset is set for reads of committed records, they end at it.
+	LastStableOffset *libkafka.Offset
+	// Scanned counts the consumed messages, Matched the ones passing the filter.
+	Scanned uint64
+	Matched uint64
+	// NextOffset is the offset after the last scanned message, nil if none was scanned.
+	NextOffset *libkafka.Offset
+}
+
 //counterfeiter:generate -o ../mocks/changes-provider.go --fake-name ChangesProvider . ChangesProvider
 type ChangesProvider interface {
 	Changes(
@@ -26,28 +63,64 @@ type ChangesProvider interface {
 		offset libkafka.Offset,
 		limit uint64,
 		filter []byte,
-	) (Records, error)
+		fetchConfig FetchConfig,
+	) (*ChangesResult, error)
 }
 
 func NewChangesProvider(
 	sentryClient sentry.Client,
 	saramaClient libkafka.SaramaClient,
 	converter Converter,
-	logSamplerFactory log.SamplerFactory,
+	redactor Redactor,
+	metrics Metrics,
+	consumerPool ConsumerPool,
+	messageCache MessageCache,
+	conversionWorkers int,
 ) ChangesProvider {
+	return newChangesProvider(
+		sentryClient,
+		saramaClient,
+		converter,
+		redactor,
+		metrics,
+		consumerPool,
+		messageCache,
+		conversionWorkers,
+	)
+}
+
+func newChangesProvider(
+	sentryClient sentry.Client,
+	saramaClient libkafka.SaramaClient,
+	converter Converter,
+	redactor Redactor,
+	metrics Metrics,
+	consumerPool ConsumerPool,
+	messageCache MessageCache,
+	conversionWorkers int,
+) *changesProvider {
 	return &changesProvider{
 		sentryClient:      sentryClient,
 		saramaClient:      saramaClient,
 		converter:         converter,
-		logSamplerFactory: logSamplerFactory,
+		redactor:          redactor,
+		metrics:           metrics,
+		consumerPool:      consumerPool,
+		messageCache:      messageCache,
+		conversionWorkers: conversionWorkers,
 	}
 }
 
 type changesProvider struct {
-	saramaClient      libkafka.SaramaClient
-	converter         Converter
-	sentryClient      sentry.Client
-	logSamplerFactory log.SamplerFactory
+	saramaClient libkafka.SaramaClient
+	converter    Converter
+	redactor     Redactor
+	metrics      Metrics
+	sentryClient sentry.Client
+	consumerPool ConsumerPool
+	messageCache MessageCache
+	// conversionWorkers above 1 converts messages in parallel
+	conversionWorkers int
 }
 
 func (c *changesProvider) Changes(
@@ -57,63 +130,127 @@ func (c *changesProvider) Changes(
 	offset libkafka.Offset,
 	limit uint64,
 	filter []byte,
-) (Records, error) {
-	var records Records
-	ch := make(chan Record, runtime.NumCPU())
-	err := run.CancelOnFirstError(
+	fetchConfig FetchConfig,
+) (*ChangesResult, error) {
+	start := time.Now()
+
+	ctx, span := tracer.Start(
 		ctx,
-		c.produceRecords(ch, topic, partition, offset, limit, filter),
-		c.collectRecords(ch, &records),
+		"ChangesProvider.Changes",
+		trace.WithAttributes(
+			attributeTopic.String(topic.String()),
+			attributePartition.Int64(int64(partition)),
+			attributeOffset.Int64(int64(offset)),
+		),
 	)
+	defer span.End()
+
+	highWaterMark, err := c.highWaterMark(ctx, topic, partition)
 	if err != nil {
-		if errors.Is(err, context.Canceled) {
-			return records, nil
+		recordSpanError(span, err)
+		return nil, err
+	}
+	result := &ChangesResult{
+		HighWaterMark: *highWaterMark,
+	}
+	endOffset := *highWaterMark
+	if fetchConfig.Isolation == IsolationReadCommitted {
+		lastStableOffset, err := c.lastStableOffset(ctx, topic, partition)
+		if err != nil {
+			recordSpanError(span, err)
+			return nil, err
 		}
-		return nil, errors.Wrap(ctx, err, "run failed")
+		result.LastStableOffset = lastStableOffset
+		endOffset = min(*lastStableOffset, *highWaterMark)
 	}
-	return records, nil
-}
 
-func (c *changesProvider) produceRecords(
-	ch chan Record,
-	topic libkafka.Topic,
-	partition libkafka.Partition,
-	offset libkafka.Offset,
-	limit uint64,
-	filter []byte,
-) func(context.Context) error {
-	return func(ctx context.Context) error {
-		defer close(ch)
+	offset = c.adjustNegativeOffset(offset, &endOffset)
+	if offset >= endOffset {
+		// consuming would wait for new messages until the timeout
+		result.Status = ReadStatusComplete
+		return result, nil
+	}
 
-		highWaterMark, err := libkafka.HighWaterMark(ctx, c.saramaClient, topic, partition)
+	var lastOffset libkafka.Offset = -1
+	// the messages end without error only at the end offset
+	endReached := true
+	messages := c.messages(ctx, topic, partition, offset, endOffset, fetchConfig, false)
+	for record, err := range c.records(ctx, messages, filter, result, &lastOffset) {
+		// canceled request or timeout, return the records read so far
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			endReached = false
+			break
+		}
 		if err != nil {
-			return errors.Wrapf(ctx, err, "get highwater marks failed")
+			recordSpanError(span, err)
+			return nil, errors.Wrap(ctx, err, "read records failed")
+		}
+		if len(result.Records) == 0 {
+			c.metrics.FirstRecord(topic, time.Since(start))
+		}
+		result.Records = append(result.Records, record)
+		if uint64(len(result.Records)) >= limit {
+			endReached = lastOffset+1 >= endOffset
+			break
 		}
+	}
+	if lastOffset >= 0 {
+		nextOffset := lastOffset + 1
+		result.NextOffset = &nextOffset
+	}
+	result.Status = readStatus(result, endReached, limit)
+	result.Matched = uint64(len(result.Records))
+	return result, nil
+}
 
-		offset = c.adjustNegativeOffset(offset, highWaterMark)
+func readStatus(result *ChangesResult, endReached bool, limit uint64) ReadStatus {
+	if endReached {
+		if result.LastStableOffset != nil && *result.LastStableOffset < result.HighWaterMark {
+			return ReadStatusLastStableOffsetReached
+		}
+		return ReadStatusHighWaterMarkReached
+	}
+	if uint64(len(result.Records)) >= limit {
+		return ReadStatusLimitReached
+	}
+	// stopped by the deadline or a canceled request
+	return ReadStatusTimeout
+}
 
-		trigger := run.NewTrigger()
-		ctx, cancel := context.WithCancel(ctx)
-		defer cancel()
+func (c *changesProvider) highWaterMark(
+	ctx context.Context,
+	topic libkafka.Topic,
+	partition libkafka.Partition,
+) (*libkafka.Offset, error) {
+	ctx, span := tracer.Start(ctx, "highWaterMark")
+	defer span.End()
 
-		var counter uint64
-		c.startTriggerWatcher(ctx, trigger, cancel, &counter)
+	highWaterMark, err := libkafka.HighWaterMark(ctx, c.saramaClient, topic, partition)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, errors.Wrapf(ctx, err, "get highwater marks failed")
+	}
+	span.SetAttributes(attribute.Int64("messaging.kafka.high_watermark", int64(*highWaterMark)))
+	return highWaterMark, nil
+}
 
-		return libkafka.NewSimpleConsumer(
-			c.saramaClient,
-			topic,
-			offset,
-			libkafka.MessageHanderList{
-				c.createMessageHandler(ch, filter, &counter, limit, trigger),
-				libkafka.NewOffsetTriggerMessageHandler(
-					map[libkafka.Partition]libkafka.Offset{partition: *highWaterMark},
-					topic,
-					trigger,
-				),
-			},
-			c.logSamplerFactory,
-		).Consume(ctx)
+func (c *changesProvider) lastStableOffset(
+	ctx context.Context,
+	topic libkafka.Topic,
+	partition libkafka.Partition,
+) (*libkafka.Offset, error) {
+	ctx, span := tracer.Start(ctx, "lastStableOffset")
+	defer span.End()
+
+	lastStableOffset, err := LastStableOffset(ctx, c.saramaClient, topic, partition)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
 	}
+	span.SetAttributes(
+		attribute.Int64("messaging.kafka.last_stable_offset", int64(*lastStableOffset)),
+	)
+	return lastStableOffset, nil
 }
 
 func (c *changesProvider) adjustNegativeOffset(
@@ -128,81 +265,245 @@ func (c *changesProvider) adjustNegativeOffset(
 	return offset
 }
 
-func (c *changesProvider) startTriggerWatcher(
+// records converts the messages, skipping those not matching the filter. Scanned
+// messages are counted in result, lastOffset is set to the offset of the last one.
+func (c *changesProvider) records(
 	ctx context.Context,
-	trigger run.Trigger,
-	cancel context.CancelFunc,
-	counter *uint64,
-) {
-	go func() {
-		select {
-		case <-ctx.Done():
-		case <-trigger.Done():
-			glog.V(2).Infof("read finished with %d records", *counter)
-			cancel()
-		}
-	}()
+	messages iter.Seq2[*sarama.ConsumerMessage, error],
+	filter []byte,
+	result *ChangesResult,
+	lastOffset *libkafka.Offset,
+) iter.Seq2[Record, error] {
+	return func(yield func(Record, error) bool) {
+		for converted := range c.convert(ctx, messages, filter) {
+			if converted.err != nil {
+				yield(Record{}, converted.err)
+				return
+			}
+			*lastOffset = libkafka.Offset(converted.msg.Offset)
+			result.Scanned++
+			if converted.record == nil {
+				continue
+			}
+			if !yield(*converted.record, nil) {
+				return
+			}
+		}
+	}
 }
 
-func (c *changesProvider) createMessageHandler(
-	ch chan Record,
+// record returns nil if the message does not match the filter.
+func (c *changesProvider) record(
+	ctx context.Context,
+	msg *sarama.ConsumerMessage,
 	filter []byte,
-	counter *uint64,
-	limit uint64,
-	trigger run.Trigger,
-) libkafka.MessageHandler {
-	return libkafka.MessageHandlerFunc(
-		func(ctx context.Context, msg *sarama.ConsumerMessage) error {
-			if !MatchesFilter(msg, filter) {
-				return nil
-			}
+) (*Record, error) {
+	topic := libkafka.Topic(msg.Topic)
+	c.metrics.BytesRead(topic, len(msg.Key)+len(msg.Value))
 
-			record, err := c.converter.Convert(ctx, msg)
-			if err != nil {
-				return errors.Wrap(ctx, err, "convert msg to record failed")
-			}
+	redacted := c.redactor.Redacts(topic)
+	// the raw bytes of a compressed value do not contain the filter, it is checked after
+	// the converter decompressed it
+	compressed := ValueContentEncoding(msg) != ContentEncodingIdentity
+	if !redacted && !compressed && len(filter) > 0 {
+		matched := MatchesFilter(msg, filter)
+		c.metrics.FilterChecked(topic, matched)
+		if !matched {
+			return nil, nil
+		}
+	}
 
-			return c.sendRecordOrCancel(ctx, ch, record, counter, limit, trigger)
-		},
-	)
+	record, err := c.converter.Convert(ctx, msg)
+	if err != nil {
+		c.metrics.ConversionFailed(topic, ConversionFailureReasonError)
+		return nil, errors.Wrap(ctx, err, "convert msg to record failed")
+	}
+	if record.valueDecodeFailure != "" {
+		c.metrics.ConversionFailed(topic, record.valueDecodeFailure)
+	}
+
+	if redacted {
+		c.redactor.Redact(record)
+	}
+	if len(filter) == 0 || (!redacted && !compressed) {
+		return record, nil
+	}
+	var matched bool
+	if redacted || record.valueDecompressed {
+		matched = MatchesValueFilter(record.Value, filter)
+	} else {
+		// not decompressed because disabled or failed, the value is not decoded
+		matched = MatchesFilter(msg, filter)
+	}
+	c.metrics.FilterChecked(topic, matched)
+	if !matched {
+		return nil, nil
+	}
+	return record, nil
 }
 
-func (c *changesProvider) sendRecordOrCancel(
+// messages returns the messages of the partition from offset up to endOffset, cached
+// messages first, the rest read from Kafka. It ends with the error of ctx if ctx is
+// done before. Reads of committed messages bypass the cache, it contains the messages
+// of aborted transactions. With noCache the messages read from Kafka are not added to
+// the cache, scans of large ranges would evict the messages of recent reads.
+func (c *changesProvider) messages(
 	ctx context.Context,
-	ch chan Record,
-	record *Record,
-	counter *uint64,
-	limit uint64,
-	trigger run.Trigger,
-) error {
-	select {
-	case <-ctx.Done():
-		return ctx.Err()
-	case ch <- *record:
-		*counter++
-		if *counter == limit {
-			trigger.Fire()
-			<-ctx.Done()
-		}
-		return nil
+	topic libkafka.Topic,
+	partition libkafka.Partition,
+	offset libkafka.Offset,
+	endOffset libkafka.Offset,
+	fetchConfig FetchConfig,
+	noCache bool,
+) iter.Seq2[*sarama.ConsumerMessage, error] {
+	return func(yield func(*sarama.ConsumerMessage, error) bool) {
+		ctx, span := tracer.Start(
+			ctx,
+			"consume",
+			trace.WithAttributes(attributeOffset.Int64(int64(offset))),
+		)
+		defer span.End()
+
+		var cached, fetched int
+		defer func() {
+			span.SetAttributes(
+				attribute.Int("read.cached", cached),
+				attribute.Int("read.fetched", fetched),
+			)
+		}()
+
+		// yieldMessage returns false if the loop must end
+		yieldMessage := func(msg *sarama.ConsumerMessage) bool {
+			fetched++
+			return yield(msg, nil) && libkafka.Offset(msg.Offset)+1 < endOffset
+		}
+
+		useCache := fetchConfig.Isolation != IsolationReadCommitted
+		for useCache && offset < endOffset {
+			messages, next, ok := c.messageCache.Get(topic, partition, offset)
+			if !ok || next <= offset {
+				break
+			}
+			for _, msg := range messages {
+				if err := ctx.Err(); err != nil {
+					yield(nil, err)
+					return
+				}
+				cached++
+				if !yieldMessage(msg) {
+					return
+				}
+			}
+			offset = next
+		}
+		if offset >= endOffset {
+			return
+		}
+
+		consumer, err := c.consumerPool.Acquire(ctx, topic, partition, offset, fetchConfig)
+		if err != nil {
+			recordSpanError(span, err)
+			yield(nil, errors.Wrapf(ctx, err, "acquire consumer failed"))
+			return
+		}
+		defer consumer.Release()
+
+		if useCache && !noCache {
+			messageCacheWriter := c.messageCache.Writer(topic, partition, offset)
+			defer messageCacheWriter.Close()
+			yieldMessage = func(msg *sarama.ConsumerMessage) bool {
+				messageCacheWriter.Add(msg)
+				fetched++
+				return yield(msg, nil) && libkafka.Offset(msg.Offset)+1 < endOffset
+			}
+		}
+
+		// the consumer skips the offsets of control records with both isolations
+		idleTimeout := fetchConfig.MaxWait + endIdleTimeout
+		nextOffset := offset
+		recordsLeft := func() bool {
+			left, err := RecordsBefore(
+				ctx,
+				c.saramaClient,
+				topic,
+				partition,
+				nextOffset,
+				endOffset,
+				fetchConfig.Isolation,
+			)
+			if err != nil {
+				glog.Warningf("check records of %s/%d left failed: %v", topic, partition, err)
+				return true
+			}
+			return left
+		}
+		for {
+			msg, err := nextBefore(ctx, consumer, endOffset, idleTimeout, recordsLeft)
+			if err != nil {
+				if !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) {
+					recordSpanError(span, err)
+				}
+				yield(nil, err)
+				return
+			}
+			if msg == nil {
+				span.SetAttributes(attribute.Bool("read.end_idle", true))
+				return
+			}
+			nextOffset = libkafka.Offset(msg.Offset) + 1
+			if !yieldMessage(msg) {
+				return
+			}
+		}
 	}
 }
 
-func (c *changesProvider) collectRecords(
-	ch chan Record,
-	records *Records,
-) func(context.Context) error {
-	return func(ctx context.Context) error {
-		for {
-			select {
-			case <-ctx.Done():
-				return ctx.Err()
-			case record, ok := <-ch:
-				if !ok {
-					return nil
+// nextBefore returns the next message of the consumer, nil if it is at or after
+// endOffset. With idleTimeout above 0 it also returns nil if no message arrives within
+// idleTimeout and recordsLeft tells no record is left before endOffset, the consumer
+// skipped transaction markers or aborted records. If records are left the fetch of the
+// consumer is still pending, so nextBefore keeps waiting until ctx is done.
+func nextBefore(
+	ctx context.Context,
+	consumer PooledConsumer,
+	endOffset libkafka.Offset,
+	idleTimeout time.Duration,
+	recordsLeft func() bool,
+) (*sarama.ConsumerMessage, error) {
+	for {
+		msg, err := nextWithin(ctx, consumer, idleTimeout)
+		if err != nil {
+			if idleTimeout > 0 && ctx.Err() == nil && errors.Is(err, context.DeadlineExceeded) {
+				if !recordsLeft() {
+					glog.V(3).Infof(
+						"no message before end offset %d within %v",
+						endOffset,
+						idleTimeout,
+					)
+					return nil, nil
 				}
-				*records = append(*records, record)
+				continue
 			}
+			return nil, err
+		}
+		if libkafka.Offset(msg.Offset) >= endOffset {
+			return nil, nil
 		}
+		return msg, nil
+	}
+}
+
+// nextWithin returns the next message of the consumer, waiting at most timeout if it
+// is above 0.
+func nextWithin(
+	ctx context.Context,
+	consumer PooledConsumer,
+	timeout time.Duration,
+) (*sarama.ConsumerMessage, error) {
+	if timeout <= 0 {
+		return consumer.Next(ctx)
 	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return consumer.Next(ctx)
 }
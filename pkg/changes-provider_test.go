@@ -5,17 +5,581 @@
 package pkg_test
 
 import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	libkafka "github.com/bborbe/kafka"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
 
+	"github.com/bborbe/kafka-topic-reader/mocks"
 	"github.com/bborbe/kafka-topic-reader/pkg"
 )
 
 var _ = Describe("ChangesProvider", func() {
 	Context("NewChangesProvider", func() {
 		It("returns changes provider", func() {
-			changesProvider := pkg.NewChangesProvider(nil, nil, nil, nil)
+			changesProvider := pkg.NewChangesProvider(nil, nil, nil, nil, nil, nil, nil, 1)
 			Expect(changesProvider).NotTo(BeNil())
 		})
 	})
+
+	Context("Changes", func() {
+		var ctx context.Context
+		var cancel context.CancelFunc
+		var saramaClient *mocks.SaramaClient
+		var converter pkg.Converter
+		var consumerPool *mocks.ConsumerPool
+		var messageCache pkg.MessageCache
+		var messages []*sarama.ConsumerMessage
+		var offset libkafka.Offset
+		var limit uint64
+		var filter []byte
+		var conversionWorkers int
+		var fetchConfig pkg.FetchConfig
+		var result *pkg.ChangesResult
+		var err error
+
+		BeforeEach(func() {
+			ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+			messages = newBenchmarkMessages(10)
+			saramaClient = &mocks.SaramaClient{}
+			saramaClient.GetOffsetReturns(int64(len(messages)), nil)
+			converter = pkg.NewConverter(100, 1024)
+			consumerPool = newBenchmarkConsumerPool(messages)
+			messageCache = pkg.NewMessageCache(&mocks.MessageCacheMetrics{}, 1024*1024)
+			offset = 0
+			limit = 100
+			filter = nil
+			conversionWorkers = 1
+			fetchConfig = pkg.DefaultFetchConfig()
+		})
+
+		AfterEach(func() {
+			cancel()
+		})
+
+		JustBeforeEach(func() {
+			result, err = newBenchmarkChangesProvider(
+				saramaClient,
+				converter,
+				consumerPool,
+				messageCache,
+				conversionWorkers,
+			).Changes(ctx, "orders", 0, offset, limit, filter, fetchConfig)
+		})
+
+		It("returns all records up to the high watermark", func() {
+			Expect(err).To(BeNil())
+			Expect(result.Records).To(HaveLen(10))
+			Expect(result.Records[9].Offset).To(Equal(libkafka.Offset(9)))
+			Expect(result.Status).To(Equal(pkg.ReadStatusHighWaterMarkReached))
+			Expect(result.HighWaterMark).To(Equal(libkafka.Offset(10)))
+			Expect(result.Scanned).To(Equal(uint64(10)))
+			Expect(result.Matched).To(Equal(uint64(10)))
+		})
+
+		Context("with fetch config", func() {
+			BeforeEach(func() {
+				fetchConfig.MinBytes = 1024 * 1024
+				fetchConfig.DefaultBytes = 8 * 1024 * 1024
+			})
+			It("acquires a consumer with the fetch config", func() {
+				Expect(err).To(BeNil())
+				Expect(consumerPool.AcquireCallCount()).To(Equal(1))
+				_, _, _, _, acquiredFetchConfig := consumerPool.AcquireArgsForCall(0)
+				Expect(acquiredFetchConfig).To(Equal(fetchConfig))
+			})
+		})
+
+		It("releases the consumer", func() {
+			Expect(consumerPool.AcquireCallCount()).To(Equal(1))
+		})
+
+		Context("with limit", func() {
+			BeforeEach(func() {
+				limit = 3
+			})
+			It("stops at the limit", func() {
+				Expect(err).To(BeNil())
+				Expect(result.Records).To(HaveLen(3))
+				Expect(result.Records[2].Offset).To(Equal(libkafka.Offset(2)))
+				Expect(result.Status).To(Equal(pkg.ReadStatusLimitReached))
+			})
+		})
+
+		Context("with filter", func() {
+			BeforeEach(func() {
+				filter = []byte(`"id":7`)
+			})
+			It("returns matching records", func() {
+				Expect(err).To(BeNil())
+				Expect(result.Records).To(HaveLen(1))
+				Expect(result.Records[0].Offset).To(Equal(libkafka.Offset(7)))
+				Expect(result.Scanned).To(Equal(uint64(10)))
+				Expect(result.Matched).To(Equal(uint64(1)))
+			})
+
+			Context("with compressed values", func() {
+				BeforeEach(func() {
+					for _, msg := range messages {
+						// the padding lets gzip compress instead of storing the value
+						padded := append(msg.Value, bytes.Repeat([]byte(" "), 100)...)
+						msg.Value = gzipValue(padded)
+						Expect(bytes.Contains(msg.Value, filter)).To(BeFalse())
+					}
+				})
+				It("matches the decompressed values", func() {
+					Expect(err).To(BeNil())
+					Expect(result.Records).To(HaveLen(1))
+					Expect(result.Records[0].Offset).To(Equal(libkafka.Offset(7)))
+					Expect(result.Records[0].ContentEncoding).To(Equal(pkg.ContentEncodingGzip))
+					Expect(result.Matched).To(Equal(uint64(1)))
+				})
+
+				Context("with decompression disabled", func() {
+					BeforeEach(func() {
+						converter = pkg.NewConverter(100, 0)
+						filter = messages[7].Value[len(messages[7].Value)-8:]
+					})
+					It("matches the raw bytes", func() {
+						Expect(err).To(BeNil())
+						Expect(result.Records).To(HaveLen(1))
+						Expect(result.Records[0].Offset).To(Equal(libkafka.Offset(7)))
+						Expect(result.Records[0].ContentEncoding).To(BeEmpty())
+					})
+				})
+
+				Context("with values larger than max decompressed bytes", func() {
+					BeforeEach(func() {
+						converter = pkg.NewConverter(100, 16)
+					})
+					It("checks the raw bytes", func() {
+						Expect(err).To(BeNil())
+						Expect(result.Records).To(BeEmpty())
+						Expect(result.Scanned).To(Equal(uint64(10)))
+					})
+
+					Context("with filter of raw bytes", func() {
+						BeforeEach(func() {
+							filter = messages[7].Value[len(messages[7].Value)-8:]
+						})
+						It("returns the failed record", func() {
+							Expect(err).To(BeNil())
+							Expect(result.Records).To(HaveLen(1))
+							Expect(result.Records[0].Offset).To(Equal(libkafka.Offset(7)))
+							Expect(result.Records[0].Value).To(HaveKeyWithValue(
+								"error",
+								ContainSubstring("decompressed value too large"),
+							))
+						})
+					})
+				})
+			})
+		})
+
+		Context("with offset at high watermark", func() {
+			BeforeEach(func() {
+				offset = 10
+			})
+			It("returns complete without consuming", func() {
+				Expect(err).To(BeNil())
+				Expect(result.Records).To(BeEmpty())
+				Expect(result.Status).To(Equal(pkg.ReadStatusComplete))
+				Expect(consumerPool.AcquireCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("with negative offset", func() {
+			BeforeEach(func() {
+				offset = -2
+			})
+			It("reads from the end", func() {
+				Expect(err).To(BeNil())
+				Expect(result.Records).To(HaveLen(2))
+				Expect(result.Records[0].Offset).To(Equal(libkafka.Offset(8)))
+			})
+		})
+
+		Context("with timeout", func() {
+			BeforeEach(func() {
+				// the high watermark is never reached
+				saramaClient.GetOffsetReturns(20, nil)
+				cancel()
+				ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+			})
+			It("returns the records read so far", func() {
+				Expect(err).To(BeNil())
+				Expect(result.Records).To(HaveLen(10))
+				Expect(result.Status).To(Equal(pkg.ReadStatusTimeout))
+			})
+		})
+
+		Context("with conversion workers", func() {
+			BeforeEach(func() {
+				messages = newBenchmarkMessages(100)
+				saramaClient.GetOffsetReturns(int64(len(messages)), nil)
+				consumerPool = newBenchmarkConsumerPool(messages)
+				conversionWorkers = 4
+			})
+			It("returns all records in offset order", func() {
+				Expect(err).To(BeNil())
+				Expect(result.Records).To(HaveLen(100))
+				for i, record := range result.Records {
+					Expect(record.Offset).To(Equal(libkafka.Offset(i)))
+				}
+				Expect(result.Status).To(Equal(pkg.ReadStatusHighWaterMarkReached))
+			})
+
+			Context("with limit", func() {
+				BeforeEach(func() {
+					limit = 3
+				})
+				It("stops at the limit", func() {
+					Expect(err).To(BeNil())
+					Expect(result.Records).To(HaveLen(3))
+					Expect(result.Records[2].Offset).To(Equal(libkafka.Offset(2)))
+					Expect(result.Status).To(Equal(pkg.ReadStatusLimitReached))
+					Expect(result.Scanned).To(Equal(uint64(3)))
+				})
+			})
+
+			Context("with filter", func() {
+				BeforeEach(func() {
+					filter = []byte(`"id":42,`)
+				})
+				It("returns matching records", func() {
+					Expect(err).To(BeNil())
+					Expect(result.Records).To(HaveLen(1))
+					Expect(result.Records[0].Offset).To(Equal(libkafka.Offset(42)))
+					Expect(result.Scanned).To(Equal(uint64(100)))
+					Expect(result.NextOffset).NotTo(BeNil())
+					Expect(*result.NextOffset).To(Equal(libkafka.Offset(100)))
+				})
+			})
+
+			Context("with timeout", func() {
+				BeforeEach(func() {
+					limit = 1000
+					saramaClient.GetOffsetReturns(200, nil)
+					cancel()
+					ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+				})
+				It("returns the records converted so far", func() {
+					Expect(err).To(BeNil())
+					Expect(result.Records).To(HaveLen(100))
+					Expect(result.Status).To(Equal(pkg.ReadStatusTimeout))
+				})
+			})
+		})
+
+		Context("with cached messages", func() {
+			BeforeEach(func() {
+				writer := messageCache.Writer("orders", 0, 0)
+				for _, msg := range messages[:5] {
+					writer.Add(msg)
+				}
+				writer.Close()
+			})
+			It("continues in Kafka after the cached messages", func() {
+				Expect(err).To(BeNil())
+				Expect(result.Records).To(HaveLen(10))
+				_, _, _, acquiredOffset, _ := consumerPool.AcquireArgsForCall(0)
+				Expect(acquiredOffset).To(Equal(libkafka.Offset(5)))
+			})
+		})
+
+		Context("with transaction markers before the high watermark", func() {
+			BeforeEach(func() {
+				// the commit markers at offset 10 and 11 are skipped by the consumer
+				saramaClient.GetOffsetReturns(12, nil)
+				fetchConfig.MaxWait = time.Millisecond
+				cancel()
+				ctx, cancel = context.WithTimeout(context.Background(), 1500*time.Millisecond)
+				response := newFetchResponse()
+				response.SetLastStableOffset("orders", 0, 12)
+				response.AddControlRecord("orders", 0, 10, 1, sarama.ControlRecordCommit)
+				response.AddControlRecord("orders", 0, 11, 2, sarama.ControlRecordCommit)
+				newTransactionalLeader(saramaClient, 12, response)
+			})
+			It("ends without waiting for the timeout", func() {
+				Expect(err).To(BeNil())
+				Expect(result.Records).To(HaveLen(10))
+				Expect(result.Status).To(Equal(pkg.ReadStatusHighWaterMarkReached))
+			})
+
+			Context("with read committed", func() {
+				BeforeEach(func() {
+					fetchConfig.Isolation = pkg.IsolationReadCommitted
+				})
+				It("ends without waiting for the timeout", func() {
+					Expect(err).To(BeNil())
+					Expect(result.Records).To(HaveLen(10))
+					Expect(result.Status).To(Equal(pkg.ReadStatusHighWaterMarkReached))
+				})
+			})
+
+			Context("with read committed and the fetch of a record pending", func() {
+				BeforeEach(func() {
+					fetchConfig.Isolation = pkg.IsolationReadCommitted
+					// the leader has a record at offset 11 the consumer did not get yet
+					response := newFetchResponse()
+					response.SetLastStableOffset("orders", 0, 12)
+					response.AddControlRecord("orders", 0, 10, 1, sarama.ControlRecordCommit)
+					response.AddRecordBatch(
+						"orders",
+						0,
+						nil,
+						sarama.StringEncoder("v"),
+						11,
+						2,
+						false,
+					)
+					newTransactionalLeader(saramaClient, 12, response)
+				})
+				It("waits for the timeout", func() {
+					Expect(err).To(BeNil())
+					Expect(result.Records).To(HaveLen(10))
+					Expect(result.Status).To(Equal(pkg.ReadStatusTimeout))
+				})
+			})
+		})
+
+		Context("with message after the high watermark", func() {
+			BeforeEach(func() {
+				// offset 9 is a commit marker, offset 10 written after the read started
+				messages[9].Offset = 10
+			})
+			It("does not return it", func() {
+				Expect(err).To(BeNil())
+				Expect(result.Records).To(HaveLen(9))
+				Expect(result.Records[8].Offset).To(Equal(libkafka.Offset(8)))
+				Expect(result.Status).To(Equal(pkg.ReadStatusHighWaterMarkReached))
+			})
+		})
+
+		Context("with read committed", func() {
+			BeforeEach(func() {
+				fetchConfig.Isolation = pkg.IsolationReadCommitted
+				newLastStableOffsetBroker(saramaClient, "orders", 0, 6)
+				writer := messageCache.Writer("orders", 0, 0)
+				for _, msg := range messages {
+					writer.Add(msg)
+				}
+				writer.Close()
+			})
+			It("stops at the last stable offset", func() {
+				Expect(err).To(BeNil())
+				Expect(result.Records).To(HaveLen(6))
+				Expect(result.Records[5].Offset).To(Equal(libkafka.Offset(5)))
+				Expect(result.Status).To(Equal(pkg.ReadStatusLastStableOffsetReached))
+				Expect(result.HighWaterMark).To(Equal(libkafka.Offset(10)))
+				Expect(*result.LastStableOffset).To(Equal(libkafka.Offset(6)))
+			})
+			It("reads from Kafka instead of the cache", func() {
+				Expect(consumerPool.AcquireCallCount()).To(Equal(1))
+				_, _, _, acquiredOffset, acquiredFetchConfig := consumerPool.AcquireArgsForCall(0)
+				Expect(acquiredOffset).To(Equal(libkafka.Offset(0)))
+				Expect(acquiredFetchConfig.Isolation).To(Equal(pkg.IsolationReadCommitted))
+			})
+
+			Context("with negative offset", func() {
+				BeforeEach(func() {
+					offset = -2
+				})
+				It("reads from the last stable offset", func() {
+					Expect(err).To(BeNil())
+					Expect(result.Records).To(HaveLen(2))
+					Expect(result.Records[0].Offset).To(Equal(libkafka.Offset(4)))
+				})
+			})
+		})
+
+		Context("with read committed without leader", func() {
+			BeforeEach(func() {
+				fetchConfig.Isolation = pkg.IsolationReadCommitted
+				saramaClient.LeaderReturns(nil, fmt.Errorf("no leader"))
+			})
+			It("returns error", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(consumerPool.AcquireCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
+
+func newBenchmarkMessages(count int) []*sarama.ConsumerMessage {
+	result := make([]*sarama.ConsumerMessage, count)
+	for i := range result {
+		result[i] = &sarama.ConsumerMessage{
+			Topic:     "orders",
+			Partition: 0,
+			Offset:    int64(i),
+			Key:       []byte(fmt.Sprintf("order-%d", i)),
+			Value: []byte(fmt.Sprintf(
+				`{"id":%d,"customer":"customer-%d","status":"shipped","amount":%d.99}`,
+				i,
+				i%100,
+				i%1000,
+			)),
+			Timestamp: time.Unix(1700000000, 0),
+		}
+	}
+	return result
+}
+
+// newBenchmarkConsumerPool returns consumers reading the given messages, blocking
+// like Kafka after the last one.
+func newBenchmarkConsumerPool(messages []*sarama.ConsumerMessage) *mocks.ConsumerPool {
+	consumerPool := &mocks.ConsumerPool{}
+	consumerPool.AcquireStub = func(
+		ctx context.Context,
+		topic libkafka.Topic,
+		partition libkafka.Partition,
+		offset libkafka.Offset,
+		fetchConfig pkg.FetchConfig,
+	) (pkg.PooledConsumer, error) {
+		return &benchmarkConsumer{
+			messages: messages,
+			index:    int(offset),
+		}, nil
+	}
+	return consumerPool
+}
+
+type benchmarkConsumer struct {
+	messages []*sarama.ConsumerMessage
+	index    int
+}
+
+func (b *benchmarkConsumer) Next(ctx context.Context) (*sarama.ConsumerMessage, error) {
+	if b.index >= len(b.messages) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	msg := b.messages[b.index]
+	b.index++
+	return msg, nil
+}
+
+func (b *benchmarkConsumer) Release() {}
+
+func newBenchmarkChangesProvider(
+	saramaClient libkafka.SaramaClient,
+	converter pkg.Converter,
+	consumerPool pkg.ConsumerPool,
+	messageCache pkg.MessageCache,
+	conversionWorkers int,
+) pkg.ChangesProvider {
+	redactor, err := pkg.NewRedactor(context.Background(), nil, nil)
+	if err != nil {
+		panic(err)
+	}
+	return pkg.NewChangesProvider(
+		nil,
+		saramaClient,
+		converter,
+		redactor,
+		pkg.NewMetricsFactory(prometheus.NewRegistry(), "benchmark")("default", saramaClient),
+		consumerPool,
+		messageCache,
+		conversionWorkers,
+	)
+}
+
+// newLargeBenchmarkMessages returns messages with about 256 KiB of nested JSON each.
+func newLargeBenchmarkMessages(count int) []*sarama.ConsumerMessage {
+	items := make([]string, 2000)
+	for i := range items {
+		items[i] = fmt.Sprintf(
+			`{"sku":"sku-%d","name":"item %d","price":%d.5,"tags":["a","b","c"],"dimensions":{"width":%d,"height":%d,"depth":%d}}`,
+			i,
+			i,
+			i,
+			i%10,
+			i%20,
+			i%30,
+		)
+	}
+	result := newBenchmarkMessages(count)
+	for i, msg := range result {
+		msg.Value = []byte(fmt.Sprintf(`{"id":%d,"items":[%s]}`, i, strings.Join(items, ",")))
+	}
+	return result
+}
+
+func benchmarkChanges(b *testing.B, count int, limit uint64, filter []byte) {
+	benchmarkChangesMessages(b, newBenchmarkMessages(count), limit, filter, 1)
+}
+
+func benchmarkChangesMessages(
+	b *testing.B,
+	messages []*sarama.ConsumerMessage,
+	limit uint64,
+	filter []byte,
+	conversionWorkers int,
+) {
+	saramaClient := &mocks.SaramaClient{}
+	saramaClient.GetOffsetReturns(int64(len(messages)), nil)
+	changesProvider := newBenchmarkChangesProvider(
+		saramaClient,
+		pkg.NewConverter(100, 1024),
+		newBenchmarkConsumerPool(messages),
+		pkg.NewMessageCache(&mocks.MessageCacheMetrics{}, 0),
+		conversionWorkers,
+	)
+	ctx := context.Background()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := changesProvider.Changes(ctx, "orders", 0, 0, limit, filter, pkg.DefaultFetchConfig()); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkChangesLimit reads a page of 100 records.
+func BenchmarkChangesLimit(b *testing.B) {
+	benchmarkChanges(b, 1000, 100, nil)
+}
+
+// BenchmarkChangesHighWaterMark reads all 1000 records of a partition.
+func BenchmarkChangesHighWaterMark(b *testing.B) {
+	benchmarkChanges(b, 1000, 1000, nil)
+}
+
+// BenchmarkChangesFilter scans 1000 messages for a single match.
+func BenchmarkChangesFilter(b *testing.B) {
+	benchmarkChanges(b, 1000, 100, []byte(`"id":500,`))
+}
+
+// BenchmarkChangesLargePayload converts 20 messages of 256 KiB with a growing number
+// of conversion workers, the gain depends on the available CPUs.
+func BenchmarkChangesLargePayload(b *testing.B) {
+	messages := newLargeBenchmarkMessages(20)
+	for _, conversionWorkers := range []int{1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("workers=%d", conversionWorkers), func(b *testing.B) {
+			benchmarkChangesMessages(b, messages, 20, nil, conversionWorkers)
+		})
+	}
+}
+
+// newTransactionalLeader returns a leader of partition 0 of orders answering offset
+// requests with lastStableOffset and fetch requests with response.
+func newTransactionalLeader(
+	saramaClient *mocks.SaramaClient,
+	lastStableOffset int64,
+	response *sarama.FetchResponse,
+) *sarama.MockBroker {
+	return newMockLeader(saramaClient, map[string]sarama.MockResponse{
+		"OffsetRequest": sarama.NewMockOffsetResponse(GinkgoT()).
+			SetOffset("orders", 0, sarama.OffsetNewest, lastStableOffset),
+		"FetchRequest": sarama.NewMockWrapper(response),
+	})
+}
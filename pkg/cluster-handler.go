@@ -0,0 +1,84 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/bborbe/errors"
+	libhttp "github.com/bborbe/http"
+	"github.com/gorilla/mux"
+)
+
+const clustersPathPrefix = "/clusters/"
+
+// NewClusterHandler dispatches requests to the handler of the selected cluster.
+// The cluster is taken from a /clusters/{name}/ path prefix, which is stripped,
+// from the cluster parameter or falls back to the default cluster.
+func NewClusterHandler(
+	defaultCluster ClusterName,
+	handlers map[ClusterName]http.Handler,
+) http.Handler {
+	return http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+		name := defaultCluster
+		if value := req.FormValue("cluster"); value != "" {
+			name = ClusterName(value)
+		}
+		if strings.HasPrefix(req.URL.Path, clustersPathPrefix) {
+			rest := strings.TrimPrefix(req.URL.Path, clustersPathPrefix)
+			pos := strings.Index(rest, "/")
+			if pos < 0 {
+				http.NotFound(resp, req)
+				return
+			}
+			name = ClusterName(rest[:pos])
+			req = req.Clone(req.Context())
+			req.URL.Path = rest[pos:]
+			req.URL.RawPath = ""
+		}
+		handler, ok := handlers[name]
+		if !ok {
+			http.Error(resp, "cluster "+name.String()+" not found", http.StatusNotFound)
+			return
+		}
+		handler.ServeHTTP(resp, req)
+	})
+}
+
+// NewClustersHandler lists all clusters with their health.
+func NewClustersHandler(
+	clusters Clusters,
+) libhttp.WithError {
+	return libhttp.WithErrorFunc(
+		func(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
+			if err := libhttp.SendJSONResponse(ctx, resp, clusters.Health(), http.StatusOK); err != nil {
+				return errors.Wrap(ctx, err, "send json failed")
+			}
+			return nil
+		},
+	)
+}
+
+// NewClusterHealthHandler returns OK if the cluster is healthy and 503 otherwise.
+func NewClusterHealthHandler(
+	clusters Clusters,
+) http.Handler {
+	return http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+		name := ClusterName(mux.Vars(req)["cluster"])
+		cluster, ok := clusters.Find(name)
+		if !ok {
+			http.Error(resp, "cluster "+name.String()+" not found", http.StatusNotFound)
+			return
+		}
+		health := cluster.Health()
+		if !health.Healthy {
+			http.Error(resp, health.Error, http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = resp.Write([]byte("OK"))
+	})
+}
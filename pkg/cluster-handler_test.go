@@ -0,0 +1,120 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/sarama"
+	"github.com/bborbe/errors"
+	"github.com/gorilla/mux"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/mocks"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("ClusterHandler", func() {
+	var handler http.Handler
+	var response *httptest.ResponseRecorder
+
+	clusterHandler := func(name string) http.Handler {
+		return http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+			_, _ = resp.Write([]byte(name + " " + req.URL.Path))
+		})
+	}
+
+	BeforeEach(func() {
+		handler = pkg.NewClusterHandler("dev", map[pkg.ClusterName]http.Handler{
+			"dev":  clusterHandler("dev"),
+			"prod": clusterHandler("prod"),
+		})
+		response = httptest.NewRecorder()
+	})
+
+	serve := func(target string) {
+		handler.ServeHTTP(response, httptest.NewRequest(http.MethodGet, target, nil))
+	}
+
+	It("uses default cluster", func() {
+		serve("/read?topic=orders")
+		Expect(response.Code).To(Equal(http.StatusOK))
+		Expect(response.Body.String()).To(Equal("dev /read"))
+	})
+
+	It("uses cluster parameter", func() {
+		serve("/read?topic=orders&cluster=prod")
+		Expect(response.Body.String()).To(Equal("prod /read"))
+	})
+
+	It("uses and strips cluster path", func() {
+		serve("/clusters/prod/topics/orders/partitions")
+		Expect(response.Body.String()).To(Equal("prod /topics/orders/partitions"))
+	})
+
+	It("prefers cluster path over parameter", func() {
+		serve("/clusters/prod/read?cluster=dev")
+		Expect(response.Body.String()).To(Equal("prod /read"))
+	})
+
+	It("returns not found for unknown cluster", func() {
+		serve("/read?cluster=unknown")
+		Expect(response.Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("returns not found for cluster path without route", func() {
+		serve("/clusters/prod")
+		Expect(response.Code).To(Equal(http.StatusNotFound))
+	})
+})
+
+var _ = Describe("ClustersHandler", func() {
+	var ctx context.Context
+	var clusters pkg.Clusters
+	var response *httptest.ResponseRecorder
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		saramaClient := &mocks.SaramaClient{}
+		saramaClient.ControllerReturns(&sarama.Broker{}, nil)
+		clusters = pkg.Clusters{
+			{Name: "dev", SaramaClient: saramaClient},
+			{Name: "prod", Err: errors.New(ctx, "connect failed")},
+		}
+		response = httptest.NewRecorder()
+	})
+
+	It("lists clusters with health", func() {
+		err := pkg.NewClustersHandler(clusters).
+			ServeHTTP(ctx, response, httptest.NewRequest(http.MethodGet, "/clusters", nil))
+		Expect(err).To(BeNil())
+		var result pkg.ClusterHealths
+		Expect(json.Unmarshal(response.Body.Bytes(), &result)).To(Succeed())
+		Expect(result).To(HaveLen(2))
+		Expect(result[0]).To(Equal(pkg.ClusterHealth{Name: "dev", Healthy: true}))
+		Expect(result[1].Name).To(Equal(pkg.ClusterName("prod")))
+		Expect(result[1].Healthy).To(BeFalse())
+	})
+
+	DescribeTable("cluster health",
+		func(name string, expectedCode int) {
+			router := mux.NewRouter()
+			router.Path("/clusters/{cluster}/healthz").
+				Handler(pkg.NewClusterHealthHandler(clusters))
+			router.ServeHTTP(
+				response,
+				httptest.NewRequest(http.MethodGet, "/clusters/"+name+"/healthz", nil),
+			)
+			Expect(response.Code).To(Equal(expectedCode))
+		},
+		Entry("healthy", "dev", http.StatusOK),
+		Entry("unhealthy", "prod", http.StatusServiceUnavailable),
+		Entry("unknown", "unknown", http.StatusNotFound),
+	)
+})
@@ -0,0 +1,167 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+
+	"github.com/IBM/sarama"
+	"github.com/bborbe/errors"
+	libkafka "github.com/bborbe/kafka"
+	"github.com/golang/glog"
+)
+
+type ClusterName string
+
+func (c ClusterName) String() string {
+	return string(c)
+}
+
+type ClusterConfigs []ClusterConfig
+
+// ClusterConfig describes how to connect to one named Kafka cluster.
+type ClusterConfig struct {
+	Name        ClusterName      `json:"name"`
+	Brokers     string           `json:"brokers"`
+	Auth        KafkaAuth        `json:"auth"`
+	IndexTopics []libkafka.Topic `json:"indexTopics,omitempty"`
+}
+
+// ParseClusterConfigsFile reads a JSON array of cluster configs.
+func ParseClusterConfigsFile(ctx context.Context, path string) (ClusterConfigs, error) {
+	content, err := os.ReadFile(path) // #nosec G304 -- path is configured by the operator
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "read clusters file %s failed", path)
+	}
+	var result ClusterConfigs
+	if err := json.Unmarshal(content, &result); err != nil {
+		return nil, errors.Wrapf(ctx, err, "parse clusters file %s failed", path)
+	}
+	return result, nil
+}
+
+// Validate ensures at least one cluster exists and all have a unique name and brokers.
+func (c ClusterConfigs) Validate(ctx context.Context) error {
+	if len(c) == 0 {
+		return errors.New(ctx, "no kafka cluster configured")
+	}
+	names := map[ClusterName]bool{}
+	for _, config := range c {
+		if config.Name == "" {
+			return errors.New(ctx, "cluster name missing")
+		}
+		if config.Brokers == "" {
+			return errors.Errorf(ctx, "brokers of cluster %s missing", config.Name)
+		}
+		if names[config.Name] {
+			return errors.Errorf(ctx, "cluster %s configured twice", config.Name)
+		}
+		names[config.Name] = true
+	}
+	return nil
+}
+
+// Find returns the config of the cluster with the given name.
+func (c ClusterConfigs) Find(name ClusterName) (*ClusterConfig, bool) {
+	for i := range c {
+		if c[i].Name == name {
+			return &c[i], true
+		}
+	}
+	return nil, false
+}
+
+type Clusters []Cluster
+
+// Cluster is a connected Kafka cluster. Err is set if the connection could not be
+// created, the cluster is then reported as unhealthy and all its requests fail.
+type Cluster struct {
+	Name         ClusterName
+	SaramaClient libkafka.SaramaClient
+	ClusterAdmin sarama.ClusterAdmin
+	ConsumerPool ConsumerPool
+	MessageCache MessageCache
+	OffsetIndex  OffsetIndex
+	Indexer      Indexer
+	Err          error
+}
+
+type ClusterHealths []ClusterHealth
+
+type ClusterHealth struct {
+	Name    ClusterName `json:"name"`
+	Healthy bool        `json:"healthy"`
+	Error   string      `json:"error,omitempty"`
+}
+
+// Health checks the connection by looking up the controller of the cluster.
+func (c Cluster) Health() ClusterHealth {
+	result := ClusterHealth{
+		Name: c.Name,
+	}
+	if c.Err != nil {
+		result.Error = c.Err.Error()
+		return result
+	}
+	if c.SaramaClient.Closed() {
+		result.Error = "client closed"
+		return result
+	}
+	if _, err := c.SaramaClient.Controller(); err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.Healthy = true
+	return result
+}
+
+// Health returns the health of all clusters.
+func (c Clusters) Health() ClusterHealths {
+	result := make(ClusterHealths, 0, len(c))
+	for _, cluster := range c {
+		result = append(result, cluster.Health())
+	}
+	return result
+}
+
+// Connected returns true if at least one cluster is connected.
+func (c Clusters) Connected() bool {
+	for _, cluster := range c {
+		if cluster.Err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// Find returns the cluster with the given name.
+func (c Clusters) Find(name ClusterName) (*Cluster, bool) {
+	for i := range c {
+		if c[i].Name == name {
+			return &c[i], true
+		}
+	}
+	return nil, false
+}
+
+// Close closes the consumer pools, offset indexes and clients of all connected
+// clusters.
+func (c Clusters) Close() {
+	for _, cluster := range c {
+		if cluster.ConsumerPool != nil {
+			cluster.ConsumerPool.Close()
+		}
+		if cluster.OffsetIndex != nil {
+			if err := cluster.OffsetIndex.Close(); err != nil {
+				glog.V(2).Infof("close offset index of cluster %s failed: %v", cluster.Name, err)
+			}
+		}
+		if cluster.SaramaClient != nil {
+			_ = cluster.SaramaClient.Close()
+		}
+	}
+}
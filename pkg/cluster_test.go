@@ -0,0 +1,156 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/IBM/sarama"
+	"github.com/bborbe/errors"
+	libkafka "github.com/bborbe/kafka"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/mocks"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("ClusterConfigs", func() {
+	var ctx context.Context
+	var dir string
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		dir = GinkgoT().TempDir()
+	})
+
+	writeFile := func(content string) string {
+		path := filepath.Join(dir, "clusters.json")
+		Expect(os.WriteFile(path, []byte(content), 0600)).To(Succeed())
+		return path
+	}
+
+	It("parses clusters file", func() {
+		path := writeFile(`[
+			{"name": "dev", "brokers": "kafka-dev:9092", "indexTopics": ["orders"]},
+			{"name": "prod", "brokers": "kafka-1:9093,kafka-2:9093", "auth": {"tlsCaFile": "/secrets/ca.pem", "saslMechanism": "SCRAM-SHA-512"}}
+		]`)
+		configs, err := pkg.ParseClusterConfigsFile(ctx, path)
+		Expect(err).To(BeNil())
+		Expect(configs).To(Equal(pkg.ClusterConfigs{
+			{Name: "dev", Brokers: "kafka-dev:9092", IndexTopics: []libkafka.Topic{"orders"}},
+			{
+				Name:    "prod",
+				Brokers: "kafka-1:9093,kafka-2:9093",
+				Auth: pkg.KafkaAuth{
+					TLSCAFile:     "/secrets/ca.pem",
+					SASLMechanism: "SCRAM-SHA-512",
+				},
+			},
+		}))
+	})
+
+	It("returns error for invalid json", func() {
+		_, err := pkg.ParseClusterConfigsFile(ctx, writeFile(`{`))
+		Expect(err).NotTo(BeNil())
+	})
+
+	It("returns error for missing file", func() {
+		_, err := pkg.ParseClusterConfigsFile(ctx, filepath.Join(dir, "missing.json"))
+		Expect(err).NotTo(BeNil())
+	})
+
+	DescribeTable(
+		"Validate",
+		func(configs pkg.ClusterConfigs, expectError bool) {
+			err := configs.Validate(ctx)
+			if expectError {
+				Expect(err).NotTo(BeNil())
+			} else {
+				Expect(err).To(BeNil())
+			}
+		},
+		Entry(
+			"valid",
+			pkg.ClusterConfigs{{Name: "a", Brokers: "a:9092"}, {Name: "b", Brokers: "b:9092"}},
+			false,
+		),
+		Entry("empty", pkg.ClusterConfigs{}, true),
+		Entry("missing name", pkg.ClusterConfigs{{Brokers: "a:9092"}}, true),
+		Entry("missing brokers", pkg.ClusterConfigs{{Name: "a"}}, true),
+		Entry(
+			"duplicate name",
+			pkg.ClusterConfigs{{Name: "a", Brokers: "a:9092"}, {Name: "a", Brokers: "b:9092"}},
+			true,
+		),
+	)
+
+	It("finds config by name", func() {
+		configs := pkg.ClusterConfigs{
+			{Name: "a", Brokers: "a:9092"},
+			{Name: "b", Brokers: "b:9092"},
+		}
+		config, ok := configs.Find("b")
+		Expect(ok).To(BeTrue())
+		Expect(config.Brokers).To(Equal("b:9092"))
+		_, ok = configs.Find("c")
+		Expect(ok).To(BeFalse())
+	})
+})
+
+var _ = Describe("Clusters", func() {
+	var ctx context.Context
+	var healthyClient *mocks.SaramaClient
+	var brokenClient *mocks.SaramaClient
+	var clusters pkg.Clusters
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		healthyClient = &mocks.SaramaClient{}
+		healthyClient.ControllerReturns(&sarama.Broker{}, nil)
+		brokenClient = &mocks.SaramaClient{}
+		brokenClient.ControllerReturns(nil, errors.New(ctx, "no controller"))
+		clusters = pkg.Clusters{
+			{Name: "dev", SaramaClient: healthyClient},
+			{Name: "prod", SaramaClient: brokenClient},
+			{Name: "test", Err: errors.New(ctx, "connect failed")},
+		}
+	})
+
+	It("reports health per cluster", func() {
+		healths := clusters.Health()
+		Expect(healths).To(HaveLen(3))
+		Expect(healths[0]).To(Equal(pkg.ClusterHealth{Name: "dev", Healthy: true}))
+		Expect(healths[1].Healthy).To(BeFalse())
+		Expect(healths[1].Error).To(ContainSubstring("no controller"))
+		Expect(healths[2].Healthy).To(BeFalse())
+		Expect(healths[2].Error).To(ContainSubstring("connect failed"))
+	})
+
+	It("reports closed client as unhealthy", func() {
+		healthyClient.ClosedReturns(true)
+		Expect(clusters[0].Health().Healthy).To(BeFalse())
+	})
+
+	It("is connected if one cluster is connected", func() {
+		Expect(clusters.Connected()).To(BeTrue())
+		Expect(clusters[2:].Connected()).To(BeFalse())
+	})
+
+	It("closes all connected clients", func() {
+		clusters.Close()
+		Expect(healthyClient.CloseCallCount()).To(Equal(1))
+		Expect(brokenClient.CloseCallCount()).To(Equal(1))
+	})
+
+	It("closes the offset index", func() {
+		offsetIndex := &mocks.OffsetIndex{}
+		clusters[0].OffsetIndex = offsetIndex
+		clusters.Close()
+		Expect(offsetIndex.CloseCallCount()).To(Equal(1))
+	})
+})
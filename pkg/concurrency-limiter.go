@@ -0,0 +1,90 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+	stderrors "errors"
+
+	libkafka "github.com/bborbe/kafka"
+)
+
+// ErrTooManyConcurrentReads is returned if the maximum of concurrent reads is reached.
+var ErrTooManyConcurrentReads = stderrors.New("too many concurrent reads")
+
+//counterfeiter:generate -o ../mocks/concurrency-limiter.go --fake-name ConcurrencyLimiter . ConcurrencyLimiter
+type ConcurrencyLimiter interface {
+	// TryAcquire takes a slot without waiting and returns false if none is free.
+	TryAcquire() bool
+	Release()
+}
+
+// NewConcurrencyLimiter allows max slots at the same time, max <= 0 allows unlimited.
+func NewConcurrencyLimiter(max int) ConcurrencyLimiter {
+	if max <= 0 {
+		return unlimitedConcurrencyLimiter{}
+	}
+	return make(concurrencyLimiter, max)
+}
+
+type concurrencyLimiter chan struct{}
+
+func (c concurrencyLimiter) TryAcquire() bool {
+	select {
+	case c <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+func (c concurrencyLimiter) Release() {
+	<-c
+}
+
+type unlimitedConcurrencyLimiter struct{}
+
+func (unlimitedConcurrencyLimiter) TryAcquire() bool { return true }
+
+func (unlimitedConcurrencyLimiter) Release() {}
+
+// NewConcurrencyLimitedChangesProvider returns ErrTooManyConcurrentReads instead of
+// starting a read if all slots of the limiter are taken.
+func NewConcurrencyLimitedChangesProvider(
+	changesProvider ChangesProvider,
+	concurrencyLimiter ConcurrencyLimiter,
+	limitMetrics LimitMetrics,
+) ChangesProvider {
+	return &concurrencyLimitedChangesProvider{
+		changesProvider:    changesProvider,
+		concurrencyLimiter: concurrencyLimiter,
+		limitMetrics:       limitMetrics,
+	}
+}
+
+type concurrencyLimitedChangesProvider struct {
+	changesProvider    ChangesProvider
+	concurrencyLimiter ConcurrencyLimiter
+	limitMetrics       LimitMetrics
+}
+
+func (c *concurrencyLimitedChangesProvider) Changes(
+	ctx context.Context,
+	topic libkafka.Topic,
+	partition libkafka.Partition,
+	offset libkafka.Offset,
+	limit uint64,
+	filter []byte,
+	fetchConfig FetchConfig,
+) (*ChangesResult, error) {
+	if !c.concurrencyLimiter.TryAcquire() {
+		c.limitMetrics.Rejected(LimitReasonConcurrency)
+		return nil, ErrTooManyConcurrentReads
+	}
+	defer c.concurrencyLimiter.Release()
+	c.limitMetrics.ReadStarted()
+	defer c.limitMetrics.ReadFinished()
+	return c.changesProvider.Changes(ctx, topic, partition, offset, limit, filter, fetchConfig)
+}
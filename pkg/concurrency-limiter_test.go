@@ -0,0 +1,98 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/mocks"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("ConcurrencyLimiter", func() {
+	It("allows max slots", func() {
+		concurrencyLimiter := pkg.NewConcurrencyLimiter(2)
+		Expect(concurrencyLimiter.TryAcquire()).To(BeTrue())
+		Expect(concurrencyLimiter.TryAcquire()).To(BeTrue())
+		Expect(concurrencyLimiter.TryAcquire()).To(BeFalse())
+		concurrencyLimiter.Release()
+		Expect(concurrencyLimiter.TryAcquire()).To(BeTrue())
+	})
+
+	It("is unlimited without max", func() {
+		concurrencyLimiter := pkg.NewConcurrencyLimiter(0)
+		for i := 0; i < 100; i++ {
+			Expect(concurrencyLimiter.TryAcquire()).To(BeTrue())
+		}
+	})
+})
+
+var _ = Describe("ConcurrencyLimitedChangesProvider", func() {
+	var ctx context.Context
+	var changesProvider *mocks.ChangesProvider
+	var concurrencyLimiter *mocks.ConcurrencyLimiter
+	var limitMetrics *mocks.LimitMetrics
+	var result *pkg.ChangesResult
+	var err error
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		changesProvider = &mocks.ChangesProvider{}
+		changesProvider.ChangesReturns(&pkg.ChangesResult{Records: pkg.Records{{Key: "a"}}}, nil)
+		concurrencyLimiter = &mocks.ConcurrencyLimiter{}
+		limitMetrics = &mocks.LimitMetrics{}
+	})
+
+	JustBeforeEach(func() {
+		result, err = pkg.NewConcurrencyLimitedChangesProvider(
+			changesProvider,
+			concurrencyLimiter,
+			limitMetrics,
+		).Changes(ctx, "orders", 0, 0, 10, nil, pkg.DefaultFetchConfig())
+	})
+
+	Context("slot free", func() {
+		BeforeEach(func() {
+			concurrencyLimiter.TryAcquireReturns(true)
+		})
+
+		It("returns records", func() {
+			Expect(err).To(BeNil())
+			Expect(result.Records).To(HaveLen(1))
+		})
+
+		It("releases slot", func() {
+			Expect(concurrencyLimiter.ReleaseCallCount()).To(Equal(1))
+		})
+
+		It("tracks running reads", func() {
+			Expect(limitMetrics.ReadStartedCallCount()).To(Equal(1))
+			Expect(limitMetrics.ReadFinishedCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("no slot free", func() {
+		BeforeEach(func() {
+			concurrencyLimiter.TryAcquireReturns(false)
+		})
+
+		It("returns ErrTooManyConcurrentReads", func() {
+			Expect(err).To(MatchError(pkg.ErrTooManyConcurrentReads))
+		})
+
+		It("does not read", func() {
+			Expect(changesProvider.ChangesCallCount()).To(Equal(0))
+			Expect(concurrencyLimiter.ReleaseCallCount()).To(Equal(0))
+		})
+
+		It("counts rejection", func() {
+			Expect(limitMetrics.RejectedCallCount()).To(Equal(1))
+			Expect(limitMetrics.RejectedArgsForCall(0)).To(Equal(pkg.LimitReasonConcurrency))
+		})
+	})
+})
@@ -0,0 +1,60 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/bborbe/errors"
+	libhttp "github.com/bborbe/http"
+	"github.com/golang/glog"
+	"github.com/gorilla/mux"
+)
+
+func NewConsumerGroupsHandler(
+	consumerGroupsProvider ConsumerGroupsProvider,
+) libhttp.WithError {
+	return libhttp.WithErrorFunc(
+		func(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
+			groups, err := consumerGroupsProvider.ConsumerGroups(ctx)
+			if err != nil {
+				return errors.Wrap(ctx, err, "get consumer groups failed")
+			}
+
+			if err := libhttp.SendJSONResponse(ctx, resp, groups, http.StatusOK); err != nil {
+				return errors.Wrap(ctx, err, "send json failed")
+			}
+
+			glog.V(2).Infof("list %d consumer groups completed", len(groups))
+			return nil
+		},
+	)
+}
+
+func NewConsumerGroupHandler(
+	consumerGroupsProvider ConsumerGroupsProvider,
+) libhttp.WithError {
+	return libhttp.WithErrorFunc(
+		func(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
+			group := mux.Vars(req)["group"]
+			if group == "" {
+				return errors.New(ctx, "parameter group missing")
+			}
+
+			detail, err := consumerGroupsProvider.ConsumerGroup(ctx, group)
+			if err != nil {
+				return errors.Wrap(ctx, err, "get consumer group failed")
+			}
+
+			if err := libhttp.SendJSONResponse(ctx, resp, detail, http.StatusOK); err != nil {
+				return errors.Wrap(ctx, err, "send json failed")
+			}
+
+			glog.V(2).Infof("get consumer group %s completed", group)
+			return nil
+		},
+	)
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/bborbe/errors"
+	libhttp "github.com/bborbe/http"
+	"github.com/gorilla/mux"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/mocks"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("ConsumerGroupsHandler", func() {
+	var ctx context.Context
+	var consumerGroupsProvider *mocks.ConsumerGroupsProvider
+	var response *httptest.ResponseRecorder
+	var request *http.Request
+	var handler libhttp.WithError
+	var err error
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		consumerGroupsProvider = &mocks.ConsumerGroupsProvider{}
+		response = httptest.NewRecorder()
+	})
+
+	JustBeforeEach(func() {
+		err = handler.ServeHTTP(ctx, response, request)
+	})
+
+	Context("NewConsumerGroupsHandler", func() {
+		BeforeEach(func() {
+			handler = pkg.NewConsumerGroupsHandler(consumerGroupsProvider)
+			request = httptest.NewRequest(http.MethodGet, "/consumer-groups", nil)
+			consumerGroupsProvider.ConsumerGroupsReturns(pkg.ConsumerGroupInfos{
+				{Name: "billing", ProtocolType: "consumer", State: "Stable"},
+			}, nil)
+		})
+
+		It("returns groups", func() {
+			Expect(err).To(BeNil())
+			Expect(response.Body.String()).To(ContainSubstring(`"name":"billing"`))
+			Expect(response.Body.String()).To(ContainSubstring(`"state":"Stable"`))
+		})
+
+		Context("provider fails", func() {
+			BeforeEach(func() {
+				consumerGroupsProvider.ConsumerGroupsReturns(nil, errors.New(ctx, "banana"))
+			})
+
+			It("returns error", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("get consumer groups failed"))
+			})
+		})
+	})
+
+	Context("NewConsumerGroupHandler", func() {
+		BeforeEach(func() {
+			handler = pkg.NewConsumerGroupHandler(consumerGroupsProvider)
+			request = mux.SetURLVars(
+				httptest.NewRequest(http.MethodGet, "/consumer-groups/billing", nil),
+				map[string]string{"group": "billing"},
+			)
+			consumerGroupsProvider.ConsumerGroupReturns(&pkg.ConsumerGroupDetail{
+				Name:     "billing",
+				State:    "Stable",
+				TotalLag: 60,
+			}, nil)
+		})
+
+		It("returns group detail", func() {
+			Expect(err).To(BeNil())
+			Expect(consumerGroupsProvider.ConsumerGroupCallCount()).To(Equal(1))
+			_, group := consumerGroupsProvider.ConsumerGroupArgsForCall(0)
+			Expect(group).To(Equal("billing"))
+			Expect(response.Body.String()).To(ContainSubstring(`"totalLag":60`))
+		})
+
+		Context("missing group", func() {
+			BeforeEach(func() {
+				request = httptest.NewRequest(http.MethodGet, "/consumer-groups/", nil)
+			})
+
+			It("returns error", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("parameter group missing"))
+			})
+		})
+	})
+})
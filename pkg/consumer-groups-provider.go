@@ -0,0 +1,312 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+	"sort"
+
+	"github.com/IBM/sarama"
+	"github.com/bborbe/errors"
+	libkafka "github.com/bborbe/kafka"
+)
+
+type ConsumerGroupInfos []ConsumerGroupInfo
+
+type ConsumerGroupInfo struct {
+	Name         string `json:"name"`
+	ProtocolType string `json:"protocolType"`
+	State        string `json:"state"`
+	// Topics are the topics the group committed offsets for.
+	Topics []libkafka.Topic `json:"topics"`
+}
+
+type ConsumerGroupDetail struct {
+	Name         string               `json:"name"`
+	ProtocolType string               `json:"protocolType"`
+	Protocol     string               `json:"protocol"`
+	State        string               `json:"state"`
+	Members      ConsumerGroupMembers `json:"members"`
+	Offsets      ConsumerGroupOffsets `json:"offsets"`
+	TotalLag     int64                `json:"totalLag"`
+}
+
+type ConsumerGroupMembers []ConsumerGroupMember
+
+type ConsumerGroupMember struct {
+	MemberID    string                                  `json:"memberId"`
+	ClientID    string                                  `json:"clientId"`
+	ClientHost  string                                  `json:"clientHost"`
+	Assignments map[libkafka.Topic][]libkafka.Partition `json:"assignments"`
+}
+
+type ConsumerGroupOffsets []ConsumerGroupOffset
+
+type ConsumerGroupOffset struct {
+	Topic           libkafka.Topic     `json:"topic"`
+	Partition       libkafka.Partition `json:"partition"`
+	CommittedOffset libkafka.Offset    `json:"committedOffset"`
+	HighWaterMark   libkafka.Offset    `json:"highWaterMark"`
+	Lag             int64              `json:"lag"`
+}
+
+//counterfeiter:generate -o ../mocks/consumer-groups-provider.go --fake-name ConsumerGroupsProvider . ConsumerGroupsProvider
+type ConsumerGroupsProvider interface {
+	// ConsumerGroups returns all consumer groups sorted by name with the topics they
+	// committed offsets for.
+	ConsumerGroups(ctx context.Context) (ConsumerGroupInfos, error)
+	// ConsumerGroup returns state, members and committed offsets with lag of the group.
+	ConsumerGroup(ctx context.Context, group string) (*ConsumerGroupDetail, error)
+	// CommittedOffset returns the offset the group committed for the topic partition.
+	CommittedOffset(
+		ctx context.Context,
+		group string,
+		topic libkafka.Topic,
+		partition libkafka.Partition,
+	) (*libkafka.Offset, error)
+}
+
+func NewConsumerGroupsProvider(
+	saramaClient libkafka.SaramaClient,
+	clusterAdmin sarama.ClusterAdmin,
+) ConsumerGroupsProvider {
+	return &consumerGroupsProvider{
+		saramaClient: saramaClient,
+		clusterAdmin: clusterAdmin,
+	}
+}
+
+type consumerGroupsProvider struct {
+	saramaClient libkafka.SaramaClient
+	clusterAdmin sarama.ClusterAdmin
+}
+
+func (c *consumerGroupsProvider) ConsumerGroups(ctx context.Context) (ConsumerGroupInfos, error) {
+	groups, err := c.clusterAdmin.ListConsumerGroups()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, "list consumer groups failed")
+	}
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if len(names) == 0 {
+		return ConsumerGroupInfos{}, nil
+	}
+
+	descriptions, err := c.clusterAdmin.DescribeConsumerGroups(names)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, "describe consumer groups failed")
+	}
+	states := make(map[string]string, len(descriptions))
+	for _, description := range descriptions {
+		states[description.GroupId] = description.State
+	}
+
+	result := make(ConsumerGroupInfos, 0, len(names))
+	for _, name := range names {
+		topics, err := c.committedTopics(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, ConsumerGroupInfo{
+			Name:         name,
+			ProtocolType: groups[name],
+			State:        states[name],
+			Topics:       topics,
+		})
+	}
+	return result, nil
+}
+
+// committedTopics returns the sorted topics the group committed an offset for.
+func (c *consumerGroupsProvider) committedTopics(
+	ctx context.Context,
+	group string,
+) ([]libkafka.Topic, error) {
+	response, err := c.clusterAdmin.ListConsumerGroupOffsets(group, nil)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "list offsets of consumer group %s failed", group)
+	}
+	result := []libkafka.Topic{}
+	for topic, partitions := range offsetFetchBlocks(response) {
+		for _, block := range partitions {
+			if block != nil && block.Offset >= 0 {
+				result = append(result, libkafka.Topic(topic))
+				break
+			}
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return result, nil
+}
+
+func (c *consumerGroupsProvider) ConsumerGroup(
+	ctx context.Context,
+	group string,
+) (*ConsumerGroupDetail, error) {
+	descriptions, err := c.clusterAdmin.DescribeConsumerGroups([]string{group})
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "describe consumer group %s failed", group)
+	}
+	if len(descriptions) == 0 {
+		return nil, errors.Errorf(ctx, "consumer group %s not found", group)
+	}
+	description := descriptions[0]
+	if !errors.Is(description.Err, sarama.ErrNoError) {
+		return nil, errors.Wrapf(ctx, description.Err, "describe consumer group %s failed", group)
+	}
+
+	members, err := c.members(ctx, description)
+	if err != nil {
+		return nil, err
+	}
+
+	offsets, err := c.offsets(ctx, group)
+	if err != nil {
+		return nil, err
+	}
+
+	var totalLag int64
+	for _, offset := range offsets {
+		totalLag += offset.Lag
+	}
+
+	return &ConsumerGroupDetail{
+		Name:         group,
+		ProtocolType: description.ProtocolType,
+		Protocol:     description.Protocol,
+		State:        description.State,
+		Members:      members,
+		Offsets:      offsets,
+		TotalLag:     totalLag,
+	}, nil
+}
+
+func (c *consumerGroupsProvider) members(
+	ctx context.Context,
+	description *sarama.GroupDescription,
+) (ConsumerGroupMembers, error) {
+	result := make(ConsumerGroupMembers, 0, len(description.Members))
+	for _, member := range description.Members {
+		assignments := map[libkafka.Topic][]libkafka.Partition{}
+		if len(member.MemberAssignment) > 0 {
+			assignment, err := member.GetMemberAssignment()
+			if err != nil {
+				return nil, errors.Wrapf(
+					ctx,
+					err,
+					"decode assignment of member %s failed",
+					member.MemberId,
+				)
+			}
+			for topic, partitions := range assignment.Topics {
+				for _, partition := range partitions {
+					assignments[libkafka.Topic(topic)] = append(
+						assignments[libkafka.Topic(topic)],
+						libkafka.Partition(partition),
+					)
+				}
+			}
+		}
+		result = append(result, ConsumerGroupMember{
+			MemberID:    member.MemberId,
+			ClientID:    member.ClientId,
+			ClientHost:  member.ClientHost,
+			Assignments: assignments,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].MemberID < result[j].MemberID })
+	return result, nil
+}
+
+// offsets returns the committed offsets of all partitions the group committed for.
+// Lag is the distance to the high watermark, partitions without commit are skipped.
+func (c *consumerGroupsProvider) offsets(
+	ctx context.Context,
+	group string,
+) (ConsumerGroupOffsets, error) {
+	response, err := c.clusterAdmin.ListConsumerGroupOffsets(group, nil)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "list offsets of consumer group %s failed", group)
+	}
+
+	var result ConsumerGroupOffsets
+	for topic, partitions := range offsetFetchBlocks(response) {
+		for partition, block := range partitions {
+			if block == nil || block.Offset < 0 {
+				continue
+			}
+			highWaterMark, err := c.saramaClient.GetOffset(topic, partition, sarama.OffsetNewest)
+			if err != nil {
+				return nil, errors.Wrapf(
+					ctx,
+					err,
+					"get high watermark of topic %s partition %d failed",
+					topic,
+					partition,
+				)
+			}
+			result = append(result, ConsumerGroupOffset{
+				Topic:           libkafka.Topic(topic),
+				Partition:       libkafka.Partition(partition),
+				CommittedOffset: libkafka.Offset(block.Offset),
+				HighWaterMark:   libkafka.Offset(highWaterMark),
+				Lag:             max(highWaterMark-block.Offset, 0),
+			})
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Topic != result[j].Topic {
+			return result[i].Topic < result[j].Topic
+		}
+		return result[i].Partition < result[j].Partition
+	})
+	return result, nil
+}
+
+func (c *consumerGroupsProvider) CommittedOffset(
+	ctx context.Context,
+	group string,
+	topic libkafka.Topic,
+	partition libkafka.Partition,
+) (*libkafka.Offset, error) {
+	response, err := c.clusterAdmin.ListConsumerGroupOffsets(
+		group,
+		map[string][]int32{topic.String(): {partition.Int32()}},
+	)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "list offsets of consumer group %s failed", group)
+	}
+	block := response.GetBlock(topic.String(), partition.Int32())
+	if block == nil || block.Offset < 0 {
+		return nil, errors.Errorf(
+			ctx,
+			"consumer group %s has no committed offset for topic %s partition %d",
+			group,
+			topic,
+			partition,
+		)
+	}
+	if !errors.Is(block.Err, sarama.ErrNoError) {
+		return nil, errors.Wrapf(ctx, block.Err, "get committed offset of group %s failed", group)
+	}
+	offset := libkafka.Offset(block.Offset)
+	return &offset, nil
+}
+
+// offsetFetchBlocks returns the blocks of the response independent of the protocol version.
+func offsetFetchBlocks(
+	response *sarama.OffsetFetchResponse,
+) map[string]map[int32]*sarama.OffsetFetchResponseBlock {
+	if response.Version >= 8 {
+		if len(response.Groups) == 0 {
+			return nil
+		}
+		return response.Groups[0].Blocks
+	}
+	return response.Blocks
+}
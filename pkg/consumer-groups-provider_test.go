@@ -0,0 +1,229 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+
+	"github.com/IBM/sarama"
+	"github.com/bborbe/errors"
+	libkafka "github.com/bborbe/kafka"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/mocks"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("ConsumerGroupsProvider", func() {
+	var ctx context.Context
+	var err error
+	var saramaClient *mocks.SaramaClient
+	var clusterAdmin *mocks.SaramaClusterAdmin
+	var consumerGroupsProvider pkg.ConsumerGroupsProvider
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		saramaClient = &mocks.SaramaClient{}
+		clusterAdmin = &mocks.SaramaClusterAdmin{}
+		consumerGroupsProvider = pkg.NewConsumerGroupsProvider(saramaClient, clusterAdmin)
+	})
+
+	Context("ConsumerGroups", func() {
+		var groups pkg.ConsumerGroupInfos
+
+		BeforeEach(func() {
+			clusterAdmin.ListConsumerGroupsReturns(map[string]string{
+				"billing":  "consumer",
+				"analyzer": "consumer",
+			}, nil)
+			clusterAdmin.DescribeConsumerGroupsReturns([]*sarama.GroupDescription{
+				{GroupId: "analyzer", State: "Empty"},
+				{GroupId: "billing", State: "Stable"},
+			}, nil)
+			clusterAdmin.ListConsumerGroupOffsetsStub = func(
+				group string,
+				_ map[string][]int32,
+			) (*sarama.OffsetFetchResponse, error) {
+				response := &sarama.OffsetFetchResponse{}
+				if group == "billing" {
+					response.AddBlock("payments", 0, &sarama.OffsetFetchResponseBlock{Offset: 7})
+					response.AddBlock("orders", 0, &sarama.OffsetFetchResponseBlock{Offset: -1})
+					response.AddBlock("orders", 1, &sarama.OffsetFetchResponseBlock{Offset: 3})
+					response.AddBlock("users", 0, &sarama.OffsetFetchResponseBlock{Offset: -1})
+				}
+				return response, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			groups, err = consumerGroupsProvider.ConsumerGroups(ctx)
+		})
+
+		It("returns groups sorted with state and committed topics", func() {
+			Expect(err).To(BeNil())
+			Expect(groups).To(Equal(pkg.ConsumerGroupInfos{
+				{
+					Name:         "analyzer",
+					ProtocolType: "consumer",
+					State:        "Empty",
+					Topics:       []libkafka.Topic{},
+				},
+				{
+					Name:         "billing",
+					ProtocolType: "consumer",
+					State:        "Stable",
+					Topics:       []libkafka.Topic{"orders", "payments"},
+				},
+			}))
+		})
+
+		Context("list offsets fails", func() {
+			BeforeEach(func() {
+				clusterAdmin.ListConsumerGroupOffsetsStub = nil
+				clusterAdmin.ListConsumerGroupOffsetsReturns(nil, errors.New(ctx, "banana"))
+			})
+
+			It("returns error", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("list offsets of consumer group"))
+			})
+		})
+
+		Context("list fails", func() {
+			BeforeEach(func() {
+				clusterAdmin.ListConsumerGroupsReturns(nil, errors.New(ctx, "banana"))
+			})
+
+			It("returns error", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("list consumer groups failed"))
+			})
+		})
+	})
+
+	Context("ConsumerGroup", func() {
+		var detail *pkg.ConsumerGroupDetail
+
+		BeforeEach(func() {
+			clusterAdmin.DescribeConsumerGroupsReturns([]*sarama.GroupDescription{
+				{
+					GroupId:      "billing",
+					State:        "Stable",
+					ProtocolType: "consumer",
+					Protocol:     "range",
+					Members: map[string]*sarama.GroupMemberDescription{
+						"member-1": {
+							MemberId:   "member-1",
+							ClientId:   "client-1",
+							ClientHost: "/10.0.0.1",
+						},
+					},
+				},
+			}, nil)
+			response := &sarama.OffsetFetchResponse{}
+			response.AddBlock("orders", 0, &sarama.OffsetFetchResponseBlock{Offset: 90})
+			response.AddBlock("orders", 1, &sarama.OffsetFetchResponseBlock{Offset: 50})
+			response.AddBlock("orders", 2, &sarama.OffsetFetchResponseBlock{Offset: -1})
+			clusterAdmin.ListConsumerGroupOffsetsReturns(response, nil)
+			saramaClient.GetOffsetReturns(100, nil)
+		})
+
+		JustBeforeEach(func() {
+			detail, err = consumerGroupsProvider.ConsumerGroup(ctx, "billing")
+		})
+
+		It("returns no error", func() {
+			Expect(err).To(BeNil())
+		})
+
+		It("returns state and members", func() {
+			Expect(detail.State).To(Equal("Stable"))
+			Expect(detail.Protocol).To(Equal("range"))
+			Expect(detail.Members).To(HaveLen(1))
+			Expect(detail.Members[0].ClientID).To(Equal("client-1"))
+		})
+
+		It("returns committed offsets with lag", func() {
+			Expect(detail.Offsets).To(Equal(pkg.ConsumerGroupOffsets{
+				{
+					Topic:           "orders",
+					Partition:       0,
+					CommittedOffset: 90,
+					HighWaterMark:   100,
+					Lag:             10,
+				},
+				{
+					Topic:           "orders",
+					Partition:       1,
+					CommittedOffset: 50,
+					HighWaterMark:   100,
+					Lag:             50,
+				},
+			}))
+			Expect(detail.TotalLag).To(Equal(int64(60)))
+		})
+
+		Context("group unknown", func() {
+			BeforeEach(func() {
+				clusterAdmin.DescribeConsumerGroupsReturns([]*sarama.GroupDescription{}, nil)
+			})
+
+			It("returns error", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("consumer group billing not found"))
+			})
+		})
+
+		Context("list offsets fails", func() {
+			BeforeEach(func() {
+				clusterAdmin.ListConsumerGroupOffsetsReturns(nil, errors.New(ctx, "banana"))
+			})
+
+			It("returns error", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(
+					err.Error(),
+				).To(ContainSubstring("list offsets of consumer group billing failed"))
+			})
+		})
+	})
+
+	Context("CommittedOffset", func() {
+		var offset *libkafka.Offset
+
+		BeforeEach(func() {
+			response := &sarama.OffsetFetchResponse{}
+			response.AddBlock("orders", 1, &sarama.OffsetFetchResponseBlock{Offset: 77})
+			clusterAdmin.ListConsumerGroupOffsetsReturns(response, nil)
+		})
+
+		JustBeforeEach(func() {
+			offset, err = consumerGroupsProvider.CommittedOffset(ctx, "billing", "orders", 1)
+		})
+
+		It("returns committed offset", func() {
+			Expect(err).To(BeNil())
+			Expect(*offset).To(Equal(libkafka.Offset(77)))
+		})
+
+		It("requests only the partition", func() {
+			group, topicPartitions := clusterAdmin.ListConsumerGroupOffsetsArgsForCall(0)
+			Expect(group).To(Equal("billing"))
+			Expect(topicPartitions).To(Equal(map[string][]int32{"orders": {1}}))
+		})
+
+		Context("no committed offset", func() {
+			BeforeEach(func() {
+				clusterAdmin.ListConsumerGroupOffsetsReturns(&sarama.OffsetFetchResponse{}, nil)
+			})
+
+			It("returns error", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("has no committed offset"))
+			})
+		})
+	})
+})
@@ -0,0 +1,71 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+//counterfeiter:generate -o ../mocks/consumer-pool-metrics.go --fake-name ConsumerPoolMetrics . ConsumerPoolMetrics
+type ConsumerPoolMetrics interface {
+	Acquired(result ConsumerPoolResult)
+	Evicted()
+	IdleChanged(delta int)
+}
+
+// NewConsumerPoolMetrics is shared by the pools of all clusters.
+func NewConsumerPoolMetrics(
+	registerer prometheus.Registerer,
+	namespace string,
+) ConsumerPoolMetrics {
+	c := &consumerPoolMetrics{
+		acquires: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "consumer_pool_acquires_total",
+				Help:      "Consumers acquired from the pool by result hit, seek or miss.",
+			},
+			[]string{"result"},
+		),
+		evictions: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "consumer_pool_evictions_total",
+				Help:      "Idle consumers closed because of idle timeout or pool size.",
+			},
+		),
+		idleConsumers: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "consumer_pool_idle_consumers",
+				Help:      "Consumers kept open in the pool.",
+			},
+		),
+	}
+	registerer.MustRegister(
+		c.acquires,
+		c.evictions,
+		c.idleConsumers,
+	)
+	return c
+}
+
+type consumerPoolMetrics struct {
+	acquires      *prometheus.CounterVec
+	evictions     prometheus.Counter
+	idleConsumers prometheus.Gauge
+}
+
+func (c *consumerPoolMetrics) Acquired(result ConsumerPoolResult) {
+	c.acquires.WithLabelValues(string(result)).Inc()
+}
+
+func (c *consumerPoolMetrics) Evicted() {
+	c.evictions.Inc()
+}
+
+func (c *consumerPoolMetrics) IdleChanged(delta int) {
+	c.idleConsumers.Add(float64(delta))
+}
@@ -0,0 +1,491 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+	stderrors "errors"
+	"sync"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/bborbe/errors"
+	libkafka "github.com/bborbe/kafka"
+	"github.com/golang/glog"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ConsumerPoolResult tells how a consumer was acquired from the pool.
+type ConsumerPoolResult string
+
+const (
+	// ConsumerPoolResultHit means an idle consumer was already at the requested offset.
+	ConsumerPoolResultHit ConsumerPoolResult = "hit"
+	// ConsumerPoolResultSeek means an idle consumer of the partition was moved to the offset.
+	ConsumerPoolResultSeek ConsumerPoolResult = "seek"
+	// ConsumerPoolResultMiss means a new consumer was created.
+	ConsumerPoolResultMiss ConsumerPoolResult = "miss"
+)
+
+// ErrTooManyFetchConfigs is returned if the max number of clients for fetch configs
+// other than the default is already connected.
+var ErrTooManyFetchConfigs = stderrors.New("too many clients for fetch configs")
+
+// SaramaConsumerFactory creates a new sarama consumer fetching with the given config.
+type SaramaConsumerFactory func(fetchConfig FetchConfig) (sarama.Consumer, error)
+
+// NewSaramaConsumerFactory creates consumers sharing the connections of the given client,
+// which is configured with defaultFetchConfig. sarama reads the fetch settings from the
+// client config, so consumers with another fetch config share a client per fetch
+// config. Up to maxClients of these clients are connected at the same time, each is
+// closed with the last of its consumers.
+func NewSaramaConsumerFactory(
+	saramaClient libkafka.SaramaClient,
+	defaultFetchConfig FetchConfig,
+	maxClients int,
+) SaramaConsumerFactory {
+	clients := &fetchConfigClients{
+		saramaClient: saramaClient,
+		maxClients:   maxClients,
+		clients:      make(map[FetchConfig]*fetchConfigClient),
+	}
+	return func(fetchConfig FetchConfig) (sarama.Consumer, error) {
+		if fetchConfig == defaultFetchConfig {
+			return sarama.NewConsumerFromClient(saramaClient)
+		}
+		return clients.newConsumer(fetchConfig)
+	}
+}
+
+// fetchConfigClients counts the consumers of each client to close it with the last.
+type fetchConfigClients struct {
+	mux          sync.Mutex
+	saramaClient libkafka.SaramaClient
+	maxClients   int
+	clients      map[FetchConfig]*fetchConfigClient
+}
+
+type fetchConfigClient struct {
+	client    sarama.Client
+	consumers int
+}
+
+func (f *fetchConfigClients) newConsumer(fetchConfig FetchConfig) (sarama.Consumer, error) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	client, ok := f.clients[fetchConfig]
+	if !ok {
+		if len(f.clients) >= f.maxClients {
+			return nil, ErrTooManyFetchConfigs
+		}
+		saramaClient, err := f.newClient(fetchConfig)
+		if err != nil {
+			return nil, err
+		}
+		client = &fetchConfigClient{client: saramaClient}
+		f.clients[fetchConfig] = client
+	}
+	consumer, err := sarama.NewConsumerFromClient(client.client)
+	if err != nil {
+		f.closeUnused(fetchConfig)
+		return nil, err
+	}
+	client.consumers++
+	return &fetchConfigConsumer{
+		Consumer: consumer,
+		release: func() {
+			f.mux.Lock()
+			defer f.mux.Unlock()
+			client.consumers--
+			f.closeUnused(fetchConfig)
+		},
+	}, nil
+}
+
+func (f *fetchConfigClients) newClient(fetchConfig FetchConfig) (sarama.Client, error) {
+	config := *f.saramaClient.Config()
+	fetchConfig.SaramaConfigOptions()(&config)
+	brokers := f.saramaClient.Brokers()
+	addrs := make([]string, 0, len(brokers))
+	for _, broker := range brokers {
+		addrs = append(addrs, broker.Addr())
+	}
+	return sarama.NewClient(addrs, &config)
+}
+
+// closeUnused closes the client of the fetch config if it has no consumer left.
+func (f *fetchConfigClients) closeUnused(fetchConfig FetchConfig) {
+	client := f.clients[fetchConfig]
+	if client.consumers > 0 {
+		return
+	}
+	delete(f.clients, fetchConfig)
+	if err := client.client.Close(); err != nil {
+		glog.V(2).Infof("close client of fetch config failed: %v", err)
+	}
+}
+
+// fetchConfigConsumer releases its client on close, the consumer does not close a
+// client it did not create.
+type fetchConfigConsumer struct {
+	sarama.Consumer
+	release func()
+}
+
+func (c *fetchConfigConsumer) Close() error {
+	err := c.Consumer.Close()
+	c.release()
+	return err
+}
+
+//counterfeiter:generate -o ../mocks/consumer-pool.go --fake-name ConsumerPool . ConsumerPool
+type ConsumerPool interface {
+	// Acquire returns a consumer of the partition starting at offset, fetching with
+	// fetchConfig. It must be released after use.
+	Acquire(
+		ctx context.Context,
+		topic libkafka.Topic,
+		partition libkafka.Partition,
+		offset libkafka.Offset,
+		fetchConfig FetchConfig,
+	) (PooledConsumer, error)
+	// Run closes consumers idle for the idle timeout until ctx is canceled.
+	Run(ctx context.Context) error
+	// Close closes all idle consumers, consumers in use are closed on release.
+	Close()
+}
+
+//counterfeiter:generate -o ../mocks/pooled-consumer.go --fake-name PooledConsumer . PooledConsumer
+type PooledConsumer interface {
+	// Next blocks until the next message of the partition is available or ctx is done.
+	Next(ctx context.Context) (*sarama.ConsumerMessage, error)
+	// Release returns the consumer to the pool, positioned after the last message
+	// returned by Next. A consumer that failed is closed instead.
+	Release()
+}
+
+// NewConsumerPool keeps released partition consumers open, so a read continuing at
+// the offset the previous read stopped gets prefetched messages without setup. Up to
+// maxIdle consumers are kept, each at most idleTimeout, evicted by Run or the next
+// Acquire or release. maxIdle 0 disables pooling.
+func NewConsumerPool(
+	createConsumer SaramaConsumerFactory,
+	consumerPoolMetrics ConsumerPoolMetrics,
+	idleTimeout time.Duration,
+	maxIdle int,
+) ConsumerPool {
+	return &consumerPool{
+		createConsumer:      createConsumer,
+		consumerPoolMetrics: consumerPoolMetrics,
+		idleTimeout:         idleTimeout,
+		maxIdle:             maxIdle,
+		idle:                make(map[consumerPoolKey][]*pooledConsumer),
+		now:                 time.Now,
+	}
+}
+
+type consumerPoolKey struct {
+	topic       libkafka.Topic
+	partition   libkafka.Partition
+	fetchConfig FetchConfig
+}
+
+type consumerPool struct {
+	mux                 sync.Mutex
+	createConsumer      SaramaConsumerFactory
+	consumerPoolMetrics ConsumerPoolMetrics
+	idleTimeout         time.Duration
+	maxIdle             int
+	// idle consumers per partition and fetch config, the most recently released last
+	idle      map[consumerPoolKey][]*pooledConsumer
+	idleCount int
+	closed    bool
+	now       func() time.Time
+}
+
+func (p *consumerPool) Acquire(
+	ctx context.Context,
+	topic libkafka.Topic,
+	partition libkafka.Partition,
+	offset libkafka.Offset,
+	fetchConfig FetchConfig,
+) (PooledConsumer, error) {
+	ctx, span := tracer.Start(
+		ctx,
+		"ConsumerPool.Acquire",
+		trace.WithAttributes(
+			attributeTopic.String(topic.String()),
+			attributePartition.Int64(int64(partition)),
+			attributeOffset.Int64(int64(offset)),
+		),
+	)
+	defer span.End()
+
+	key := consumerPoolKey{topic: topic, partition: partition, fetchConfig: fetchConfig}
+
+	p.mux.Lock()
+	expired := p.removeExpired(p.now())
+	consumer, result := p.takeIdle(key, offset)
+	p.mux.Unlock()
+	p.closeEvicted(expired)
+
+	span.SetAttributes(attribute.String("consumer_pool.result", string(result)))
+	p.consumerPoolMetrics.Acquired(result)
+
+	switch result {
+	case ConsumerPoolResultHit:
+		return consumer, nil
+	case ConsumerPoolResultSeek:
+		if err := consumer.seek(offset); err != nil {
+			consumer.close()
+			recordSpanError(span, err)
+			return nil, errors.Wrapf(ctx, err, "seek %s/%d to %d failed", topic, partition, offset)
+		}
+		return consumer, nil
+	default:
+		consumer, err := p.newConsumer(key, offset)
+		if err != nil {
+			recordSpanError(span, err)
+			return nil, errors.Wrapf(
+				ctx,
+				err,
+				"consume %s/%d at %d failed",
+				topic,
+				partition,
+				offset,
+			)
+		}
+		return consumer, nil
+	}
+}
+
+// takeIdle prefers a consumer at the offset, else the most recently used one of the partition.
+func (p *consumerPool) takeIdle(
+	key consumerPoolKey,
+	offset libkafka.Offset,
+) (*pooledConsumer, ConsumerPoolResult) {
+	consumers := p.idle[key]
+	if len(consumers) == 0 {
+		return nil, ConsumerPoolResultMiss
+	}
+	index := len(consumers) - 1
+	result := ConsumerPoolResultSeek
+	for i, consumer := range consumers {
+		if consumer.nextOffset == offset {
+			index = i
+			result = ConsumerPoolResultHit
+			break
+		}
+	}
+	consumer := consumers[index]
+	p.removeIdle(key, index)
+	return consumer, result
+}
+
+func (p *consumerPool) removeIdle(key consumerPoolKey, index int) {
+	consumers := append(p.idle[key][:index], p.idle[key][index+1:]...)
+	if len(consumers) == 0 {
+		delete(p.idle, key)
+	} else {
+		p.idle[key] = consumers
+	}
+	p.idleCount--
+	p.consumerPoolMetrics.IdleChanged(-1)
+}
+
+func (p *consumerPool) newConsumer(
+	key consumerPoolKey,
+	offset libkafka.Offset,
+) (*pooledConsumer, error) {
+	saramaConsumer, err := p.createConsumer(key.fetchConfig)
+	if err != nil {
+		return nil, err
+	}
+	consumer := &pooledConsumer{
+		pool:     p,
+		key:      key,
+		consumer: saramaConsumer,
+	}
+	if err := consumer.seek(offset); err != nil {
+		consumer.close()
+		return nil, err
+	}
+	return consumer, nil
+}
+
+func (p *consumerPool) release(consumer *pooledConsumer) {
+	if consumer.failed {
+		consumer.close()
+		return
+	}
+	p.mux.Lock()
+	if p.closed || p.maxIdle <= 0 {
+		p.mux.Unlock()
+		consumer.close()
+		return
+	}
+	now := p.now()
+	consumer.lastUsed = now
+	p.idle[consumer.key] = append(p.idle[consumer.key], consumer)
+	p.idleCount++
+	p.consumerPoolMetrics.IdleChanged(1)
+	evicted := p.removeExpired(now)
+	for p.idleCount > p.maxIdle {
+		evicted = append(evicted, p.removeOldest())
+	}
+	p.mux.Unlock()
+	p.closeEvicted(evicted)
+}
+
+func (p *consumerPool) removeExpired(now time.Time) []*pooledConsumer {
+	var result []*pooledConsumer
+	for key, consumers := range p.idle {
+		for i := len(consumers) - 1; i >= 0; i-- {
+			if now.Sub(consumers[i].lastUsed) >= p.idleTimeout {
+				result = append(result, consumers[i])
+				p.removeIdle(key, i)
+			}
+		}
+	}
+	return result
+}
+
+func (p *consumerPool) removeOldest() *pooledConsumer {
+	var oldestKey consumerPoolKey
+	var oldest *pooledConsumer
+	for key, consumers := range p.idle {
+		// the first consumer of a partition is its least recently used
+		if oldest == nil || consumers[0].lastUsed.Before(oldest.lastUsed) {
+			oldestKey = key
+			oldest = consumers[0]
+		}
+	}
+	p.removeIdle(oldestKey, 0)
+	return oldest
+}
+
+func (p *consumerPool) closeEvicted(consumers []*pooledConsumer) {
+	for _, consumer := range consumers {
+		p.consumerPoolMetrics.Evicted()
+		consumer.close()
+	}
+}
+
+func (p *consumerPool) Run(ctx context.Context) error {
+	// consumers are closed at most half the idle timeout late
+	ticker := time.NewTicker(max(p.idleTimeout/2, time.Millisecond))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			p.mux.Lock()
+			expired := p.removeExpired(p.now())
+			p.mux.Unlock()
+			p.closeEvicted(expired)
+		}
+	}
+}
+
+func (p *consumerPool) Close() {
+	p.mux.Lock()
+	p.closed = true
+	var consumers []*pooledConsumer
+	for key := range p.idle {
+		for len(p.idle[key]) > 0 {
+			consumers = append(consumers, p.idle[key][0])
+			p.removeIdle(key, 0)
+		}
+	}
+	p.mux.Unlock()
+	for _, consumer := range consumers {
+		consumer.close()
+	}
+}
+
+type pooledConsumer struct {
+	pool              *consumerPool
+	key               consumerPoolKey
+	consumer          sarama.Consumer
+	partitionConsumer sarama.PartitionConsumer
+	nextOffset        libkafka.Offset
+	lastUsed          time.Time
+	failed            bool
+}
+
+func (c *pooledConsumer) Next(ctx context.Context) (*sarama.ConsumerMessage, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case msg, ok := <-c.partitionConsumer.Messages():
+		if !ok {
+			c.failed = true
+			return nil, errors.Errorf(
+				ctx,
+				"consumer of %s/%d closed",
+				c.key.topic,
+				c.key.partition,
+			)
+		}
+		c.nextOffset = libkafka.Offset(msg.Offset + 1)
+		return msg, nil
+	case consumerErr, ok := <-c.partitionConsumer.Errors():
+		c.failed = true
+		if !ok {
+			return nil, errors.Errorf(
+				ctx,
+				"consumer of %s/%d closed",
+				c.key.topic,
+				c.key.partition,
+			)
+		}
+		return nil, errors.Wrapf(
+			ctx,
+			consumerErr,
+			"consume %s/%d failed",
+			c.key.topic,
+			c.key.partition,
+		)
+	}
+}
+
+func (c *pooledConsumer) Release() {
+	c.pool.release(c)
+}
+
+// seek replaces the partition consumer, sarama can not move an existing one.
+func (c *pooledConsumer) seek(offset libkafka.Offset) error {
+	c.closePartitionConsumer()
+	partitionConsumer, err := c.consumer.ConsumePartition(
+		c.key.topic.String(),
+		c.key.partition.Int32(),
+		offset.Int64(),
+	)
+	if err != nil {
+		return err
+	}
+	c.partitionConsumer = partitionConsumer
+	c.nextOffset = offset
+	return nil
+}
+
+func (c *pooledConsumer) closePartitionConsumer() {
+	if c.partitionConsumer == nil {
+		return
+	}
+	if err := c.partitionConsumer.Close(); err != nil {
+		glog.V(2).Infof("close consumer of %s/%d failed: %v", c.key.topic, c.key.partition, err)
+	}
+	c.partitionConsumer = nil
+}
+
+func (c *pooledConsumer) close() {
+	c.closePartitionConsumer()
+	if err := c.consumer.Close(); err != nil {
+		glog.V(2).Infof("close consumer failed: %v", err)
+	}
+}
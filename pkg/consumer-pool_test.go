@@ -0,0 +1,322 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/bborbe/errors"
+	libkafka "github.com/bborbe/kafka"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/mocks"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("ConsumerPool", func() {
+	var ctx context.Context
+	var saramaConsumers []*mocks.SaramaConsumer
+	var fetchConfigs []pkg.FetchConfig
+	var partitionConsumers []*mocks.SaramaPartitionConsumer
+	var messages chan *sarama.ConsumerMessage
+	var consumerPoolMetrics *mocks.ConsumerPoolMetrics
+	var idleTimeout time.Duration
+	var maxIdle int
+	var consumerPool pkg.ConsumerPool
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		saramaConsumers = nil
+		fetchConfigs = nil
+		partitionConsumers = nil
+		messages = make(chan *sarama.ConsumerMessage, 10)
+		consumerPoolMetrics = &mocks.ConsumerPoolMetrics{}
+		idleTimeout = time.Hour
+		maxIdle = 10
+	})
+
+	JustBeforeEach(func() {
+		consumerPool = pkg.NewConsumerPool(
+			func(fetchConfig pkg.FetchConfig) (sarama.Consumer, error) {
+				fetchConfigs = append(fetchConfigs, fetchConfig)
+				saramaConsumer := &mocks.SaramaConsumer{}
+				saramaConsumer.ConsumePartitionStub = func(
+					topic string,
+					partition int32,
+					offset int64,
+				) (sarama.PartitionConsumer, error) {
+					partitionConsumer := &mocks.SaramaPartitionConsumer{}
+					partitionConsumer.MessagesReturns(messages)
+					partitionConsumers = append(partitionConsumers, partitionConsumer)
+					return partitionConsumer, nil
+				}
+				saramaConsumers = append(saramaConsumers, saramaConsumer)
+				return saramaConsumer, nil
+			},
+			consumerPoolMetrics,
+			idleTimeout,
+			maxIdle,
+		)
+	})
+
+	acquire := func(offset libkafka.Offset) pkg.PooledConsumer {
+		consumer, err := consumerPool.Acquire(ctx, "orders", 1, offset, pkg.DefaultFetchConfig())
+		Expect(err).To(BeNil())
+		Expect(consumer).NotTo(BeNil())
+		return consumer
+	}
+
+	next := func(consumer pkg.PooledConsumer, offset int64) {
+		messages <- &sarama.ConsumerMessage{Topic: "orders", Partition: 1, Offset: offset}
+		msg, err := consumer.Next(ctx)
+		Expect(err).To(BeNil())
+		Expect(msg.Offset).To(Equal(offset))
+	}
+
+	lastResult := func() pkg.ConsumerPoolResult {
+		return consumerPoolMetrics.AcquiredArgsForCall(consumerPoolMetrics.AcquiredCallCount() - 1)
+	}
+
+	It("creates a consumer at the offset", func() {
+		acquire(42)
+		Expect(saramaConsumers).To(HaveLen(1))
+		topic, partition, offset := saramaConsumers[0].ConsumePartitionArgsForCall(0)
+		Expect(topic).To(Equal("orders"))
+		Expect(partition).To(Equal(int32(1)))
+		Expect(offset).To(Equal(int64(42)))
+		Expect(lastResult()).To(Equal(pkg.ConsumerPoolResultMiss))
+	})
+
+	It("reuses a released consumer at the next offset", func() {
+		consumer := acquire(42)
+		next(consumer, 42)
+		next(consumer, 43)
+		consumer.Release()
+
+		acquire(44)
+		Expect(saramaConsumers).To(HaveLen(1))
+		Expect(partitionConsumers).To(HaveLen(1))
+		Expect(lastResult()).To(Equal(pkg.ConsumerPoolResultHit))
+	})
+
+	It("seeks a released consumer to another offset", func() {
+		consumer := acquire(42)
+		next(consumer, 42)
+		consumer.Release()
+
+		acquire(10)
+		Expect(saramaConsumers).To(HaveLen(1))
+		Expect(partitionConsumers).To(HaveLen(2))
+		Expect(partitionConsumers[0].CloseCallCount()).To(Equal(1))
+		_, _, offset := saramaConsumers[0].ConsumePartitionArgsForCall(1)
+		Expect(offset).To(Equal(int64(10)))
+		Expect(lastResult()).To(Equal(pkg.ConsumerPoolResultSeek))
+	})
+
+	It("creates another consumer while the partition is in use", func() {
+		acquire(42)
+		acquire(42)
+		Expect(saramaConsumers).To(HaveLen(2))
+		Expect(lastResult()).To(Equal(pkg.ConsumerPoolResultMiss))
+	})
+
+	It("creates a consumer with another fetch config", func() {
+		consumer := acquire(42)
+		next(consumer, 42)
+		consumer.Release()
+
+		fetchConfig := pkg.DefaultFetchConfig()
+		fetchConfig.DefaultBytes = 16 * 1024 * 1024
+		_, err := consumerPool.Acquire(ctx, "orders", 1, 43, fetchConfig)
+		Expect(err).To(BeNil())
+		Expect(saramaConsumers).To(HaveLen(2))
+		Expect(fetchConfigs).To(Equal([]pkg.FetchConfig{pkg.DefaultFetchConfig(), fetchConfig}))
+		Expect(lastResult()).To(Equal(pkg.ConsumerPoolResultMiss))
+	})
+
+	It("tracks idle consumers", func() {
+		acquire(42).Release()
+		Expect(consumerPoolMetrics.IdleChangedCallCount()).To(Equal(1))
+		Expect(consumerPoolMetrics.IdleChangedArgsForCall(0)).To(Equal(1))
+	})
+
+	It("returns the error of Next if ctx is done", func() {
+		consumer := acquire(42)
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+		_, err := consumer.Next(ctx)
+		Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+	})
+
+	It("keeps the consumer if ctx is done", func() {
+		consumer := acquire(42)
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+		_, _ = consumer.Next(ctx)
+		consumer.Release()
+		Expect(saramaConsumers[0].CloseCallCount()).To(Equal(0))
+	})
+
+	It("closes a failed consumer on release", func() {
+		consumer := acquire(42)
+		close(messages)
+		_, err := consumer.Next(ctx)
+		Expect(err).NotTo(BeNil())
+		consumer.Release()
+		Expect(partitionConsumers[0].CloseCallCount()).To(Equal(1))
+		Expect(saramaConsumers[0].CloseCallCount()).To(Equal(1))
+
+		messages = make(chan *sarama.ConsumerMessage, 10)
+		acquire(42)
+		Expect(lastResult()).To(Equal(pkg.ConsumerPoolResultMiss))
+	})
+
+	It("closes idle consumers on close", func() {
+		acquire(42).Release()
+		consumerPool.Close()
+		Expect(saramaConsumers[0].CloseCallCount()).To(Equal(1))
+	})
+
+	It("closes consumers released after close", func() {
+		consumer := acquire(42)
+		consumerPool.Close()
+		consumer.Release()
+		Expect(saramaConsumers[0].CloseCallCount()).To(Equal(1))
+	})
+
+	Context("with max idle reached", func() {
+		BeforeEach(func() {
+			maxIdle = 1
+		})
+		It("evicts the least recently used consumer", func() {
+			first := acquire(42)
+			second := acquire(42)
+			first.Release()
+			second.Release()
+			Expect(saramaConsumers[0].CloseCallCount()).To(Equal(1))
+			Expect(saramaConsumers[1].CloseCallCount()).To(Equal(0))
+			Expect(consumerPoolMetrics.EvictedCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("with max idle 0", func() {
+		BeforeEach(func() {
+			maxIdle = 0
+		})
+		It("closes released consumers", func() {
+			acquire(42).Release()
+			Expect(saramaConsumers[0].CloseCallCount()).To(Equal(1))
+			acquire(42)
+			Expect(lastResult()).To(Equal(pkg.ConsumerPoolResultMiss))
+		})
+	})
+
+	Context("with idle timeout expired", func() {
+		BeforeEach(func() {
+			idleTimeout = time.Millisecond
+		})
+		It("evicts the idle consumer", func() {
+			acquire(42).Release()
+			time.Sleep(5 * time.Millisecond)
+			acquire(42)
+			Expect(saramaConsumers).To(HaveLen(2))
+			Expect(saramaConsumers[0].CloseCallCount()).To(Equal(1))
+			Expect(consumerPoolMetrics.EvictedCallCount()).To(Equal(1))
+		})
+
+		It("evicts the idle consumer without further use of the pool", func() {
+			runCtx, cancel := context.WithCancel(ctx)
+			done := make(chan error)
+			go func() {
+				done <- consumerPool.Run(runCtx)
+			}()
+			acquire(42).Release()
+			Eventually(saramaConsumers[0].CloseCallCount).Should(Equal(1))
+			Expect(consumerPoolMetrics.EvictedCallCount()).To(Equal(1))
+
+			cancel()
+			Eventually(done).Should(Receive(BeNil()))
+		})
+	})
+
+	Context("with consume partition error", func() {
+		It("returns error and closes the consumer", func() {
+			consumerPool = pkg.NewConsumerPool(
+				func(fetchConfig pkg.FetchConfig) (sarama.Consumer, error) {
+					saramaConsumer := &mocks.SaramaConsumer{}
+					saramaConsumer.ConsumePartitionReturns(nil, sarama.ErrOffsetOutOfRange)
+					saramaConsumers = append(saramaConsumers, saramaConsumer)
+					return saramaConsumer, nil
+				},
+				consumerPoolMetrics,
+				idleTimeout,
+				maxIdle,
+			)
+			_, err := consumerPool.Acquire(ctx, "orders", 1, 42, pkg.DefaultFetchConfig())
+			Expect(errors.Is(err, sarama.ErrOffsetOutOfRange)).To(BeTrue())
+			Expect(saramaConsumers[0].CloseCallCount()).To(Equal(1))
+		})
+	})
+})
+
+var _ = Describe("SaramaConsumerFactory", func() {
+	var saramaClient *mocks.SaramaClient
+	var fetchConfig pkg.FetchConfig
+	var otherFetchConfig pkg.FetchConfig
+	var createConsumer pkg.SaramaConsumerFactory
+
+	BeforeEach(func() {
+		mockBroker := sarama.NewMockBroker(GinkgoT(), 1)
+		DeferCleanup(mockBroker.Close)
+		mockBroker.SetHandlerByMap(map[string]sarama.MockResponse{
+			"MetadataRequest": sarama.NewMockMetadataResponse(GinkgoT()).
+				SetBroker(mockBroker.Addr(), mockBroker.BrokerID()),
+		})
+		config := sarama.NewConfig()
+		config.ApiVersionsRequest = false
+		saramaClient = &mocks.SaramaClient{}
+		saramaClient.ConfigReturns(config)
+		saramaClient.BrokersReturns([]*sarama.Broker{sarama.NewBroker(mockBroker.Addr())})
+
+		fetchConfig = pkg.DefaultFetchConfig()
+		otherFetchConfig = pkg.DefaultFetchConfig()
+		otherFetchConfig.DefaultBytes = 16 * 1024 * 1024
+		createConsumer = pkg.NewSaramaConsumerFactory(saramaClient, fetchConfig, 1)
+	})
+
+	It("shares the client of a fetch config until its last consumer is closed", func() {
+		first, err := createConsumer(otherFetchConfig)
+		Expect(err).To(BeNil())
+		second, err := createConsumer(otherFetchConfig)
+		Expect(err).To(BeNil())
+
+		anotherFetchConfig := otherFetchConfig
+		anotherFetchConfig.DefaultBytes = 32 * 1024 * 1024
+		_, err = createConsumer(anotherFetchConfig)
+		Expect(err).To(MatchError(pkg.ErrTooManyFetchConfigs))
+
+		Expect(first.Close()).To(Succeed())
+		_, err = createConsumer(anotherFetchConfig)
+		Expect(err).To(MatchError(pkg.ErrTooManyFetchConfigs))
+
+		Expect(second.Close()).To(Succeed())
+		another, err := createConsumer(anotherFetchConfig)
+		Expect(err).To(BeNil())
+		Expect(another.Close()).To(Succeed())
+	})
+
+	Context("without clients for other fetch configs", func() {
+		BeforeEach(func() {
+			createConsumer = pkg.NewSaramaConsumerFactory(saramaClient, fetchConfig, 0)
+		})
+		It("returns an error for another fetch config", func() {
+			_, err := createConsumer(otherFetchConfig)
+			Expect(err).To(MatchError(pkg.ErrTooManyFetchConfigs))
+		})
+	})
+})
@@ -0,0 +1,196 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/binary"
+	stderrors "errors"
+	"io"
+	"strings"
+
+	"github.com/IBM/sarama"
+	"github.com/bborbe/errors"
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+)
+
+// ErrDecompressedValueTooLarge is returned if a decompressed value exceeds the max
+// decompressed bytes.
+var ErrDecompressedValueTooLarge = stderrors.New("decompressed value too large")
+
+// ContentEncodingHeader is the message header naming the compression of the value.
+const ContentEncodingHeader = "content-encoding"
+
+// ContentEncoding is the compression the producer applied to a message value, not
+// the compression of the Kafka record batch.
+type ContentEncoding string
+
+const (
+	// ContentEncodingIdentity means the value is not compressed.
+	ContentEncodingIdentity ContentEncoding = "identity"
+	ContentEncodingGzip     ContentEncoding = "gzip"
+	ContentEncodingZstd     ContentEncoding = "zstd"
+	ContentEncodingSnappy   ContentEncoding = "snappy"
+	ContentEncodingLZ4      ContentEncoding = "lz4"
+)
+
+func (c ContentEncoding) String() string {
+	return string(c)
+}
+
+var (
+	gzipMagic         = []byte{0x1f, 0x8b}
+	zstdMagic         = []byte{0x28, 0xb5, 0x2f, 0xfd}
+	lz4Magic          = []byte{0x04, 0x22, 0x4d, 0x18}
+	snappyFramedMagic = []byte("\xff\x06\x00\x00sNaPpY")
+	snappyXerialMagic = []byte("\x82SNAPPY\x00")
+)
+
+// ValueContentEncoding returns the compression of the message value, taken from the
+// content-encoding header or detected by the magic bytes of the gzip, zstd, lz4 and
+// framed snappy formats. Unframed snappy is only detected by header.
+func ValueContentEncoding(msg *sarama.ConsumerMessage) ContentEncoding {
+	if len(msg.Value) == 0 {
+		return ContentEncodingIdentity
+	}
+	for _, header := range msg.Headers {
+		if !strings.EqualFold(string(header.Key), ContentEncodingHeader) {
+			continue
+		}
+		switch encoding := ContentEncoding(strings.ToLower(string(header.Value))); encoding {
+		case ContentEncodingIdentity,
+			ContentEncodingGzip,
+			ContentEncodingZstd,
+			ContentEncodingSnappy,
+			ContentEncodingLZ4:
+			return encoding
+		}
+	}
+	switch {
+	case bytes.HasPrefix(msg.Value, gzipMagic):
+		return ContentEncodingGzip
+	case bytes.HasPrefix(msg.Value, zstdMagic):
+		return ContentEncodingZstd
+	case bytes.HasPrefix(msg.Value, lz4Magic):
+		return ContentEncodingLZ4
+	case bytes.HasPrefix(msg.Value, snappyFramedMagic),
+		bytes.HasPrefix(msg.Value, snappyXerialMagic):
+		return ContentEncodingSnappy
+	}
+	return ContentEncodingIdentity
+}
+
+// Decompress returns the value decompressed with the encoding. It fails with
+// ErrDecompressedValueTooLarge instead of decompressing more than maxBytes.
+func Decompress(
+	ctx context.Context,
+	encoding ContentEncoding,
+	value []byte,
+	maxBytes int,
+) ([]byte, error) {
+	switch encoding {
+	case ContentEncodingIdentity:
+		return value, nil
+	case ContentEncodingGzip:
+		reader, err := gzip.NewReader(bytes.NewReader(value))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, "create gzip reader failed")
+		}
+		defer reader.Close()
+		return readCapped(ctx, reader, maxBytes)
+	case ContentEncodingZstd:
+		// the window is a power of two, frames of values up to maxBytes need at most
+		// twice the size
+		decoder, err := zstd.NewReader(
+			bytes.NewReader(value),
+			zstd.WithDecoderConcurrency(1),
+			zstd.WithDecoderMaxWindow(uint64(max(2*maxBytes, zstd.MinWindowSize))),
+		)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, "create zstd reader failed")
+		}
+		defer decoder.Close()
+		return readCapped(ctx, decoder, maxBytes)
+	case ContentEncodingLZ4:
+		return readCapped(ctx, lz4.NewReader(bytes.NewReader(value)), maxBytes)
+	case ContentEncodingSnappy:
+		switch {
+		case bytes.HasPrefix(value, snappyFramedMagic):
+			return readCapped(ctx, snappy.NewReader(bytes.NewReader(value)), maxBytes)
+		case bytes.HasPrefix(value, snappyXerialMagic):
+			return decodeSnappyXerial(ctx, value, maxBytes)
+		default:
+			return decodeSnappyBlock(ctx, nil, value, maxBytes)
+		}
+	default:
+		return nil, errors.Errorf(ctx, "unknown content encoding '%s'", encoding)
+	}
+}
+
+// readCapped reads at most one byte more than maxBytes to tell a value of exactly
+// maxBytes from a larger one.
+func readCapped(ctx context.Context, reader io.Reader, maxBytes int) ([]byte, error) {
+	result, err := io.ReadAll(io.LimitReader(reader, int64(maxBytes)+1))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, "decompress value failed")
+	}
+	if len(result) > maxBytes {
+		return nil, errors.Wrapf(ctx, ErrDecompressedValueTooLarge, "max %d bytes", maxBytes)
+	}
+	return result, nil
+}
+
+// decodeSnappyBlock appends the decoded block to dst, checking the decoded length
+// stored in the block before allocating.
+func decodeSnappyBlock(
+	ctx context.Context,
+	dst []byte,
+	block []byte,
+	maxBytes int,
+) ([]byte, error) {
+	length, err := snappy.DecodedLen(block)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, "decode snappy length failed")
+	}
+	if len(dst)+length > maxBytes {
+		return nil, errors.Wrapf(ctx, ErrDecompressedValueTooLarge, "max %d bytes", maxBytes)
+	}
+	decoded, err := snappy.Decode(nil, block)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, "decode snappy failed")
+	}
+	return append(dst, decoded...), nil
+}
+
+// decodeSnappyXerial decodes the framing of the Java snappy library: a 16 byte
+// header followed by blocks with a 4 byte big endian length.
+func decodeSnappyXerial(ctx context.Context, value []byte, maxBytes int) ([]byte, error) {
+	const headerLength = 16
+	if len(value) < headerLength {
+		return nil, errors.New(ctx, "snappy xerial header incomplete")
+	}
+	var result []byte
+	for pos := headerLength; pos < len(value); {
+		if pos+4 > len(value) {
+			return nil, errors.New(ctx, "snappy xerial block length incomplete")
+		}
+		length := int(binary.BigEndian.Uint32(value[pos : pos+4]))
+		pos += 4
+		if length > len(value)-pos {
+			return nil, errors.New(ctx, "snappy xerial block incomplete")
+		}
+		var err error
+		result, err = decodeSnappyBlock(ctx, result, value[pos:pos+length], maxBytes)
+		if err != nil {
+			return nil, err
+		}
+		pos += length
+	}
+	return result, nil
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+
+	"github.com/IBM/sarama"
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/snappy/xerial"
+	"github.com/klauspost/compress/zstd"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pierrec/lz4/v4"
+
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("ContentEncoding", func() {
+	var ctx context.Context
+	var value []byte
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		value = []byte(`{"id":"order-1","amount":42}`)
+	})
+
+	DescribeTable(
+		"ValueContentEncoding",
+		func(compress func([]byte) []byte, headers []*sarama.RecordHeader, expected pkg.ContentEncoding) {
+			msg := &sarama.ConsumerMessage{
+				Headers: headers,
+				Value:   compress(value),
+			}
+			Expect(pkg.ValueContentEncoding(msg)).To(Equal(expected))
+		},
+		Entry("json", identityValue, nil, pkg.ContentEncodingIdentity),
+		Entry("gzip", gzipValue, nil, pkg.ContentEncodingGzip),
+		Entry("zstd", zstdValue, nil, pkg.ContentEncodingZstd),
+		Entry("lz4", lz4Value, nil, pkg.ContentEncodingLZ4),
+		Entry("framed snappy", snappyFramedValue, nil, pkg.ContentEncodingSnappy),
+		Entry("xerial snappy", snappyXerialValue, nil, pkg.ContentEncodingSnappy),
+		Entry("snappy block without header", snappyBlockValue, nil, pkg.ContentEncodingIdentity),
+		Entry(
+			"snappy block with header",
+			snappyBlockValue,
+			[]*sarama.RecordHeader{{Key: []byte("content-encoding"), Value: []byte("snappy")}},
+			pkg.ContentEncodingSnappy,
+		),
+		Entry(
+			"header in other case",
+			gzipValue,
+			[]*sarama.RecordHeader{{Key: []byte("Content-Encoding"), Value: []byte("GZIP")}},
+			pkg.ContentEncodingGzip,
+		),
+		Entry(
+			"identity header",
+			gzipValue,
+			[]*sarama.RecordHeader{{Key: []byte("content-encoding"), Value: []byte("identity")}},
+			pkg.ContentEncodingIdentity,
+		),
+		Entry(
+			"unknown header",
+			gzipValue,
+			[]*sarama.RecordHeader{{Key: []byte("content-encoding"), Value: []byte("br")}},
+			pkg.ContentEncodingGzip,
+		),
+	)
+
+	DescribeTable("Decompress",
+		func(compress func([]byte) []byte, encoding pkg.ContentEncoding) {
+			result, err := pkg.Decompress(ctx, encoding, compress(value), 1024)
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(value))
+		},
+		Entry("identity", identityValue, pkg.ContentEncodingIdentity),
+		Entry("gzip", gzipValue, pkg.ContentEncodingGzip),
+		Entry("zstd", zstdValue, pkg.ContentEncodingZstd),
+		Entry("lz4", lz4Value, pkg.ContentEncodingLZ4),
+		Entry("framed snappy", snappyFramedValue, pkg.ContentEncodingSnappy),
+		Entry("xerial snappy", snappyXerialValue, pkg.ContentEncodingSnappy),
+		Entry("snappy block", snappyBlockValue, pkg.ContentEncodingSnappy),
+	)
+
+	DescribeTable("Decompress larger than max bytes",
+		func(compress func([]byte) []byte, encoding pkg.ContentEncoding) {
+			large := bytes.Repeat([]byte("a"), 1025)
+			_, err := pkg.Decompress(ctx, encoding, compress(large), 1024)
+			Expect(errors.Is(err, pkg.ErrDecompressedValueTooLarge)).To(BeTrue())
+
+			result, err := pkg.Decompress(ctx, encoding, compress(large[:1024]), 1024)
+			Expect(err).To(BeNil())
+			Expect(result).To(HaveLen(1024))
+		},
+		Entry("gzip", gzipValue, pkg.ContentEncodingGzip),
+		Entry("zstd", zstdValue, pkg.ContentEncodingZstd),
+		Entry("lz4", lz4Value, pkg.ContentEncodingLZ4),
+		Entry("framed snappy", snappyFramedValue, pkg.ContentEncodingSnappy),
+		Entry("xerial snappy", snappyXerialValue, pkg.ContentEncodingSnappy),
+		Entry("snappy block", snappyBlockValue, pkg.ContentEncodingSnappy),
+	)
+
+	It("returns error for corrupt value", func() {
+		_, err := pkg.Decompress(
+			ctx,
+			pkg.ContentEncodingZstd,
+			[]byte{0x28, 0xb5, 0x2f, 0xfd, 0x00},
+			1024,
+		)
+		Expect(err).NotTo(BeNil())
+	})
+})
+
+func identityValue(value []byte) []byte {
+	return value
+}
+
+func gzipValue(value []byte) []byte {
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	_, err := writer.Write(value)
+	Expect(err).To(BeNil())
+	Expect(writer.Close()).To(Succeed())
+	return buf.Bytes()
+}
+
+func zstdValue(value []byte) []byte {
+	encoder, err := zstd.NewWriter(nil)
+	Expect(err).To(BeNil())
+	defer encoder.Close()
+	return encoder.EncodeAll(value, nil)
+}
+
+func lz4Value(value []byte) []byte {
+	var buf bytes.Buffer
+	writer := lz4.NewWriter(&buf)
+	_, err := writer.Write(value)
+	Expect(err).To(BeNil())
+	Expect(writer.Close()).To(Succeed())
+	return buf.Bytes()
+}
+
+func snappyFramedValue(value []byte) []byte {
+	var buf bytes.Buffer
+	writer := snappy.NewBufferedWriter(&buf)
+	_, err := writer.Write(value)
+	Expect(err).To(BeNil())
+	Expect(writer.Close()).To(Succeed())
+	return buf.Bytes()
+}
+
+func snappyXerialValue(value []byte) []byte {
+	return xerial.Encode(nil, value)
+}
+
+func snappyBlockValue(value []byte) []byte {
+	return snappy.Encode(nil, value)
+}
@@ -15,19 +15,36 @@ import (
 	"github.com/golang/glog"
 )
 
+// ConversionFailureReason tells why a message could not be converted.
+type ConversionFailureReason string
+
+const (
+	// ConversionFailureReasonInvalidJSON means the value is not JSON, the record contains a preview.
+	ConversionFailureReasonInvalidJSON ConversionFailureReason = "invalidJSON"
+	// ConversionFailureReasonDecompression means the compressed value could not be
+	// decompressed, the record contains a preview of the compressed value.
+	ConversionFailureReasonDecompression ConversionFailureReason = "decompression"
+	// ConversionFailureReasonError means the converter returned an error.
+	ConversionFailureReasonError ConversionFailureReason = "error"
+)
+
 //counterfeiter:generate -o ../mocks/converter.go --fake-name Converter . Converter
 type Converter interface {
 	Convert(ctx context.Context, msg *sarama.ConsumerMessage) (*Record, error)
 }
 
-func NewConverter(errorPreviewContentLength int) Converter {
+// NewConverter returns a converter decompressing values up to maxDecompressedBytes.
+// If maxDecompressedBytes is 0, compressed values are not decompressed.
+func NewConverter(errorPreviewContentLength int, maxDecompressedBytes int) Converter {
 	return &converter{
 		errorPreviewContentLength: errorPreviewContentLength,
+		maxDecompressedBytes:      maxDecompressedBytes,
 	}
 }
 
 type converter struct {
 	errorPreviewContentLength int
+	maxDecompressedBytes      int
 }
 
 // Convert transforms a Sarama consumer message into a Record.
@@ -41,6 +58,10 @@ type converter struct {
 //
 // The preview fields are limited by errorPreviewContentLength to prevent memory exhaustion
 // from large malformed messages. If errorPreviewContentLength is -1, no limit is applied.
+//
+// A value compressed by the producer (see ValueContentEncoding) is decompressed before
+// unmarshaling and the record gets its content encoding. If decompression fails, the
+// error map contains the preview of the compressed value.
 func (c *converter) Convert(ctx context.Context, msg *sarama.ConsumerMessage) (*Record, error) {
 	record := Record{
 		Key:       string(msg.Key),
@@ -49,20 +70,44 @@ func (c *converter) Convert(ctx context.Context, msg *sarama.ConsumerMessage) (*
 		Topic:     libkafka.Topic(msg.Topic),
 		Header:    libkafka.ParseHeader(msg.Headers),
 	}
-	if len(msg.Value) != 0 {
-		if err := json.Unmarshal(msg.Value, &record.Value); err != nil {
-			glog.V(4).Infof("unmarshal json failed: %v", err)
-			previewLength := len(msg.Value)
-			if c.errorPreviewContentLength >= 0 {
-				previewLength = min(c.errorPreviewContentLength, len(msg.Value))
-			}
-			record.Value = map[string]interface{}{
-				"error":         fmt.Sprintf("unmarshal value as JSON failed: %v", err),
-				"valueLength":   len(msg.Value),
-				"previewBase64": base64.StdEncoding.EncodeToString(msg.Value[:previewLength]),
-				"previewHex":    fmt.Sprintf("%x", msg.Value[:previewLength]),
+	if len(msg.Value) == 0 {
+		return &record, nil
+	}
+	value := msg.Value
+	if c.maxDecompressedBytes > 0 {
+		if encoding := ValueContentEncoding(msg); encoding != ContentEncodingIdentity {
+			record.ContentEncoding = encoding
+			decompressed, err := Decompress(ctx, encoding, msg.Value, c.maxDecompressedBytes)
+			if err != nil {
+				glog.V(4).Infof("decompress %s value failed: %v", encoding, err)
+				record.valueDecodeFailure = ConversionFailureReasonDecompression
+				record.Value = c.errorValue(
+					fmt.Sprintf("decompress %s value failed: %v", encoding, err),
+					msg.Value,
+				)
+				return &record, nil
 			}
+			value = decompressed
+			record.valueDecompressed = true
 		}
 	}
+	if err := json.Unmarshal(value, &record.Value); err != nil {
+		glog.V(4).Infof("unmarshal json failed: %v", err)
+		record.valueDecodeFailure = ConversionFailureReasonInvalidJSON
+		record.Value = c.errorValue(fmt.Sprintf("unmarshal value as JSON failed: %v", err), value)
+	}
 	return &record, nil
 }
+
+func (c *converter) errorValue(message string, value []byte) map[string]interface{} {
+	previewLength := len(value)
+	if c.errorPreviewContentLength >= 0 {
+		previewLength = min(c.errorPreviewContentLength, len(value))
+	}
+	return map[string]interface{}{
+		"error":         message,
+		"valueLength":   len(value),
+		"previewBase64": base64.StdEncoding.EncodeToString(value[:previewLength]),
+		"previewHex":    fmt.Sprintf("%x", value[:previewLength]),
+	}
+}
@@ -5,9 +5,11 @@
 package pkg_test
 
 import (
+	"bytes"
 	"context"
 
 	"github.com/IBM/sarama"
+	"github.com/klauspost/compress/snappy"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -23,7 +25,7 @@ var _ = Describe("Converter", func() {
 
 	BeforeEach(func() {
 		ctx = context.Background()
-		converter = pkg.NewConverter(100)
+		converter = pkg.NewConverter(100, 1024)
 		msg = &sarama.ConsumerMessage{
 			Headers: []*sarama.RecordHeader{
 				{
@@ -330,10 +332,97 @@ var _ = Describe("Converter", func() {
 			})
 		})
 
+		Context("with gzip compressed value", func() {
+			BeforeEach(func() {
+				msg.Value = gzipValue([]byte(`{"a":"b"}`))
+			})
+
+			It("returns the decompressed value", func() {
+				Expect(err).To(BeNil())
+				Expect(record.Value).To(HaveKeyWithValue("a", "b"))
+				Expect(record.ContentEncoding).To(Equal(pkg.ContentEncodingGzip))
+			})
+		})
+
+		Context("with snappy block and content-encoding header", func() {
+			BeforeEach(func() {
+				msg.Headers = []*sarama.RecordHeader{
+					{Key: []byte("Content-Encoding"), Value: []byte("snappy")},
+				}
+				msg.Value = snappy.Encode(nil, []byte(`{"a":"b"}`))
+			})
+
+			It("returns the decompressed value", func() {
+				Expect(err).To(BeNil())
+				Expect(record.Value).To(HaveKeyWithValue("a", "b"))
+				Expect(record.ContentEncoding).To(Equal(pkg.ContentEncodingSnappy))
+			})
+		})
+
+		Context("with compressed value larger than max decompressed bytes", func() {
+			BeforeEach(func() {
+				msg.Value = gzipValue(bytes.Repeat([]byte(" "), 2048))
+			})
+
+			It("returns the compressed preview", func() {
+				Expect(err).To(BeNil())
+				Expect(record.ContentEncoding).To(Equal(pkg.ContentEncodingGzip))
+				errorMap, ok := record.Value.(map[string]interface{})
+				Expect(ok).To(BeTrue())
+				Expect(errorMap["error"]).To(ContainSubstring("decompress gzip value failed"))
+				Expect(errorMap["error"]).To(ContainSubstring("decompressed value too large"))
+				Expect(errorMap["valueLength"]).To(Equal(len(msg.Value)))
+			})
+		})
+
+		Context("with corrupt compressed value", func() {
+			BeforeEach(func() {
+				msg.Value = []byte{0x1f, 0x8b, 0x00, 0x01}
+			})
+
+			It("returns the compressed preview", func() {
+				Expect(err).To(BeNil())
+				errorMap, ok := record.Value.(map[string]interface{})
+				Expect(ok).To(BeTrue())
+				Expect(errorMap["error"]).To(ContainSubstring("decompress gzip value failed"))
+				Expect(errorMap["previewHex"]).To(Equal("1f8b0001"))
+			})
+		})
+
+		Context("with compressed value that is no JSON", func() {
+			BeforeEach(func() {
+				msg.Value = gzipValue([]byte("banana"))
+			})
+
+			It("returns the decompressed preview", func() {
+				Expect(err).To(BeNil())
+				Expect(record.ContentEncoding).To(Equal(pkg.ContentEncodingGzip))
+				errorMap, ok := record.Value.(map[string]interface{})
+				Expect(ok).To(BeTrue())
+				Expect(errorMap["error"]).To(ContainSubstring("unmarshal value as JSON failed:"))
+				Expect(errorMap["previewBase64"]).To(Equal("YmFuYW5h"))
+			})
+		})
+
+		Context("with decompression disabled", func() {
+			BeforeEach(func() {
+				converter = pkg.NewConverter(100, 0)
+				msg.Value = gzipValue([]byte(`{"a":"b"}`))
+			})
+
+			It("returns the compressed preview", func() {
+				Expect(err).To(BeNil())
+				Expect(record.ContentEncoding).To(BeEmpty())
+				errorMap, ok := record.Value.(map[string]interface{})
+				Expect(ok).To(BeTrue())
+				Expect(errorMap["error"]).To(ContainSubstring("unmarshal value as JSON failed:"))
+			})
+		})
+
 		Context("with configurable preview length", func() {
 			Context("with preview length of 10", func() {
 				BeforeEach(func() {
-					converter = pkg.NewConverter(10)
+					converter = pkg.NewConverter(10, 1024)
 					msg.Value = make([]byte, 50)
 					for i := range msg.Value {
 						msg.Value[i] = 'x'
@@ -351,7 +440,7 @@ var _ = Describe("Converter", func() {
 
 			Context("with preview length of -1 (unlimited)", func() {
 				BeforeEach(func() {
-					converter = pkg.NewConverter(-1)
+					converter = pkg.NewConverter(-1, 1024)
 					msg.Value = make([]byte, 200)
 					for i := range msg.Value {
 						msg.Value[i] = 'y'
@@ -369,7 +458,7 @@ var _ = Describe("Converter", func() {
 
 			Context("with preview length of 0", func() {
 				BeforeEach(func() {
-					converter = pkg.NewConverter(0)
+					converter = pkg.NewConverter(0, 1024)
 					msg.Value = []byte("test")
 				})
 
@@ -385,7 +474,7 @@ var _ = Describe("Converter", func() {
 
 			Context("with preview length larger than value", func() {
 				BeforeEach(func() {
-					converter = pkg.NewConverter(1000)
+					converter = pkg.NewConverter(1000, 1024)
 					msg.Value = []byte("short")
 				})
 
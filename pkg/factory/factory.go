@@ -5,11 +5,13 @@
 package factory
 
 import (
+	"context"
 	"net/http"
+	"time"
 
+	"github.com/IBM/sarama"
 	libhttp "github.com/bborbe/http"
 	libkafka "github.com/bborbe/kafka"
-	"github.com/bborbe/log"
 	"github.com/bborbe/sentry"
 
 	"github.com/bborbe/kafka-topic-reader/pkg"
@@ -18,16 +20,251 @@ import (
 func CreateReadHandler(
 	sentryClient sentry.Client,
 	saramaClient libkafka.SaramaClient,
+	clusterAdmin sarama.ClusterAdmin,
+	consumerPool pkg.ConsumerPool,
+	messageCache pkg.MessageCache,
+	offsetIndex pkg.OffsetIndex,
+	redactor pkg.Redactor,
+	authorizer pkg.Authorizer,
+	cluster pkg.ClusterName,
+	concurrencyLimiter pkg.ConcurrencyLimiter,
+	limitMetrics pkg.LimitMetrics,
+	metrics pkg.Metrics,
+	readLimits pkg.ReadLimits,
+	fetchLimits pkg.FetchLimits,
+	conversionWorkers int,
 	errorPreviewContentLength int,
+	maxDecompressedBytes int,
 ) http.Handler {
 	return libhttp.NewErrorHandler(
 		pkg.NewHandler(
-			pkg.NewChangesProvider(
-				sentryClient,
-				saramaClient,
-				pkg.NewConverter(errorPreviewContentLength),
-				log.DefaultSamplerFactory,
+			pkg.NewConcurrencyLimitedChangesProvider(
+				pkg.NewChangesProvider(
+					sentryClient,
+					saramaClient,
+					pkg.NewTracingConverter(
+						pkg.NewConverter(errorPreviewContentLength, maxDecompressedBytes),
+					),
+					redactor,
+					metrics,
+					consumerPool,
+					messageCache,
+					conversionWorkers,
+				),
+				concurrencyLimiter,
+				limitMetrics,
 			),
+			pkg.NewAuthorizedConsumerGroupsProvider(
+				pkg.NewConsumerGroupsProvider(saramaClient, clusterAdmin),
+				authorizer,
+				cluster,
+			),
+			pkg.NewTimeOffsetProvider(saramaClient, offsetIndex),
+			metrics,
+			readLimits,
+			fetchLimits,
+		),
+	)
+}
+
+func CreateTopicsHandler(
+	clusterAdmin sarama.ClusterAdmin,
+	authorizer pkg.Authorizer,
+	cluster pkg.ClusterName,
+) http.Handler {
+	return libhttp.NewErrorHandler(
+		pkg.NewTopicsHandler(
+			pkg.NewAuthorizedTopicsProvider(
+				pkg.NewTopicsProvider(clusterAdmin),
+				authorizer,
+				cluster,
+			),
+		),
+	)
+}
+
+func CreatePartitionsHandler(
+	saramaClient libkafka.SaramaClient,
+) http.Handler {
+	return libhttp.NewErrorHandler(
+		pkg.NewPartitionsHandler(
+			pkg.NewPartitionsProvider(saramaClient),
+		),
+	)
+}
+
+func CreateConsumerGroupsHandler(
+	saramaClient libkafka.SaramaClient,
+	clusterAdmin sarama.ClusterAdmin,
+	authorizer pkg.Authorizer,
+	cluster pkg.ClusterName,
+) http.Handler {
+	return libhttp.NewErrorHandler(
+		pkg.NewConsumerGroupsHandler(
+			pkg.NewAuthorizedConsumerGroupsProvider(
+				pkg.NewConsumerGroupsProvider(saramaClient, clusterAdmin),
+				authorizer,
+				cluster,
+			),
+		),
+	)
+}
+
+func CreateConsumerGroupHandler(
+	saramaClient libkafka.SaramaClient,
+	clusterAdmin sarama.ClusterAdmin,
+	authorizer pkg.Authorizer,
+	cluster pkg.ClusterName,
+) http.Handler {
+	return libhttp.NewErrorHandler(
+		pkg.NewConsumerGroupHandler(
+			pkg.NewAuthorizedConsumerGroupsProvider(
+				pkg.NewConsumerGroupsProvider(saramaClient, clusterAdmin),
+				authorizer,
+				cluster,
+			),
+		),
+	)
+}
+
+func CreateTopicConfigHandler(
+	clusterAdmin sarama.ClusterAdmin,
+) http.Handler {
+	return libhttp.NewErrorHandler(
+		pkg.NewTopicConfigHandler(
+			pkg.NewTopicConfigProvider(clusterAdmin),
+		),
+	)
+}
+
+func CreateSearchManager(
+	ctx context.Context,
+	sentryClient sentry.Client,
+	saramaClient libkafka.SaramaClient,
+	consumerPool pkg.ConsumerPool,
+	messageCache pkg.MessageCache,
+	redactor pkg.Redactor,
+	concurrencyLimiter pkg.ConcurrencyLimiter,
+	limitMetrics pkg.LimitMetrics,
+	metrics pkg.Metrics,
+	createResults pkg.SearchResultsFactory,
+	conversionWorkers int,
+	errorPreviewContentLength int,
+	maxDecompressedBytes int,
+	maxRunning int,
+	maxRunningPerOwner int,
+	maxMatches uint64,
+	timeout time.Duration,
+	retention time.Duration,
+) pkg.SearchManager {
+	return pkg.NewSearchManager(
+		ctx,
+		pkg.NewScanner(
+			sentryClient,
+			saramaClient,
+			pkg.NewTracingConverter(
+				pkg.NewConverter(errorPreviewContentLength, maxDecompressedBytes),
+			),
+			redactor,
+			metrics,
+			consumerPool,
+			messageCache,
+			conversionWorkers,
 		),
+		pkg.NewPartitionsProvider(saramaClient),
+		createResults,
+		concurrencyLimiter,
+		limitMetrics,
+		maxRunning,
+		maxRunningPerOwner,
+		maxMatches,
+		timeout,
+		retention,
+	)
+}
+
+func CreateSearchCreateHandler(
+	searchManager pkg.SearchManager,
+	fetchLimits pkg.FetchLimits,
+) http.Handler {
+	return libhttp.NewErrorHandler(
+		pkg.NewSearchCreateHandler(searchManager, fetchLimits),
+	)
+}
+
+func CreateSearchHandler(
+	searchManager pkg.SearchManager,
+	readLimits pkg.ReadLimits,
+) http.Handler {
+	return libhttp.NewErrorHandler(
+		pkg.NewSearchHandler(searchManager, readLimits),
+	)
+}
+
+func CreateSearchCancelHandler(
+	searchManager pkg.SearchManager,
+) http.Handler {
+	return libhttp.NewErrorHandler(
+		pkg.NewSearchCancelHandler(searchManager),
+	)
+}
+
+func CreateIndexer(
+	saramaClient libkafka.SaramaClient,
+	fetchConfig pkg.FetchConfig,
+	offsetIndex pkg.OffsetIndex,
+	indexMetrics pkg.IndexMetrics,
+	topics []libkafka.Topic,
+	batchSize int,
+	flushInterval time.Duration,
+) pkg.Indexer {
+	return pkg.NewIndexer(
+		saramaClient,
+		// the indexer consumes with the default fetch config only
+		pkg.NewSaramaConsumerFactory(saramaClient, fetchConfig, 0),
+		fetchConfig,
+		offsetIndex,
+		indexMetrics,
+		topics,
+		batchSize,
+		flushInterval,
+	)
+}
+
+func CreateKeyOffsetsHandler(
+	indexer pkg.Indexer,
+	offsetIndex pkg.OffsetIndex,
+	redactor pkg.Redactor,
+	readLimits pkg.ReadLimits,
+) http.Handler {
+	return libhttp.NewErrorHandler(
+		pkg.NewKeyOffsetsHandler(indexer, offsetIndex, redactor, readLimits),
+	)
+}
+
+func CreateIndexHandler(
+	indexer pkg.Indexer,
+	offsetIndex pkg.OffsetIndex,
+	authorizer pkg.Authorizer,
+	cluster pkg.ClusterName,
+) http.Handler {
+	return libhttp.NewErrorHandler(
+		pkg.NewIndexHandler(indexer, offsetIndex, authorizer, cluster),
+	)
+}
+
+func CreateIndexRebuildHandler(
+	indexer pkg.Indexer,
+) http.Handler {
+	return libhttp.NewErrorHandler(
+		pkg.NewIndexRebuildHandler(indexer),
+	)
+}
+
+func CreateIndexCompactHandler(
+	indexer pkg.Indexer,
+) http.Handler {
+	return libhttp.NewErrorHandler(
+		pkg.NewIndexCompactHandler(indexer),
 	)
 }
@@ -5,23 +5,63 @@
 package factory_test
 
 import (
+	"context"
 	"net/http"
+	"time"
 
+	libkafka "github.com/bborbe/kafka"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/bborbe/kafka-topic-reader/pkg"
 	"github.com/bborbe/kafka-topic-reader/pkg/factory"
 )
 
 var _ = Describe("Factory", func() {
 	Context("CreateReadHandler", func() {
 		It("returns a non-nil http.Handler", func() {
-			handler := factory.CreateReadHandler(nil, nil, 100)
+			handler := factory.CreateReadHandler(
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				pkg.NewAllowAllAuthorizer(),
+				"default",
+				nil,
+				nil,
+				nil,
+				pkg.DefaultReadLimits(),
+				pkg.DefaultFetchLimits(),
+				1,
+				100,
+				10485760,
+			)
 			Expect(handler).NotTo(BeNil())
 		})
 
 		It("implements http.Handler interface", func() {
-			handler := factory.CreateReadHandler(nil, nil, 100)
+			handler := factory.CreateReadHandler(
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				pkg.NewAllowAllAuthorizer(),
+				"default",
+				nil,
+				nil,
+				nil,
+				pkg.DefaultReadLimits(),
+				pkg.DefaultFetchLimits(),
+				1,
+				100,
+				10485760,
+			)
 			// Verify it implements http.Handler by using it as one
 			var _ http.Handler = handler //nolint:staticcheck
 			Expect(handler).NotTo(BeNil())
@@ -30,7 +70,161 @@ var _ = Describe("Factory", func() {
 		It("creates handler with factory pattern", func() {
 			// Test that the factory can create the handler even with nil dependencies
 			// This verifies the wiring is correct
-			handler := factory.CreateReadHandler(nil, nil, 100)
+			handler := factory.CreateReadHandler(
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				pkg.NewAllowAllAuthorizer(),
+				"default",
+				nil,
+				nil,
+				nil,
+				pkg.DefaultReadLimits(),
+				pkg.DefaultFetchLimits(),
+				1,
+				100,
+				10485760,
+			)
+			Expect(handler).NotTo(BeNil())
+		})
+	})
+
+	Context("CreateTopicsHandler", func() {
+		It("returns a non-nil http.Handler", func() {
+			handler := factory.CreateTopicsHandler(nil, pkg.NewAllowAllAuthorizer(), "default")
+			Expect(handler).NotTo(BeNil())
+		})
+	})
+
+	Context("CreatePartitionsHandler", func() {
+		It("returns a non-nil http.Handler", func() {
+			handler := factory.CreatePartitionsHandler(nil)
+			Expect(handler).NotTo(BeNil())
+		})
+	})
+
+	Context("CreateConsumerGroupsHandler", func() {
+		It("returns a non-nil http.Handler", func() {
+			handler := factory.CreateConsumerGroupsHandler(
+				nil,
+				nil,
+				pkg.NewAllowAllAuthorizer(),
+				"default",
+			)
+			Expect(handler).NotTo(BeNil())
+		})
+	})
+
+	Context("CreateConsumerGroupHandler", func() {
+		It("returns a non-nil http.Handler", func() {
+			handler := factory.CreateConsumerGroupHandler(
+				nil,
+				nil,
+				pkg.NewAllowAllAuthorizer(),
+				"default",
+			)
+			Expect(handler).NotTo(BeNil())
+		})
+	})
+
+	Context("CreateTopicConfigHandler", func() {
+		It("returns a non-nil http.Handler", func() {
+			handler := factory.CreateTopicConfigHandler(nil)
+			Expect(handler).NotTo(BeNil())
+		})
+	})
+
+	Context("CreateSearchManager", func() {
+		It("returns a non-nil search manager", func() {
+			searchManager := factory.CreateSearchManager(
+				context.Background(),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				pkg.NewMemorySearchResultsFactory(),
+				1,
+				100,
+				10485760,
+				4,
+				2,
+				10000,
+				time.Hour,
+				time.Hour,
+			)
+			Expect(searchManager).NotTo(BeNil())
+		})
+	})
+
+	Context("CreateSearchCreateHandler", func() {
+		It("returns a non-nil http.Handler", func() {
+			handler := factory.CreateSearchCreateHandler(nil, pkg.DefaultFetchLimits())
+			Expect(handler).NotTo(BeNil())
+		})
+	})
+
+	Context("CreateSearchHandler", func() {
+		It("returns a non-nil http.Handler", func() {
+			handler := factory.CreateSearchHandler(nil, pkg.DefaultReadLimits())
+			Expect(handler).NotTo(BeNil())
+		})
+	})
+
+	Context("CreateSearchCancelHandler", func() {
+		It("returns a non-nil http.Handler", func() {
+			handler := factory.CreateSearchCancelHandler(nil)
+			Expect(handler).NotTo(BeNil())
+		})
+	})
+
+	Context("CreateIndexer", func() {
+		It("returns a non-nil indexer", func() {
+			indexer := factory.CreateIndexer(
+				nil,
+				pkg.DefaultFetchConfig(),
+				nil,
+				nil,
+				[]libkafka.Topic{"orders"},
+				1000,
+				time.Second,
+			)
+			Expect(indexer).NotTo(BeNil())
+			Expect(indexer.Indexed("orders")).To(BeTrue())
+		})
+	})
+
+	Context("CreateKeyOffsetsHandler", func() {
+		It("returns a non-nil http.Handler", func() {
+			handler := factory.CreateKeyOffsetsHandler(nil, nil, nil, pkg.DefaultReadLimits())
+			Expect(handler).NotTo(BeNil())
+		})
+	})
+
+	Context("CreateIndexHandler", func() {
+		It("returns a non-nil http.Handler", func() {
+			handler := factory.CreateIndexHandler(nil, nil, nil, "default")
+			Expect(handler).NotTo(BeNil())
+		})
+	})
+
+	Context("CreateIndexRebuildHandler", func() {
+		It("returns a non-nil http.Handler", func() {
+			handler := factory.CreateIndexRebuildHandler(nil)
+			Expect(handler).NotTo(BeNil())
+		})
+	})
+
+	Context("CreateIndexCompactHandler", func() {
+		It("returns a non-nil http.Handler", func() {
+			handler := factory.CreateIndexCompactHandler(nil)
 			Expect(handler).NotTo(BeNil())
 		})
 	})
@@ -0,0 +1,282 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/bborbe/errors"
+	libkafka "github.com/bborbe/kafka"
+)
+
+// Isolation selects which messages of transactional producers a read returns.
+type Isolation string
+
+const (
+	// IsolationReadUncommitted returns all messages up to the high watermark, including
+	// those of open and aborted transactions.
+	IsolationReadUncommitted Isolation = "read_uncommitted"
+	// IsolationReadCommitted returns only committed messages and stops at the last
+	// stable offset, the first offset of the oldest open transaction.
+	IsolationReadCommitted Isolation = "read_committed"
+)
+
+func (i Isolation) String() string {
+	return string(i)
+}
+
+// ParseIsolation returns an error for unknown values.
+func ParseIsolation(ctx context.Context, value string) (Isolation, error) {
+	switch isolation := Isolation(value); isolation {
+	case IsolationReadUncommitted, IsolationReadCommitted:
+		return isolation, nil
+	default:
+		return "", errors.Errorf(
+			ctx,
+			"unknown isolation %s, expected %s or %s",
+			value,
+			IsolationReadUncommitted,
+			IsolationReadCommitted,
+		)
+	}
+}
+
+// SaramaIsolationLevel returns the isolation level of the sarama consumer config.
+func (i Isolation) SaramaIsolationLevel() sarama.IsolationLevel {
+	if i == IsolationReadCommitted {
+		return sarama.ReadCommitted
+	}
+	return sarama.ReadUncommitted
+}
+
+// FetchConfig tunes the fetch requests of the partition consumers of a read.
+type FetchConfig struct {
+	// MinBytes the broker waits for before answering a fetch, at most MaxWait.
+	MinBytes int
+	// DefaultBytes fetched per partition and request.
+	DefaultBytes int
+	// MaxBytes fetched per partition and request if a message is larger than
+	// DefaultBytes, 0 is unlimited.
+	MaxBytes int
+	// MaxWait the broker waits for MinBytes.
+	MaxWait time.Duration
+	// Isolation of transactional messages.
+	Isolation Isolation
+}
+
+// DefaultFetchConfig returns the sarama defaults.
+func DefaultFetchConfig() FetchConfig {
+	return FetchConfig{
+		MinBytes:     1,
+		DefaultBytes: 1024 * 1024,
+		MaxBytes:     0,
+		MaxWait:      500 * time.Millisecond,
+		Isolation:    IsolationReadUncommitted,
+	}
+}
+
+// SaramaConfigOptions returns an option applying the fetch settings to the sarama config.
+// The bytes must fit into int32, which FetchLimits.Validate ensures.
+func (f FetchConfig) SaramaConfigOptions() libkafka.SaramaConfigOptions {
+	return func(config *sarama.Config) {
+		config.Consumer.Fetch.Min = int32(f.MinBytes)         // #nosec G115 -- validated
+		config.Consumer.Fetch.Default = int32(f.DefaultBytes) // #nosec G115 -- validated
+		config.Consumer.Fetch.Max = int32(f.MaxBytes)         // #nosec G115 -- validated
+		config.Consumer.MaxWaitTime = f.MaxWait
+		config.Consumer.IsolationLevel = f.Isolation.SaramaIsolationLevel()
+	}
+}
+
+func (f FetchConfig) Validate(ctx context.Context) error {
+	if f.MinBytes <= 0 {
+		return errors.New(ctx, "fetch min bytes must be greater than 0")
+	}
+	if f.DefaultBytes < f.MinBytes {
+		return errors.Errorf(
+			ctx,
+			"fetch default bytes %d is less than min bytes %d",
+			f.DefaultBytes,
+			f.MinBytes,
+		)
+	}
+	if f.MaxBytes < 0 {
+		return errors.New(ctx, "fetch max bytes must not be negative")
+	}
+	if f.MaxBytes > 0 && f.MaxBytes < f.DefaultBytes {
+		return errors.Errorf(
+			ctx,
+			"fetch max bytes %d is less than default bytes %d",
+			f.MaxBytes,
+			f.DefaultBytes,
+		)
+	}
+	if f.MaxWait < time.Millisecond {
+		return errors.New(ctx, "fetch max wait must be at least 1ms")
+	}
+	if _, err := ParseIsolation(ctx, f.Isolation.String()); err != nil {
+		return errors.Wrap(ctx, err, "invalid fetch isolation")
+	}
+	return nil
+}
+
+// FetchLimits holds the fetch config of the service and bounds the overrides of a
+// request.
+type FetchLimits struct {
+	// Default is used for all values the request does not override.
+	Default FetchConfig
+	// MaxBytes caps the fetch bytes of the request.
+	MaxBytes int
+	// MaxWait caps the fetch max wait of the request.
+	MaxWait time.Duration
+}
+
+// DefaultFetchLimits returns the limits used if nothing is configured.
+func DefaultFetchLimits() FetchLimits {
+	return FetchLimits{
+		Default:  DefaultFetchConfig(),
+		MaxBytes: 64 * 1024 * 1024,
+		MaxWait:  5 * time.Second,
+	}
+}
+
+func (f FetchLimits) Validate(ctx context.Context) error {
+	if err := f.Default.Validate(ctx); err != nil {
+		return errors.Wrap(ctx, err, "validate default fetch config failed")
+	}
+	if f.MaxBytes > math.MaxInt32 {
+		return errors.Errorf(ctx, "fetch limit max bytes %d exceeds %d", f.MaxBytes, math.MaxInt32)
+	}
+	if f.MaxBytes < f.Default.DefaultBytes || f.MaxBytes < f.Default.MaxBytes {
+		return errors.Errorf(
+			ctx,
+			"fetch limit max bytes %d is less than default bytes %d or max bytes %d",
+			f.MaxBytes,
+			f.Default.DefaultBytes,
+			f.Default.MaxBytes,
+		)
+	}
+	if f.MaxWait < f.Default.MaxWait {
+		return errors.Errorf(
+			ctx,
+			"fetch limit max wait %v is less than default max wait %v",
+			f.MaxWait,
+			f.Default.MaxWait,
+		)
+	}
+	return nil
+}
+
+// parseFetchConfig overrides the default fetch config with the fetch parameters of the
+// request, capped at the fetch limits.
+func parseFetchConfig(
+	ctx context.Context,
+	req *http.Request,
+	fetchLimits FetchLimits,
+) (FetchConfig, error) {
+	result := fetchLimits.Default
+	var err error
+	result.MinBytes, err = parseFetchBytes(
+		ctx,
+		req.FormValue("fetchMinBytes"),
+		result.MinBytes,
+		fetchLimits.MaxBytes,
+	)
+	if err != nil {
+		return FetchConfig{}, errors.Wrap(ctx, err, "parse parameter fetchMinBytes failed")
+	}
+	result.DefaultBytes, err = parseFetchBytes(
+		ctx,
+		req.FormValue("fetchDefaultBytes"),
+		result.DefaultBytes,
+		fetchLimits.MaxBytes,
+	)
+	if err != nil {
+		return FetchConfig{}, errors.Wrap(ctx, err, "parse parameter fetchDefaultBytes failed")
+	}
+	result.MaxBytes, err = parseFetchBytes(
+		ctx,
+		req.FormValue("fetchMaxBytes"),
+		result.MaxBytes,
+		fetchLimits.MaxBytes,
+	)
+	if err != nil {
+		return FetchConfig{}, errors.Wrap(ctx, err, "parse parameter fetchMaxBytes failed")
+	}
+	result.MaxWait, err = parseFetchMaxWait(
+		ctx,
+		req.FormValue("fetchMaxWait"),
+		result.MaxWait,
+		fetchLimits.MaxWait,
+	)
+	if err != nil {
+		return FetchConfig{}, errors.Wrap(ctx, err, "parse parameter fetchMaxWait failed")
+	}
+	if value := req.FormValue("isolation"); value != "" {
+		result.Isolation, err = ParseIsolation(ctx, value)
+		if err != nil {
+			return FetchConfig{}, errors.Wrap(ctx, err, "parse parameter isolation failed")
+		}
+	}
+	if err := result.Validate(ctx); err != nil {
+		return FetchConfig{}, errors.Wrap(ctx, err, "invalid fetch parameters")
+	}
+	return result, nil
+}
+
+// parseFetchBytes returns defaultValue for an empty value and caps the bytes at maxBytes.
+func parseFetchBytes(
+	ctx context.Context,
+	value string,
+	defaultValue int,
+	maxBytes int,
+) (int, error) {
+	if value == "" {
+		return defaultValue, nil
+	}
+	fetchBytes, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, errors.Wrapf(ctx, err, "invalid bytes %s", value)
+	}
+	if fetchBytes <= 0 {
+		return 0, errors.Errorf(ctx, "bytes %s must be greater than 0", value)
+	}
+	if fetchBytes > maxBytes {
+		return maxBytes, nil
+	}
+	return fetchBytes, nil
+}
+
+// parseFetchMaxWait accepts a duration like 100ms or a number of milliseconds and caps
+// the wait at maxWait.
+func parseFetchMaxWait(
+	ctx context.Context,
+	value string,
+	defaultValue time.Duration,
+	maxWait time.Duration,
+) (time.Duration, error) {
+	if value == "" {
+		return defaultValue, nil
+	}
+	wait, err := time.ParseDuration(value)
+	if err != nil {
+		milliseconds, parseErr := strconv.ParseUint(value, 10, 32)
+		if parseErr != nil {
+			return 0, errors.Wrapf(ctx, err, "invalid max wait %s", value)
+		}
+		wait = time.Duration(milliseconds) * time.Millisecond
+	}
+	if wait < time.Millisecond {
+		return 0, errors.Errorf(ctx, "max wait %s must be at least 1ms", value)
+	}
+	if wait > maxWait {
+		return maxWait, nil
+	}
+	return wait, nil
+}
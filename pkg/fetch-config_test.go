@@ -0,0 +1,117 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/IBM/sarama"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("FetchConfig", func() {
+	It("applies the fetch settings to the sarama config", func() {
+		config := sarama.NewConfig()
+		pkg.FetchConfig{
+			MinBytes:     1024,
+			DefaultBytes: 4096,
+			MaxBytes:     8192,
+			MaxWait:      50 * time.Millisecond,
+			Isolation:    pkg.IsolationReadCommitted,
+		}.SaramaConfigOptions()(config)
+		Expect(config.Consumer.Fetch.Min).To(Equal(int32(1024)))
+		Expect(config.Consumer.Fetch.Default).To(Equal(int32(4096)))
+		Expect(config.Consumer.Fetch.Max).To(Equal(int32(8192)))
+		Expect(config.Consumer.MaxWaitTime).To(Equal(50 * time.Millisecond))
+		Expect(config.Consumer.IsolationLevel).To(Equal(sarama.ReadCommitted))
+		Expect(config.Validate()).To(Succeed())
+	})
+
+	It("equals the sarama defaults", func() {
+		config := sarama.NewConfig()
+		defaults := sarama.NewConfig()
+		pkg.DefaultFetchConfig().SaramaConfigOptions()(config)
+		Expect(config.Consumer.Fetch).To(Equal(defaults.Consumer.Fetch))
+		Expect(config.Consumer.MaxWaitTime).To(Equal(defaults.Consumer.MaxWaitTime))
+		Expect(config.Consumer.IsolationLevel).To(Equal(defaults.Consumer.IsolationLevel))
+	})
+})
+
+var _ = Describe("Isolation", func() {
+	DescribeTable(
+		"ParseIsolation",
+		func(value string, expected pkg.Isolation, expectError bool) {
+			isolation, err := pkg.ParseIsolation(context.Background(), value)
+			if expectError {
+				Expect(err).To(HaveOccurred())
+				return
+			}
+			Expect(err).To(BeNil())
+			Expect(isolation).To(Equal(expected))
+		},
+		Entry("read uncommitted", "read_uncommitted", pkg.IsolationReadUncommitted, false),
+		Entry("read committed", "read_committed", pkg.IsolationReadCommitted, false),
+		Entry("empty", "", pkg.Isolation(""), true),
+		Entry("unknown", "READ_COMMITTED", pkg.Isolation(""), true),
+	)
+})
+
+var _ = Describe("FetchLimits", func() {
+	DescribeTable(
+		"Validate",
+		func(update func(fetchLimits *pkg.FetchLimits), expectError bool) {
+			fetchLimits := pkg.DefaultFetchLimits()
+			update(&fetchLimits)
+			err := fetchLimits.Validate(context.Background())
+			if expectError {
+				Expect(err).To(HaveOccurred())
+			} else {
+				Expect(err).To(BeNil())
+			}
+		},
+		Entry("default", func(fetchLimits *pkg.FetchLimits) {}, false),
+		Entry("limited max bytes", func(fetchLimits *pkg.FetchLimits) {
+			fetchLimits.Default.MaxBytes = 8 * 1024 * 1024
+		}, false),
+		Entry("zero min bytes", func(fetchLimits *pkg.FetchLimits) {
+			fetchLimits.Default.MinBytes = 0
+		}, true),
+		Entry("default bytes below min bytes", func(fetchLimits *pkg.FetchLimits) {
+			fetchLimits.Default.MinBytes = 2 * 1024 * 1024
+		}, true),
+		Entry("negative max bytes", func(fetchLimits *pkg.FetchLimits) {
+			fetchLimits.Default.MaxBytes = -1
+		}, true),
+		Entry("max bytes below default bytes", func(fetchLimits *pkg.FetchLimits) {
+			fetchLimits.Default.MaxBytes = 1024
+		}, true),
+		Entry("max wait below 1ms", func(fetchLimits *pkg.FetchLimits) {
+			fetchLimits.Default.MaxWait = time.Microsecond
+		}, true),
+		Entry("read committed", func(fetchLimits *pkg.FetchLimits) {
+			fetchLimits.Default.Isolation = pkg.IsolationReadCommitted
+		}, false),
+		Entry("unknown isolation", func(fetchLimits *pkg.FetchLimits) {
+			fetchLimits.Default.Isolation = "serializable"
+		}, true),
+		Entry("limit max bytes below default bytes", func(fetchLimits *pkg.FetchLimits) {
+			fetchLimits.MaxBytes = 1024
+		}, true),
+		Entry("limit max bytes below max bytes", func(fetchLimits *pkg.FetchLimits) {
+			fetchLimits.Default.MaxBytes = 128 * 1024 * 1024
+		}, true),
+		Entry("limit max bytes above int32", func(fetchLimits *pkg.FetchLimits) {
+			fetchLimits.MaxBytes = math.MaxInt32 + 1
+		}, true),
+		Entry("limit max wait below default max wait", func(fetchLimits *pkg.FetchLimits) {
+			fetchLimits.MaxWait = 100 * time.Millisecond
+		}, true),
+	)
+})
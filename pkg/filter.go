@@ -6,6 +6,7 @@ package pkg
 
 import (
 	"bytes"
+	"encoding/json"
 
 	"github.com/IBM/sarama"
 )
@@ -25,3 +26,22 @@ func MatchesFilter(msg *sarama.ConsumerMessage, filter []byte) bool {
 	// Exact byte matching for binary data
 	return bytes.Contains(msg.Value, filter)
 }
+
+// MatchesValueFilter checks if the JSON encoding of a converted value contains the
+// filter bytes. It is used for redacted topics, so the filter only sees what the
+// response would show and can not be used to probe redacted values.
+func MatchesValueFilter(value interface{}, filter []byte) bool {
+	if len(filter) == 0 {
+		return true
+	}
+	if value == nil {
+		return false
+	}
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return false
+	}
+	return bytes.Contains(buf.Bytes(), filter)
+}
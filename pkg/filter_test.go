@@ -184,3 +184,14 @@ var _ = Describe("MatchesFilter", func() {
 		})
 	})
 })
+
+var _ = DescribeTable("MatchesValueFilter",
+	func(value interface{}, filter string, expected bool) {
+		Expect(pkg.MatchesValueFilter(value, []byte(filter))).To(Equal(expected))
+	},
+	Entry("empty filter", nil, "", true),
+	Entry("nil value", nil, "a", false),
+	Entry("matching field", map[string]interface{}{"name": "alice"}, `"name":"alice"`, true),
+	Entry("masked value", map[string]interface{}{"email": "***"}, "alice@example.com", false),
+	Entry("html not escaped", map[string]interface{}{"query": "a<b"}, "a<b", true),
+)
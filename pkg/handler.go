@@ -6,6 +6,7 @@ package pkg
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"strconv"
 	"time"
@@ -15,35 +16,78 @@ import (
 	libhttp "github.com/bborbe/http"
 	libkafka "github.com/bborbe/kafka"
 	"github.com/golang/glog"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type Page struct {
-	NextOffset *libkafka.Offset `json:"nextOffset,omitempty"`
-	Records    Records          `json:"records"`
+	NextOffset       *libkafka.Offset `json:"nextOffset,omitempty"`
+	Records          Records          `json:"records"`
+	Truncated        TruncatedReason  `json:"truncated,omitempty"`
+	Status           ReadStatus       `json:"status"`
+	HighWaterMark    libkafka.Offset  `json:"highWaterMark"`
+	LastStableOffset *libkafka.Offset `json:"lastStableOffset,omitempty"`
+	Scanned          uint64           `json:"scanned"`
+	Matched          uint64           `json:"matched"`
 }
 
 type requestParams struct {
-	topic     libkafka.Topic
-	partition libkafka.Partition
-	offset    libkafka.Offset
-	limit     uint64
-	filter    []byte
+	topic       libkafka.Topic
+	partition   libkafka.Partition
+	offset      libkafka.Offset
+	limit       uint64
+	limitCapped bool
+	timeout     time.Duration
+	filter      []byte
+	group       string
+	timestamp   *time.Time
+	fetchConfig FetchConfig
 }
 
-func parseRequestParams(ctx context.Context, req *http.Request) (*requestParams, error) {
+func parseRequestParams(
+	ctx context.Context,
+	req *http.Request,
+	readLimits ReadLimits,
+	fetchLimits FetchLimits,
+) (*requestParams, error) {
 	topic := libkafka.Topic(req.FormValue("topic"))
 	if topic == "" {
 		return nil, errors.New(ctx, "parameter topic missing")
 	}
 
-	offset, err := libkafka.ParseOffset(ctx, req.FormValue("offset"))
+	group := req.FormValue("group")
+	offsetValue := req.FormValue("offset")
+	if group != "" && offsetValue != "" {
+		return nil, errors.New(ctx, "parameter offset and group are exclusive")
+	}
+	timeValue := req.FormValue("time")
+	if timeValue != "" && (group != "" || offsetValue != "") {
+		return nil, errors.New(ctx, "parameter time is exclusive with offset and group")
+	}
+
+	timestamp, err := parseTimestamp(ctx, timeValue)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, "parse parameter time failed")
+	}
+
+	var offset libkafka.Offset
+	if group == "" && timestamp == nil {
+		parsedOffset, err := libkafka.ParseOffset(ctx, offsetValue)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, "parse parameter offset failed")
+		}
+		offset = *parsedOffset
+	}
+
+	limit, limitCapped, err := parseLimit(ctx, req.FormValue("limit"), readLimits)
 	if err != nil {
-		return nil, errors.Wrap(ctx, err, "parse parameter offset failed")
+		return nil, errors.Wrap(ctx, err, "parse parameter limit failed")
 	}
 
-	limit, err := strconv.ParseUint(req.FormValue("limit"), 10, 64)
+	timeout, err := parseTimeout(ctx, req.FormValue("timeout"), readLimits)
 	if err != nil {
-		limit = 100
+		return nil, errors.Wrap(ctx, err, "parse parameter timeout failed")
 	}
 
 	partition, err := libkafka.ParsePartition(ctx, req.FormValue("partition"))
@@ -56,69 +100,278 @@ func parseRequestParams(ctx context.Context, req *http.Request) (*requestParams,
 		return nil, errors.New(ctx, "filter parameter exceeds maximum length of 1024 bytes")
 	}
 
+	fetchConfig, err := parseFetchConfig(ctx, req, fetchLimits)
+	if err != nil {
+		return nil, err
+	}
+
 	return &requestParams{
-		topic:     topic,
-		partition: *partition,
-		offset:    *offset,
-		limit:     limit,
-		filter:    []byte(filterValue),
+		topic:       topic,
+		partition:   *partition,
+		offset:      offset,
+		limit:       limit,
+		limitCapped: limitCapped,
+		timeout:     timeout,
+		filter:      []byte(filterValue),
+		group:       group,
+		timestamp:   timestamp,
+		fetchConfig: fetchConfig,
 	}, nil
 }
 
+// parseTimestamp accepts RFC3339 or unix milliseconds, it returns nil for an empty
+// value.
+func parseTimestamp(ctx context.Context, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	timestamp, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		millis, parseErr := strconv.ParseInt(value, 10, 64)
+		if parseErr != nil {
+			return nil, errors.Wrapf(ctx, err, "invalid time %s", value)
+		}
+		timestamp = time.UnixMilli(millis)
+	}
+	return &timestamp, nil
+}
+
+// parseLimit returns the default limit for an empty or zero value and caps the limit
+// at the max limit.
+func parseLimit(ctx context.Context, value string, readLimits ReadLimits) (uint64, bool, error) {
+	if value == "" {
+		return readLimits.DefaultLimit, false, nil
+	}
+	limit, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, false, errors.Wrapf(ctx, err, "invalid limit %s", value)
+	}
+	if limit == 0 {
+		return readLimits.DefaultLimit, false, nil
+	}
+	if limit > readLimits.MaxLimit {
+		return readLimits.MaxLimit, true, nil
+	}
+	return limit, false, nil
+}
+
+// parseTimeout accepts a duration like 5s or a number of seconds and caps the timeout
+// at the max timeout.
+func parseTimeout(ctx context.Context, value string, readLimits ReadLimits) (time.Duration, error) {
+	if value == "" {
+		return readLimits.DefaultTimeout, nil
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil {
+		seconds, parseErr := strconv.ParseUint(value, 10, 32)
+		if parseErr != nil {
+			return 0, errors.Wrapf(ctx, err, "invalid timeout %s", value)
+		}
+		timeout = time.Duration(seconds) * time.Second
+	}
+	if timeout <= 0 {
+		return 0, errors.Errorf(ctx, "timeout %s must be greater than 0", value)
+	}
+	if timeout > readLimits.MaxTimeout {
+		return readLimits.MaxTimeout, nil
+	}
+	return timeout, nil
+}
+
+// resolveGroupOffset replaces the offset with the committed offset of the requested group.
+func resolveGroupOffset(
+	ctx context.Context,
+	consumerGroupsProvider ConsumerGroupsProvider,
+	params *requestParams,
+) error {
+	if params.group == "" {
+		return nil
+	}
+	offset, err := consumerGroupsProvider.CommittedOffset(
+		ctx,
+		params.group,
+		params.topic,
+		params.partition,
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, "get committed offset of group failed")
+	}
+	params.offset = *offset
+	return nil
+}
+
+// resolveTimeOffset replaces the offset with the offset of the requested time.
+func resolveTimeOffset(
+	ctx context.Context,
+	timeOffsetProvider TimeOffsetProvider,
+	params *requestParams,
+) error {
+	if params.timestamp == nil {
+		return nil
+	}
+	offset, err := timeOffsetProvider.Offset(
+		ctx,
+		params.topic,
+		params.partition,
+		*params.timestamp,
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, "get offset of time failed")
+	}
+	params.offset = offset
+	return nil
+}
+
 func fetchChangesWithRetry(
 	ctx context.Context,
 	changesProvider ChangesProvider,
 	params *requestParams,
-) (Records, error) {
-	changes, err := changesProvider.Changes(
+) (*ChangesResult, error) {
+	ctx, span := tracer.Start(ctx, "fetchChangesWithRetry")
+	defer span.End()
+
+	result, err := changesProvider.Changes(
 		ctx,
 		params.topic,
 		params.partition,
 		params.offset,
 		params.limit,
 		params.filter,
+		params.fetchConfig,
 	)
 	if err != nil {
 		if !errors.Is(err, sarama.ErrOffsetOutOfRange) {
+			recordSpanError(span, err)
 			return nil, errors.Wrap(ctx, err, "get changes failed")
 		}
 		glog.V(2).Infof("offset out of range error => fallbacktest to oldest")
-		changes, err = changesProvider.Changes(
+		span.AddEvent("offset out of range, retry from oldest")
+		result, err = changesProvider.Changes(
 			ctx,
 			params.topic,
 			params.partition,
 			libkafka.OffsetOldest,
 			params.limit,
 			params.filter,
+			params.fetchConfig,
 		)
 		if err != nil {
+			recordSpanError(span, err)
 			return nil, errors.Wrap(ctx, err, "get changes failed")
 		}
 	}
-	return changes, nil
+	return result, nil
 }
 
-func buildPage(changes Records, offset libkafka.Offset) Page {
+// truncateToMaxBytes keeps the records whose JSON fits into maxBytes, but at least one
+// record so paging always makes progress.
+func truncateToMaxBytes(records Records, maxBytes int) (Records, bool) {
+	if maxBytes <= 0 {
+		return records, false
+	}
+	var size int
+	for i, record := range records {
+		content, err := json.Marshal(record)
+		if err != nil {
+			// sending the response reports the error
+			return records, false
+		}
+		size += len(content) + 1
+		if size > maxBytes && i > 0 {
+			return records[:i], true
+		}
+	}
+	return records, false
+}
+
+func truncatedReason(
+	params *requestParams,
+	status ReadStatus,
+	bytesTruncated bool,
+) TruncatedReason {
+	switch {
+	case bytesTruncated:
+		return TruncatedReasonMaxResponseBytes
+	case status == ReadStatusTimeout:
+		return TruncatedReasonTimeout
+	case params.limitCapped && status == ReadStatusLimitReached:
+		return TruncatedReasonMaxLimit
+	default:
+		return ""
+	}
+}
+
+func buildPage(
+	result *ChangesResult,
+	records Records,
+	offset libkafka.Offset,
+) Page {
 	nextOffset := offset
-	if len(changes) > 0 {
-		nextOffset = changes[len(changes)-1].Offset + 1
+	switch {
+	case len(records) < len(result.Records):
+		// the records cut by the response size are read again
+		nextOffset = records[len(records)-1].Offset + 1
+	case result.NextOffset != nil:
+		// continue after the scanned messages, also if none matched the filter
+		nextOffset = *result.NextOffset
+	case len(records) > 0:
+		nextOffset = records[len(records)-1].Offset + 1
 	}
 	return Page{
-		Records:    changes,
-		NextOffset: &nextOffset,
+		Records:          records,
+		NextOffset:       &nextOffset,
+		Status:           result.Status,
+		HighWaterMark:    result.HighWaterMark,
+		LastStableOffset: result.LastStableOffset,
+		Scanned:          result.Scanned,
+		Matched:          result.Matched,
 	}
 }
 
 func NewHandler(
 	changesProvider ChangesProvider,
+	consumerGroupsProvider ConsumerGroupsProvider,
+	timeOffsetProvider TimeOffsetProvider,
+	metrics Metrics,
+	readLimits ReadLimits,
+	fetchLimits FetchLimits,
 ) libhttp.WithError {
 	return libhttp.WithErrorFunc(
 		func(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
-			ctx, cancel := context.WithTimeout(ctx, 15*time.Second)
+			params, err := parseRequestParams(ctx, req, readLimits, fetchLimits)
+			if err != nil {
+				return err
+			}
+
+			ctx, span := tracer.Start(
+				ctx,
+				"Handler.read",
+				trace.WithAttributes(
+					attributeTopic.String(params.topic.String()),
+					attributePartition.Int64(int64(params.partition)),
+					attributeOffset.Int64(int64(params.offset)),
+					attribute.Int64("read.limit", int64(params.limit)),
+				),
+			)
+			defer span.End()
+
+			start := time.Now()
+			status := ReadStatusError
+			defer func() {
+				metrics.ReadCompleted(params.topic, status, time.Since(start))
+				span.SetAttributes(attribute.String("read.status", string(status)))
+				if status == ReadStatusError {
+					span.SetStatus(codes.Error, "read failed")
+				}
+			}()
+
+			ctx, cancel := context.WithTimeout(ctx, params.timeout)
 			defer cancel()
 
-			params, err := parseRequestParams(ctx, req)
-			if err != nil {
+			if err := resolveGroupOffset(ctx, consumerGroupsProvider, params); err != nil {
+				return err
+			}
+			if err := resolveTimeOffset(ctx, timeOffsetProvider, params); err != nil {
 				return err
 			}
 
@@ -127,12 +380,32 @@ func NewHandler(
 				params.topic, params.partition.Int32(), params.offset.Int64(), params.limit,
 			)
 
-			changes, err := fetchChangesWithRetry(ctx, changesProvider, params)
+			result, err := fetchChangesWithRetry(ctx, changesProvider, params)
 			if err != nil {
+				if errors.Is(err, ErrTooManyConcurrentReads) ||
+					errors.Is(err, ErrTooManyFetchConfigs) {
+					status = ReadStatusRejected
+					sendTooManyRequests(resp, time.Second, err.Error())
+					return nil
+				}
 				return err
 			}
 
-			page := buildPage(changes, params.offset)
+			records, bytesTruncated := truncateToMaxBytes(
+				result.Records,
+				readLimits.MaxResponseBytes,
+			)
+
+			AuditEventFromContext(ctx).SetRead(params, records)
+
+			page := buildPage(result, records, params.offset)
+			page.Truncated = truncatedReason(params, result.Status, bytesTruncated)
+			if bytesTruncated {
+				// records before the high watermark were cut, more can be read
+				page.Status = ReadStatusLimitReached
+			}
+			status = page.Status
+			metrics.RecordsRead(params.topic, result.Scanned, len(records))
 
 			if err := libhttp.SendJSONResponse(ctx, resp, page, http.StatusOK); err != nil {
 				return errors.Wrap(ctx, err, "send json failed")
@@ -6,9 +6,12 @@ package pkg_test
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
+	"time"
 
 	"github.com/IBM/sarama"
 	"github.com/bborbe/errors"
@@ -24,6 +27,9 @@ import (
 var _ = Describe("Handler", func() {
 	var ctx context.Context
 	var changesProvider *mocks.ChangesProvider
+	var consumerGroupsProvider *mocks.ConsumerGroupsProvider
+	var timeOffsetProvider *mocks.TimeOffsetProvider
+	var metrics *mocks.Metrics
 	var handler libhttp.WithError
 	var request *http.Request
 	var response *httptest.ResponseRecorder
@@ -32,7 +38,17 @@ var _ = Describe("Handler", func() {
 	BeforeEach(func() {
 		ctx = context.Background()
 		changesProvider = &mocks.ChangesProvider{}
-		handler = pkg.NewHandler(changesProvider)
+		consumerGroupsProvider = &mocks.ConsumerGroupsProvider{}
+		timeOffsetProvider = &mocks.TimeOffsetProvider{}
+		metrics = &mocks.Metrics{}
+		handler = pkg.NewHandler(
+			changesProvider,
+			consumerGroupsProvider,
+			timeOffsetProvider,
+			metrics,
+			pkg.DefaultReadLimits(),
+			pkg.DefaultFetchLimits(),
+		)
 		response = httptest.NewRecorder()
 	})
 
@@ -133,16 +149,46 @@ var _ = Describe("Handler", func() {
 						Topic:     libkafka.Topic("test-topic"),
 					},
 				}
-				changesProvider.ChangesReturns(records, nil)
+				changesProvider.ChangesReturns(&pkg.ChangesResult{
+					Records:       records,
+					Status:        pkg.ReadStatusHighWaterMarkReached,
+					HighWaterMark: 1,
+					Scanned:       3,
+					Matched:       1,
+				}, nil)
 			})
 
 			It("returns no error", func() {
 				Expect(err).To(BeNil())
 			})
 
+			It("records metrics", func() {
+				Expect(metrics.ReadCompletedCallCount()).To(Equal(1))
+				topic, status, _ := metrics.ReadCompletedArgsForCall(0)
+				Expect(topic).To(Equal(libkafka.Topic("test-topic")))
+				Expect(status).To(Equal(pkg.ReadStatusHighWaterMarkReached))
+
+				Expect(metrics.RecordsReadCallCount()).To(Equal(1))
+				_, scanned, returned := metrics.RecordsReadArgsForCall(0)
+				Expect(scanned).To(Equal(uint64(3)))
+				Expect(returned).To(Equal(1))
+			})
+
+			It("returns read status", func() {
+				var page pkg.Page
+				Expect(json.Unmarshal(response.Body.Bytes(), &page)).To(Succeed())
+				Expect(page.Status).To(Equal(pkg.ReadStatusHighWaterMarkReached))
+				Expect(page.HighWaterMark).To(Equal(libkafka.Offset(1)))
+				Expect(page.Scanned).To(Equal(uint64(3)))
+				Expect(page.Matched).To(Equal(uint64(1)))
+				Expect(page.Truncated).To(BeEmpty())
+			})
+
 			It("calls changes provider with correct parameters", func() {
 				Expect(changesProvider.ChangesCallCount()).To(Equal(1))
-				_, topic, partition, offset, limit, filter := changesProvider.ChangesArgsForCall(0)
+				_, topic, partition, offset, limit, filter, _ := changesProvider.ChangesArgsForCall(
+					0,
+				)
 				Expect(topic).To(Equal(libkafka.Topic("test-topic")))
 				Expect(partition).To(Equal(libkafka.Partition(0)))
 				Expect(offset).To(Equal(libkafka.Offset(0)))
@@ -163,6 +209,23 @@ var _ = Describe("Handler", func() {
 				Expect(body).To(ContainSubstring("test-key"))
 				Expect(body).To(ContainSubstring("nextOffset"))
 			})
+
+			Context("with audit event", func() {
+				var event *pkg.AuditEvent
+
+				BeforeEach(func() {
+					event = &pkg.AuditEvent{}
+					ctx = pkg.WithAuditEvent(ctx, event)
+				})
+
+				It("fills read details", func() {
+					Expect(event.Topic).To(Equal(libkafka.Topic("test-topic")))
+					Expect(event.Partition).To(HaveValue(Equal(libkafka.Partition(0))))
+					Expect(event.FromOffset).To(HaveValue(Equal(libkafka.Offset(0))))
+					Expect(event.ToOffset).To(HaveValue(Equal(libkafka.Offset(0))))
+					Expect(event.RecordCount).To(Equal(1))
+				})
+			})
 		})
 
 		Context("successful request with custom limit", func() {
@@ -174,12 +237,12 @@ var _ = Describe("Handler", func() {
 				values.Set("limit", "50")
 				request = httptest.NewRequest("GET", "/read?"+values.Encode(), nil)
 
-				changesProvider.ChangesReturns(pkg.Records{}, nil)
+				changesProvider.ChangesReturns(&pkg.ChangesResult{}, nil)
 			})
 
 			It("calls changes provider with custom limit", func() {
 				Expect(changesProvider.ChangesCallCount()).To(Equal(1))
-				_, _, _, _, limit, _ := changesProvider.ChangesArgsForCall(0)
+				_, _, _, _, limit, _, _ := changesProvider.ChangesArgsForCall(0)
 				Expect(limit).To(Equal(uint64(50)))
 			})
 		})
@@ -193,13 +256,199 @@ var _ = Describe("Handler", func() {
 				values.Set("limit", "invalid")
 				request = httptest.NewRequest("GET", "/read?"+values.Encode(), nil)
 
-				changesProvider.ChangesReturns(pkg.Records{}, nil)
+				changesProvider.ChangesReturns(&pkg.ChangesResult{}, nil)
 			})
 
-			It("uses default limit of 100", func() {
+			It("returns error", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("parse parameter limit failed"))
+			})
+
+			It("does not read", func() {
+				Expect(changesProvider.ChangesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("limit above max limit", func() {
+			BeforeEach(func() {
+				values := url.Values{}
+				values.Set("topic", "test-topic")
+				values.Set("offset", "0")
+				values.Set("partition", "0")
+				values.Set("limit", "1000000")
+				request = httptest.NewRequest("GET", "/read?"+values.Encode(), nil)
+
+				changesProvider.ChangesReturns(&pkg.ChangesResult{}, nil)
+			})
+
+			It("caps limit", func() {
 				Expect(changesProvider.ChangesCallCount()).To(Equal(1))
-				_, _, _, _, limit, _ := changesProvider.ChangesArgsForCall(0)
-				Expect(limit).To(Equal(uint64(100)))
+				_, _, _, _, limit, _, _ := changesProvider.ChangesArgsForCall(0)
+				Expect(limit).To(Equal(uint64(1000)))
+			})
+
+			Context("page full", func() {
+				BeforeEach(func() {
+					changesProvider.ChangesReturns(&pkg.ChangesResult{
+						Records: make(pkg.Records, 1000),
+						Status:  pkg.ReadStatusLimitReached,
+					}, nil)
+				})
+
+				It("returns truncated page", func() {
+					Expect(err).To(BeNil())
+					Expect(response.Body.String()).To(ContainSubstring(`"truncated":"maxLimit"`))
+				})
+			})
+
+			Context("end of partition reached", func() {
+				BeforeEach(func() {
+					changesProvider.ChangesReturns(&pkg.ChangesResult{
+						Records: make(pkg.Records, 10),
+						Status:  pkg.ReadStatusHighWaterMarkReached,
+					}, nil)
+				})
+
+				It("returns complete page", func() {
+					Expect(response.Body.String()).NotTo(ContainSubstring("truncated"))
+				})
+			})
+		})
+
+		Context("timeout parameter", func() {
+			var deadline time.Duration
+
+			BeforeEach(func() {
+				changesProvider.ChangesStub = func(
+					ctx context.Context,
+					topic libkafka.Topic,
+					partition libkafka.Partition,
+					offset libkafka.Offset,
+					limit uint64,
+					filter []byte,
+					fetchConfig pkg.FetchConfig,
+				) (*pkg.ChangesResult, error) {
+					d, ok := ctx.Deadline()
+					Expect(ok).To(BeTrue())
+					deadline = time.Until(d)
+					return &pkg.ChangesResult{}, nil
+				}
+			})
+
+			DescribeTable(
+				"sets deadline",
+				func(timeout string, min time.Duration, max time.Duration) {
+					values := url.Values{}
+					values.Set("topic", "test-topic")
+					values.Set("offset", "0")
+					values.Set("partition", "0")
+					values.Set("timeout", timeout)
+					request = httptest.NewRequest("GET", "/read?"+values.Encode(), nil)
+
+					Expect(handler.ServeHTTP(ctx, response, request)).To(Succeed())
+					Expect(deadline).To(BeNumerically(">", min))
+					Expect(deadline).To(BeNumerically("<=", max))
+				},
+				Entry("default", "", 14*time.Second, 15*time.Second),
+				Entry("duration", "2s", time.Second, 2*time.Second),
+				Entry("seconds", "3", 2*time.Second, 3*time.Second),
+				Entry("capped", "10m", 59*time.Second, 60*time.Second),
+			)
+		})
+
+		Context("invalid timeout parameter", func() {
+			BeforeEach(func() {
+				values := url.Values{}
+				values.Set("topic", "test-topic")
+				values.Set("offset", "0")
+				values.Set("partition", "0")
+				values.Set("timeout", "soon")
+				request = httptest.NewRequest("GET", "/read?"+values.Encode(), nil)
+			})
+
+			It("returns error", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("parse parameter timeout failed"))
+			})
+		})
+
+		Context("timeout reached", func() {
+			BeforeEach(func() {
+				values := url.Values{}
+				values.Set("topic", "test-topic")
+				values.Set("offset", "0")
+				values.Set("partition", "0")
+				values.Set("timeout", "1ms")
+				request = httptest.NewRequest("GET", "/read?"+values.Encode(), nil)
+
+				changesProvider.ChangesStub = func(
+					ctx context.Context,
+					topic libkafka.Topic,
+					partition libkafka.Partition,
+					offset libkafka.Offset,
+					limit uint64,
+					filter []byte,
+					fetchConfig pkg.FetchConfig,
+				) (*pkg.ChangesResult, error) {
+					<-ctx.Done()
+					return &pkg.ChangesResult{
+						Records: pkg.Records{{Offset: 0}},
+						Status:  pkg.ReadStatusTimeout,
+					}, nil
+				}
+			})
+
+			It("returns partial page", func() {
+				Expect(err).To(BeNil())
+				Expect(response.Body.String()).To(ContainSubstring(`"truncated":"timeout"`))
+				Expect(response.Body.String()).To(ContainSubstring(`"status":"timeout"`))
+				Expect(response.Body.String()).To(ContainSubstring(`"nextOffset":1`))
+			})
+		})
+
+		Context("max response bytes reached", func() {
+			BeforeEach(func() {
+				readLimits := pkg.DefaultReadLimits()
+				readLimits.MaxResponseBytes = 1000
+				handler = pkg.NewHandler(
+					changesProvider,
+					consumerGroupsProvider,
+					timeOffsetProvider,
+					metrics,
+					readLimits,
+					pkg.DefaultFetchLimits(),
+				)
+
+				values := url.Values{}
+				values.Set("topic", "test-topic")
+				values.Set("offset", "0")
+				values.Set("partition", "0")
+				request = httptest.NewRequest("GET", "/read?"+values.Encode(), nil)
+
+				records := make(pkg.Records, 10)
+				for i := range records {
+					records[i] = pkg.Record{
+						Offset: libkafka.Offset(i),
+						Value:  strings.Repeat("x", 300),
+					}
+				}
+				nextOffset := libkafka.Offset(10)
+				changesProvider.ChangesReturns(&pkg.ChangesResult{
+					Records:    records,
+					Status:     pkg.ReadStatusHighWaterMarkReached,
+					NextOffset: &nextOffset,
+				}, nil)
+			})
+
+			It("returns partial page", func() {
+				Expect(err).To(BeNil())
+				var page pkg.Page
+				Expect(json.Unmarshal(response.Body.Bytes(), &page)).To(Succeed())
+				Expect(page.Truncated).To(Equal(pkg.TruncatedReasonMaxResponseBytes))
+				Expect(page.Status).To(Equal(pkg.ReadStatusLimitReached))
+				Expect(len(page.Records)).To(BeNumerically(">=", 1))
+				Expect(len(page.Records)).To(BeNumerically("<", 10))
+				Expect(*page.NextOffset).To(Equal(libkafka.Offset(len(page.Records))))
 			})
 		})
 
@@ -213,21 +462,25 @@ var _ = Describe("Handler", func() {
 
 				// First call returns offset out of range, second call succeeds
 				changesProvider.ChangesReturnsOnCall(0, nil, sarama.ErrOffsetOutOfRange)
-				changesProvider.ChangesReturnsOnCall(1, pkg.Records{}, nil)
+				changesProvider.ChangesReturnsOnCall(1, &pkg.ChangesResult{}, nil)
 			})
 
 			It("retries with oldest offset", func() {
 				Expect(changesProvider.ChangesCallCount()).To(Equal(2))
 
 				// First call with original offset
-				_, topic1, partition1, offset1, limit1, _ := changesProvider.ChangesArgsForCall(0)
+				_, topic1, partition1, offset1, limit1, _, _ := changesProvider.ChangesArgsForCall(
+					0,
+				)
 				Expect(topic1).To(Equal(libkafka.Topic("test-topic")))
 				Expect(partition1).To(Equal(libkafka.Partition(0)))
 				Expect(offset1).To(Equal(libkafka.Offset(1000)))
 				Expect(limit1).To(Equal(uint64(100)))
 
 				// Second call with oldest offset
-				_, topic2, partition2, offset2, limit2, _ := changesProvider.ChangesArgsForCall(1)
+				_, topic2, partition2, offset2, limit2, _, _ := changesProvider.ChangesArgsForCall(
+					1,
+				)
 				Expect(topic2).To(Equal(libkafka.Topic("test-topic")))
 				Expect(partition2).To(Equal(libkafka.Partition(0)))
 				Expect(offset2).To(Equal(libkafka.OffsetOldest))
@@ -250,12 +503,58 @@ var _ = Describe("Handler", func() {
 				changesProvider.ChangesReturns(nil, errors.New(ctx, "provider error"))
 			})
 
+			It("records error status", func() {
+				Expect(metrics.ReadCompletedCallCount()).To(Equal(1))
+				_, status, _ := metrics.ReadCompletedArgsForCall(0)
+				Expect(status).To(Equal(pkg.ReadStatusError))
+			})
+
 			It("returns error", func() {
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(ContainSubstring("get changes failed"))
 			})
 		})
 
+		Context("too many concurrent reads", func() {
+			BeforeEach(func() {
+				values := url.Values{}
+				values.Set("topic", "test-topic")
+				values.Set("offset", "0")
+				values.Set("partition", "0")
+				request = httptest.NewRequest("GET", "/read?"+values.Encode(), nil)
+
+				changesProvider.ChangesReturns(nil, pkg.ErrTooManyConcurrentReads)
+			})
+
+			It("returns no error", func() {
+				Expect(err).To(BeNil())
+			})
+
+			It("returns 429 with Retry-After", func() {
+				Expect(response.Code).To(Equal(http.StatusTooManyRequests))
+				Expect(response.Header().Get("Retry-After")).To(Equal("1"))
+			})
+		})
+
+		Context("too many fetch configs", func() {
+			BeforeEach(func() {
+				values := url.Values{}
+				values.Set("topic", "test-topic")
+				values.Set("offset", "0")
+				values.Set("partition", "0")
+				values.Set("fetchMaxWait", "10ms")
+				request = httptest.NewRequest("GET", "/read?"+values.Encode(), nil)
+
+				changesProvider.ChangesReturns(nil, pkg.ErrTooManyFetchConfigs)
+			})
+
+			It("returns 429 with Retry-After", func() {
+				Expect(err).To(BeNil())
+				Expect(response.Code).To(Equal(http.StatusTooManyRequests))
+				Expect(response.Header().Get("Retry-After")).To(Equal("1"))
+			})
+		})
+
 		Context("changes provider error after offset retry", func() {
 			BeforeEach(func() {
 				values := url.Values{}
@@ -289,7 +588,7 @@ var _ = Describe("Handler", func() {
 						pkg.Record{Offset: libkafka.Offset(6)},
 						pkg.Record{Offset: libkafka.Offset(7)},
 					}
-					changesProvider.ChangesReturns(records, nil)
+					changesProvider.ChangesReturns(&pkg.ChangesResult{Records: records}, nil)
 				})
 
 				It("calculates next offset correctly", func() {
@@ -306,7 +605,7 @@ var _ = Describe("Handler", func() {
 					values.Set("partition", "0")
 					request = httptest.NewRequest("GET", "/read?"+values.Encode(), nil)
 
-					changesProvider.ChangesReturns(pkg.Records{}, nil)
+					changesProvider.ChangesReturns(&pkg.ChangesResult{}, nil)
 				})
 
 				It("keeps original offset", func() {
@@ -314,6 +613,218 @@ var _ = Describe("Handler", func() {
 					Expect(body).To(ContainSubstring(`"nextOffset":5`))
 				})
 			})
+
+			Context("without matching records", func() {
+				BeforeEach(func() {
+					values := url.Values{}
+					values.Set("topic", "test-topic")
+					values.Set("offset", "5")
+					values.Set("partition", "0")
+					values.Set("filter", "banana")
+					request = httptest.NewRequest("GET", "/read?"+values.Encode(), nil)
+
+					nextOffset := libkafka.Offset(105)
+					changesProvider.ChangesReturns(&pkg.ChangesResult{
+						Status:     pkg.ReadStatusTimeout,
+						Scanned:    100,
+						NextOffset: &nextOffset,
+					}, nil)
+				})
+
+				It("continues after the scanned messages", func() {
+					var page pkg.Page
+					Expect(json.Unmarshal(response.Body.Bytes(), &page)).To(Succeed())
+					Expect(page.Records).To(BeEmpty())
+					Expect(page.Scanned).To(Equal(uint64(100)))
+					Expect(*page.NextOffset).To(Equal(libkafka.Offset(105)))
+				})
+			})
+
+			Context("with messages scanned after the last match", func() {
+				BeforeEach(func() {
+					values := url.Values{}
+					values.Set("topic", "test-topic")
+					values.Set("offset", "5")
+					values.Set("partition", "0")
+					request = httptest.NewRequest("GET", "/read?"+values.Encode(), nil)
+
+					nextOffset := libkafka.Offset(20)
+					changesProvider.ChangesReturns(&pkg.ChangesResult{
+						Records:    pkg.Records{pkg.Record{Offset: libkafka.Offset(7)}},
+						Status:     pkg.ReadStatusTimeout,
+						Scanned:    15,
+						NextOffset: &nextOffset,
+					}, nil)
+				})
+
+				It("continues after the scanned messages", func() {
+					body := response.Body.String()
+					Expect(body).To(ContainSubstring(`"nextOffset":20`))
+				})
+			})
+		})
+
+		Context("with group parameter", func() {
+			BeforeEach(func() {
+				request = httptest.NewRequest(
+					"GET",
+					"/read?topic=test-topic&partition=1&group=my-group",
+					nil,
+				)
+				offset := libkafka.Offset(42)
+				consumerGroupsProvider.CommittedOffsetReturns(&offset, nil)
+				changesProvider.ChangesReturns(&pkg.ChangesResult{}, nil)
+			})
+
+			It("returns no error", func() {
+				Expect(err).To(BeNil())
+			})
+
+			It("looks up the committed offset of the group", func() {
+				Expect(consumerGroupsProvider.CommittedOffsetCallCount()).To(Equal(1))
+				_, group, topic, partition := consumerGroupsProvider.CommittedOffsetArgsForCall(0)
+				Expect(group).To(Equal("my-group"))
+				Expect(topic).To(Equal(libkafka.Topic("test-topic")))
+				Expect(partition).To(Equal(libkafka.Partition(1)))
+			})
+
+			It("reads from the committed offset", func() {
+				Expect(changesProvider.ChangesCallCount()).To(Equal(1))
+				_, _, _, offset, _, _, _ := changesProvider.ChangesArgsForCall(0)
+				Expect(offset).To(Equal(libkafka.Offset(42)))
+			})
+		})
+
+		Context("with group and offset parameter", func() {
+			BeforeEach(func() {
+				request = httptest.NewRequest(
+					"GET",
+					"/read?topic=test-topic&partition=1&offset=5&group=my-group",
+					nil,
+				)
+			})
+
+			It("returns error", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("parameter offset and group are exclusive"))
+			})
+		})
+
+		Context("with time parameter", func() {
+			BeforeEach(func() {
+				request = httptest.NewRequest(
+					"GET",
+					"/read?topic=test-topic&partition=1&time=2026-10-18T10:00:00Z",
+					nil,
+				)
+				timeOffsetProvider.OffsetReturns(42, nil)
+				changesProvider.ChangesReturns(&pkg.ChangesResult{}, nil)
+			})
+
+			It("returns no error", func() {
+				Expect(err).To(BeNil())
+			})
+
+			It("looks up the offset of the time", func() {
+				Expect(timeOffsetProvider.OffsetCallCount()).To(Equal(1))
+				_, topic, partition, t := timeOffsetProvider.OffsetArgsForCall(0)
+				Expect(topic).To(Equal(libkafka.Topic("test-topic")))
+				Expect(partition).To(Equal(libkafka.Partition(1)))
+				Expect(t).To(BeTemporally("==", time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC)))
+			})
+
+			It("reads from the offset of the time", func() {
+				Expect(changesProvider.ChangesCallCount()).To(Equal(1))
+				_, _, _, offset, _, _, _ := changesProvider.ChangesArgsForCall(0)
+				Expect(offset).To(Equal(libkafka.Offset(42)))
+			})
+		})
+
+		Context("with time parameter in unix milliseconds", func() {
+			BeforeEach(func() {
+				request = httptest.NewRequest(
+					"GET",
+					"/read?topic=test-topic&partition=1&time=1792317600000",
+					nil,
+				)
+				changesProvider.ChangesReturns(&pkg.ChangesResult{}, nil)
+			})
+
+			It("looks up the offset of the time", func() {
+				Expect(err).To(BeNil())
+				_, _, _, t := timeOffsetProvider.OffsetArgsForCall(0)
+				Expect(t.UnixMilli()).To(Equal(int64(1792317600000)))
+			})
+		})
+
+		Context("with invalid time parameter", func() {
+			BeforeEach(func() {
+				request = httptest.NewRequest(
+					"GET",
+					"/read?topic=test-topic&partition=1&time=yesterday",
+					nil,
+				)
+			})
+
+			It("returns error", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("parse parameter time failed"))
+			})
+		})
+
+		Context("with time and offset parameter", func() {
+			BeforeEach(func() {
+				request = httptest.NewRequest(
+					"GET",
+					"/read?topic=test-topic&partition=1&offset=5&time=2026-10-18T10:00:00Z",
+					nil,
+				)
+			})
+
+			It("returns error", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("parameter time is exclusive"))
+			})
+		})
+
+		Context("with failing time lookup", func() {
+			BeforeEach(func() {
+				request = httptest.NewRequest(
+					"GET",
+					"/read?topic=test-topic&partition=1&time=2026-10-18T10:00:00Z",
+					nil,
+				)
+				timeOffsetProvider.OffsetReturns(0, errors.New(ctx, "banana"))
+			})
+
+			It("returns error", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("get offset of time failed"))
+			})
+
+			It("does not call ChangesProvider", func() {
+				Expect(changesProvider.ChangesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("with group without committed offset", func() {
+			BeforeEach(func() {
+				request = httptest.NewRequest(
+					"GET",
+					"/read?topic=test-topic&partition=1&group=my-group",
+					nil,
+				)
+				consumerGroupsProvider.CommittedOffsetReturns(nil, errors.New(ctx, "banana"))
+			})
+
+			It("returns error", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("get committed offset of group failed"))
+			})
+
+			It("does not call ChangesProvider", func() {
+				Expect(changesProvider.ChangesCallCount()).To(Equal(0))
+			})
 		})
 
 		Context("with filter parameter", func() {
@@ -326,7 +837,7 @@ var _ = Describe("Handler", func() {
 				records := pkg.Records{
 					{Key: "key1", Value: "test-value here", Offset: libkafka.Offset(1)},
 				}
-				changesProvider.ChangesReturns(records, nil)
+				changesProvider.ChangesReturns(&pkg.ChangesResult{Records: records}, nil)
 			})
 
 			It("returns no error", func() {
@@ -335,7 +846,7 @@ var _ = Describe("Handler", func() {
 
 			It("passes filter to changes provider", func() {
 				Expect(changesProvider.ChangesCallCount()).To(Equal(1))
-				_, _, _, _, _, filter := changesProvider.ChangesArgsForCall(0)
+				_, _, _, _, _, filter, _ := changesProvider.ChangesArgsForCall(0)
 				Expect(filter).To(Equal([]byte("test-value")))
 			})
 		})
@@ -350,12 +861,12 @@ var _ = Describe("Handler", func() {
 				records := pkg.Records{
 					{Key: "key1", Value: "any value", Offset: libkafka.Offset(1)},
 				}
-				changesProvider.ChangesReturns(records, nil)
+				changesProvider.ChangesReturns(&pkg.ChangesResult{Records: records}, nil)
 			})
 
 			It("passes empty filter to changes provider", func() {
 				Expect(changesProvider.ChangesCallCount()).To(Equal(1))
-				_, _, _, _, _, filter := changesProvider.ChangesArgsForCall(0)
+				_, _, _, _, _, filter, _ := changesProvider.ChangesArgsForCall(0)
 				Expect(filter).To(Equal([]byte{}))
 			})
 		})
@@ -386,4 +897,105 @@ var _ = Describe("Handler", func() {
 			})
 		})
 	})
+
+	Context("fetch parameters", func() {
+		DescribeTable(
+			"passes fetch config",
+			func(parameters map[string]string, update func(fetchConfig *pkg.FetchConfig)) {
+				values := url.Values{}
+				values.Set("topic", "test-topic")
+				values.Set("offset", "0")
+				values.Set("partition", "0")
+				for key, value := range parameters {
+					values.Set(key, value)
+				}
+				request = httptest.NewRequest("GET", "/read?"+values.Encode(), nil)
+				changesProvider.ChangesReturns(&pkg.ChangesResult{}, nil)
+
+				Expect(handler.ServeHTTP(ctx, response, request)).To(Succeed())
+				Expect(changesProvider.ChangesCallCount()).To(Equal(1))
+				_, _, _, _, _, _, fetchConfig := changesProvider.ChangesArgsForCall(0)
+				expected := pkg.DefaultFetchConfig()
+				update(&expected)
+				Expect(fetchConfig).To(Equal(expected))
+			},
+			Entry("default", map[string]string{}, func(fetchConfig *pkg.FetchConfig) {}),
+			Entry("scan", map[string]string{
+				"fetchMinBytes":     "1048576",
+				"fetchDefaultBytes": "8388608",
+				"fetchMaxBytes":     "16777216",
+				"fetchMaxWait":      "1s",
+			}, func(fetchConfig *pkg.FetchConfig) {
+				fetchConfig.MinBytes = 1024 * 1024
+				fetchConfig.DefaultBytes = 8 * 1024 * 1024
+				fetchConfig.MaxBytes = 16 * 1024 * 1024
+				fetchConfig.MaxWait = time.Second
+			}),
+			Entry("max wait in milliseconds", map[string]string{
+				"fetchMaxWait": "10",
+			}, func(fetchConfig *pkg.FetchConfig) {
+				fetchConfig.MaxWait = 10 * time.Millisecond
+			}),
+			Entry("capped", map[string]string{
+				"fetchDefaultBytes": "1073741824",
+				"fetchMaxWait":      "1m",
+			}, func(fetchConfig *pkg.FetchConfig) {
+				fetchConfig.DefaultBytes = 64 * 1024 * 1024
+				fetchConfig.MaxWait = 5 * time.Second
+			}),
+			Entry("read committed", map[string]string{
+				"isolation": "read_committed",
+			}, func(fetchConfig *pkg.FetchConfig) {
+				fetchConfig.Isolation = pkg.IsolationReadCommitted
+			}),
+		)
+
+		DescribeTable(
+			"rejects invalid fetch parameters",
+			func(key string, value string, message string) {
+				values := url.Values{}
+				values.Set("topic", "test-topic")
+				values.Set("offset", "0")
+				values.Set("partition", "0")
+				values.Set(key, value)
+				request = httptest.NewRequest("GET", "/read?"+values.Encode(), nil)
+
+				err := handler.ServeHTTP(ctx, response, request)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(message))
+				Expect(changesProvider.ChangesCallCount()).To(Equal(0))
+			},
+			Entry(
+				"invalid bytes",
+				"fetchMinBytes",
+				"many",
+				"parse parameter fetchMinBytes failed",
+			),
+			Entry(
+				"zero bytes",
+				"fetchDefaultBytes",
+				"0",
+				"parse parameter fetchDefaultBytes failed",
+			),
+			Entry(
+				"invalid max wait",
+				"fetchMaxWait",
+				"soon",
+				"parse parameter fetchMaxWait failed",
+			),
+			Entry(
+				"max wait below 1ms",
+				"fetchMaxWait",
+				"10us",
+				"parse parameter fetchMaxWait failed",
+			),
+			Entry("min above default", "fetchMinBytes", "2097152", "invalid fetch parameters"),
+			Entry(
+				"unknown isolation",
+				"isolation",
+				"serializable",
+				"parse parameter isolation failed",
+			),
+		)
+	})
 })
@@ -0,0 +1,35 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import "context"
+
+type AuthMethod string
+
+const (
+	AuthMethodAPIKey AuthMethod = "apiKey"
+	AuthMethodJWT    AuthMethod = "jwt"
+)
+
+// Identity is the authenticated caller of a request.
+type Identity struct {
+	Subject string                 `json:"subject"`
+	Groups  []string               `json:"groups,omitempty"`
+	Claims  map[string]interface{} `json:"claims,omitempty"`
+	Method  AuthMethod             `json:"method"`
+}
+
+type identityContextKey struct{}
+
+// WithIdentity returns a context carrying the given identity.
+func WithIdentity(ctx context.Context, identity *Identity) context.Context {
+	return context.WithValue(ctx, identityContextKey{}, identity)
+}
+
+// IdentityFromContext returns the identity of the request or nil if unauthenticated.
+func IdentityFromContext(ctx context.Context) *Identity {
+	identity, _ := ctx.Value(identityContextKey{}).(*Identity)
+	return identity
+}
@@ -0,0 +1,26 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("Identity", func() {
+	It("returns nil without identity", func() {
+		Expect(pkg.IdentityFromContext(context.Background())).To(BeNil())
+	})
+
+	It("returns identity from context", func() {
+		identity := &pkg.Identity{Subject: "alice", Method: pkg.AuthMethodJWT}
+		ctx := pkg.WithIdentity(context.Background(), identity)
+		Expect(pkg.IdentityFromContext(ctx)).To(Equal(identity))
+	})
+})
@@ -0,0 +1,167 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/bborbe/errors"
+	libhttp "github.com/bborbe/http"
+	libkafka "github.com/bborbe/kafka"
+	"github.com/golang/glog"
+	"github.com/gorilla/mux"
+)
+
+// KeyOffsets are the offsets of the messages with a key. Messages at or after the
+// next offset of a partition are not indexed yet.
+type KeyOffsets struct {
+	Topic      libkafka.Topic  `json:"topic"`
+	Key        string          `json:"key"`
+	Offsets    []IndexedOffset `json:"offsets"`
+	Partitions []IndexProgress `json:"partitions"`
+}
+
+// IndexedTopic is the progress of the index of a topic.
+type IndexedTopic struct {
+	Topic      libkafka.Topic  `json:"topic"`
+	Partitions []IndexProgress `json:"partitions"`
+}
+
+// NewKeyOffsetsHandler answers with the offsets of the messages with the key given as
+// parameter, up to limit offsets in order of partition and offset. Topics with
+// redaction rules are refused, the lookup would tell whether a redacted key exists.
+func NewKeyOffsetsHandler(
+	indexer Indexer,
+	offsetIndex OffsetIndex,
+	redactor Redactor,
+	readLimits ReadLimits,
+) libhttp.WithError {
+	return libhttp.WithErrorFunc(
+		func(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
+			topic := libkafka.Topic(mux.Vars(req)["topic"])
+			if !indexer.Indexed(topic) {
+				http.Error(resp, "topic "+topic.String()+" not indexed", http.StatusNotFound)
+				return nil
+			}
+			if redactor.Redacts(topic) {
+				http.Error(
+					resp,
+					"key lookup of redacted topic "+topic.String()+" forbidden",
+					http.StatusForbidden,
+				)
+				return nil
+			}
+			key := req.FormValue("key")
+			if key == "" {
+				return errors.New(ctx, "parameter key missing")
+			}
+			limit, _, err := parseLimit(ctx, req.FormValue("limit"), readLimits)
+			if err != nil {
+				return errors.Wrap(ctx, err, "parse parameter limit failed")
+			}
+
+			offsets, err := offsetIndex.KeyOffsets(ctx, topic, []byte(key), int(limit))
+			if err != nil {
+				return errors.Wrap(ctx, err, "get offsets of key failed")
+			}
+			progress, err := offsetIndex.Progress(ctx, topic)
+			if err != nil {
+				return errors.Wrap(ctx, err, "get index progress failed")
+			}
+
+			result := KeyOffsets{
+				Topic:      topic,
+				Key:        key,
+				Offsets:    offsets,
+				Partitions: progress,
+			}
+			if err := libhttp.SendJSONResponse(ctx, resp, result, http.StatusOK); err != nil {
+				return errors.Wrap(ctx, err, "send json failed")
+			}
+			glog.V(2).Infof("found %d offsets of key in topic %s", len(offsets), topic)
+			return nil
+		},
+	)
+}
+
+// NewIndexHandler answers with the progress of all indexed topics visible to the
+// identity.
+func NewIndexHandler(
+	indexer Indexer,
+	offsetIndex OffsetIndex,
+	authorizer Authorizer,
+	cluster ClusterName,
+) libhttp.WithError {
+	return libhttp.WithErrorFunc(
+		func(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
+			identity := IdentityFromContext(ctx)
+			result := make([]IndexedTopic, 0, len(indexer.Topics()))
+			for _, topic := range indexer.Topics() {
+				if !authorizer.Visible(identity, cluster, topic) {
+					continue
+				}
+				progress, err := offsetIndex.Progress(ctx, topic)
+				if err != nil {
+					return errors.Wrapf(ctx, err, "get index progress of topic %s failed", topic)
+				}
+				result = append(result, IndexedTopic{
+					Topic:      topic,
+					Partitions: progress,
+				})
+			}
+
+			if err := libhttp.SendJSONResponse(ctx, resp, result, http.StatusOK); err != nil {
+				return errors.Wrap(ctx, err, "send json failed")
+			}
+			return nil
+		},
+	)
+}
+
+// NewIndexRebuildHandler starts the rebuild of the index of the topic given as
+// parameter. The rebuild runs in the background, its progress is reported by the
+// index handler.
+func NewIndexRebuildHandler(
+	indexer Indexer,
+) libhttp.WithError {
+	return libhttp.WithErrorFunc(
+		func(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
+			topic := libkafka.Topic(req.FormValue("topic"))
+			if topic == "" {
+				return errors.New(ctx, "parameter topic missing")
+			}
+			if err := indexer.Rebuild(ctx, topic); err != nil {
+				if errors.Is(err, ErrTopicNotIndexed) {
+					http.Error(resp, err.Error(), http.StatusNotFound)
+					return nil
+				}
+				return errors.Wrap(ctx, err, "rebuild index failed")
+			}
+
+			resp.WriteHeader(http.StatusAccepted)
+			glog.V(2).Infof("rebuild of index of topic %s started", topic)
+			return nil
+		},
+	)
+}
+
+// NewIndexCompactHandler removes the entries of deleted messages and rewrites the
+// index file. Reads of the index wait until the compaction completed.
+func NewIndexCompactHandler(
+	indexer Indexer,
+) libhttp.WithError {
+	return libhttp.WithErrorFunc(
+		func(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
+			if err := indexer.Compact(ctx); err != nil {
+				return errors.Wrap(ctx, err, "compact index failed")
+			}
+
+			resp.WriteHeader(http.StatusNoContent)
+			glog.V(2).Infof("compaction of index completed")
+			return nil
+		},
+	)
+}
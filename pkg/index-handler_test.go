@@ -0,0 +1,220 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/bborbe/errors"
+	libkafka "github.com/bborbe/kafka"
+	"github.com/gorilla/mux"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/mocks"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("IndexHandler", func() {
+	var ctx context.Context
+	var indexer *mocks.Indexer
+	var offsetIndex *mocks.OffsetIndex
+	var response *httptest.ResponseRecorder
+	var err error
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		indexer = &mocks.Indexer{}
+		indexer.TopicsReturns([]libkafka.Topic{"orders", "payments"})
+		indexer.IndexedCalls(func(topic libkafka.Topic) bool {
+			return topic == "orders" || topic == "payments"
+		})
+		offsetIndex = &mocks.OffsetIndex{}
+		offsetIndex.ProgressReturns([]pkg.IndexProgress{{Partition: 0, NextOffset: 100}}, nil)
+		response = httptest.NewRecorder()
+	})
+
+	Context("key offsets", func() {
+		var target string
+		var redactor *mocks.Redactor
+
+		BeforeEach(func() {
+			target = "/topics/orders/keys?key=order-1&limit=5"
+			redactor = &mocks.Redactor{}
+			offsetIndex.KeyOffsetsReturns([]pkg.IndexedOffset{{Partition: 0, Offset: 42}}, nil)
+		})
+
+		JustBeforeEach(func() {
+			request := mux.SetURLVars(
+				httptest.NewRequest(http.MethodGet, target, nil),
+				map[string]string{"topic": "orders"},
+			)
+			err = pkg.NewKeyOffsetsHandler(indexer, offsetIndex, redactor, pkg.DefaultReadLimits()).
+				ServeHTTP(ctx, response, request)
+		})
+
+		It("returns the offsets of the key", func() {
+			Expect(err).To(BeNil())
+			Expect(response.Code).To(Equal(http.StatusOK))
+			var result pkg.KeyOffsets
+			Expect(json.Unmarshal(response.Body.Bytes(), &result)).To(Succeed())
+			Expect(result).To(Equal(pkg.KeyOffsets{
+				Topic:      "orders",
+				Key:        "order-1",
+				Offsets:    []pkg.IndexedOffset{{Partition: 0, Offset: 42}},
+				Partitions: []pkg.IndexProgress{{Partition: 0, NextOffset: 100}},
+			}))
+
+			_, topic, key, limit := offsetIndex.KeyOffsetsArgsForCall(0)
+			Expect(topic).To(Equal(libkafka.Topic("orders")))
+			Expect(key).To(Equal([]byte("order-1")))
+			Expect(limit).To(Equal(5))
+		})
+
+		Context("topic not indexed", func() {
+			BeforeEach(func() {
+				indexer.IndexedReturns(false)
+				indexer.IndexedCalls(nil)
+			})
+
+			It("returns not found", func() {
+				Expect(err).To(BeNil())
+				Expect(response.Code).To(Equal(http.StatusNotFound))
+				Expect(offsetIndex.KeyOffsetsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("redacted topic", func() {
+			BeforeEach(func() {
+				redactor.RedactsReturns(true)
+			})
+
+			It("returns forbidden", func() {
+				Expect(err).To(BeNil())
+				Expect(response.Code).To(Equal(http.StatusForbidden))
+				Expect(offsetIndex.KeyOffsetsCallCount()).To(Equal(0))
+				Expect(redactor.RedactsArgsForCall(0)).To(Equal(libkafka.Topic("orders")))
+			})
+		})
+
+		Context("missing key", func() {
+			BeforeEach(func() {
+				target = "/topics/orders/keys"
+			})
+
+			It("returns error", func() {
+				Expect(err).NotTo(BeNil())
+				Expect(err.Error()).To(ContainSubstring("parameter key missing"))
+			})
+		})
+
+		Context("failing index", func() {
+			BeforeEach(func() {
+				offsetIndex.KeyOffsetsReturns(nil, errors.New(ctx, "banana"))
+			})
+
+			It("returns error", func() {
+				Expect(err).NotTo(BeNil())
+			})
+		})
+	})
+
+	Context("index", func() {
+		var authorizer *mocks.Authorizer
+
+		BeforeEach(func() {
+			authorizer = &mocks.Authorizer{}
+			authorizer.VisibleCalls(
+				func(identity *pkg.Identity, cluster pkg.ClusterName, topic libkafka.Topic) bool {
+					return topic == "orders"
+				},
+			)
+		})
+
+		JustBeforeEach(func() {
+			err = pkg.NewIndexHandler(indexer, offsetIndex, authorizer, "default").
+				ServeHTTP(ctx, response, httptest.NewRequest(http.MethodGet, "/index", nil))
+		})
+
+		It("returns the progress of the visible topics", func() {
+			Expect(err).To(BeNil())
+			var result []pkg.IndexedTopic
+			Expect(json.Unmarshal(response.Body.Bytes(), &result)).To(Succeed())
+			Expect(result).To(Equal([]pkg.IndexedTopic{{
+				Topic:      "orders",
+				Partitions: []pkg.IndexProgress{{Partition: 0, NextOffset: 100}},
+			}}))
+		})
+	})
+
+	Context("rebuild", func() {
+		var target string
+
+		BeforeEach(func() {
+			target = "/index/rebuild?topic=orders"
+		})
+
+		JustBeforeEach(func() {
+			err = pkg.NewIndexRebuildHandler(indexer).
+				ServeHTTP(ctx, response, httptest.NewRequest(http.MethodPost, target, nil))
+		})
+
+		It("starts the rebuild", func() {
+			Expect(err).To(BeNil())
+			Expect(response.Code).To(Equal(http.StatusAccepted))
+			Expect(indexer.RebuildCallCount()).To(Equal(1))
+			_, topic := indexer.RebuildArgsForCall(0)
+			Expect(topic).To(Equal(libkafka.Topic("orders")))
+		})
+
+		Context("topic not indexed", func() {
+			BeforeEach(func() {
+				indexer.RebuildReturns(errors.Wrap(ctx, pkg.ErrTopicNotIndexed, "rebuild failed"))
+			})
+
+			It("returns not found", func() {
+				Expect(err).To(BeNil())
+				Expect(response.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		Context("missing topic", func() {
+			BeforeEach(func() {
+				target = "/index/rebuild"
+			})
+
+			It("returns error", func() {
+				Expect(err).NotTo(BeNil())
+				Expect(indexer.RebuildCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("compact", func() {
+		JustBeforeEach(func() {
+			err = pkg.NewIndexCompactHandler(indexer).
+				ServeHTTP(ctx, response, httptest.NewRequest(http.MethodPost, "/index/compact", nil))
+		})
+
+		It("compacts the index", func() {
+			Expect(err).To(BeNil())
+			Expect(response.Code).To(Equal(http.StatusNoContent))
+			Expect(indexer.CompactCallCount()).To(Equal(1))
+		})
+
+		Context("failing compaction", func() {
+			BeforeEach(func() {
+				indexer.CompactReturns(errors.New(ctx, "banana"))
+			})
+
+			It("returns error", func() {
+				Expect(err).NotTo(BeNil())
+			})
+		})
+	})
+})
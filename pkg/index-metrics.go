@@ -0,0 +1,61 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"strconv"
+
+	libkafka "github.com/bborbe/kafka"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+//counterfeiter:generate -o ../mocks/index-metrics.go --fake-name IndexMetrics . IndexMetrics
+type IndexMetrics interface {
+	MessagesIndexed(topic libkafka.Topic, count int)
+	Lag(topic libkafka.Topic, partition libkafka.Partition, lag int64)
+}
+
+// NewIndexMetrics is shared by the indexers of all clusters.
+func NewIndexMetrics(
+	registerer prometheus.Registerer,
+	namespace string,
+) IndexMetrics {
+	m := &indexMetrics{
+		messages: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "index_messages_total",
+				Help:      "Messages added to the offset index by topic.",
+			},
+			[]string{"topic"},
+		),
+		lag: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "index_lag",
+				Help:      "Messages of a partition not yet indexed.",
+			},
+			[]string{"topic", "partition"},
+		),
+	}
+	registerer.MustRegister(
+		m.messages,
+		m.lag,
+	)
+	return m
+}
+
+type indexMetrics struct {
+	messages *prometheus.CounterVec
+	lag      *prometheus.GaugeVec
+}
+
+func (m *indexMetrics) MessagesIndexed(topic libkafka.Topic, count int) {
+	m.messages.WithLabelValues(topic.String()).Add(float64(count))
+}
+
+func (m *indexMetrics) Lag(topic libkafka.Topic, partition libkafka.Partition, lag int64) {
+	m.lag.WithLabelValues(topic.String(), strconv.Itoa(int(partition))).Set(float64(lag))
+}
@@ -0,0 +1,346 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+	stderrors "errors"
+	"sync"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/bborbe/errors"
+	libkafka "github.com/bborbe/kafka"
+	"github.com/bborbe/run"
+	"github.com/golang/glog"
+)
+
+// ErrTopicNotIndexed is returned for topics the indexer is not configured for.
+var ErrTopicNotIndexed = stderrors.New("topic not indexed")
+
+// indexerRetryDelay is the wait before indexing a topic again after an error.
+const indexerRetryDelay = 10 * time.Second
+
+//counterfeiter:generate -o ../mocks/indexer.go --fake-name Indexer . Indexer
+type Indexer interface {
+	// Run tails all partitions of the topics into the offset index until ctx is
+	// canceled. A failing topic is retried and does not stop the others.
+	Run(ctx context.Context) error
+	// Topics returns the indexed topics.
+	Topics() []libkafka.Topic
+	// Indexed returns true if the topic is indexed.
+	Indexed(topic libkafka.Topic) bool
+	// Rebuild removes the index of the topic and indexes it again from the low
+	// watermarks in the background.
+	Rebuild(ctx context.Context, topic libkafka.Topic) error
+	// Compact removes the entries of messages below the low watermarks and rewrites
+	// the index file.
+	Compact(ctx context.Context) error
+}
+
+// NewIndexer indexes the topics in batches of up to batchSize messages, written at
+// least every flushInterval.
+func NewIndexer(
+	saramaClient libkafka.SaramaClient,
+	createConsumer SaramaConsumerFactory,
+	fetchConfig FetchConfig,
+	offsetIndex OffsetIndex,
+	indexMetrics IndexMetrics,
+	topics []libkafka.Topic,
+	batchSize int,
+	flushInterval time.Duration,
+) Indexer {
+	indexedTopics := make(map[libkafka.Topic]*indexedTopic, len(topics))
+	for _, topic := range topics {
+		indexedTopics[topic] = &indexedTopic{}
+	}
+	return &indexer{
+		saramaClient:   saramaClient,
+		createConsumer: createConsumer,
+		fetchConfig:    fetchConfig,
+		offsetIndex:    offsetIndex,
+		indexMetrics:   indexMetrics,
+		topics:         topics,
+		indexedTopics:  indexedTopics,
+		batchSize:      max(batchSize, 1),
+		flushInterval:  flushInterval,
+	}
+}
+
+type indexer struct {
+	saramaClient   libkafka.SaramaClient
+	createConsumer SaramaConsumerFactory
+	fetchConfig    FetchConfig
+	offsetIndex    OffsetIndex
+	indexMetrics   IndexMetrics
+	topics         []libkafka.Topic
+	indexedTopics  map[libkafka.Topic]*indexedTopic
+	batchSize      int
+	flushInterval  time.Duration
+}
+
+// indexedTopic allows Rebuild to stop the running indexing of a topic.
+type indexedTopic struct {
+	mux     sync.Mutex
+	cancel  context.CancelFunc
+	rebuild bool
+}
+
+// started sets the cancel of the running indexing, it is canceled at once if a
+// rebuild was requested before.
+func (t *indexedTopic) started(cancel context.CancelFunc) {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	t.cancel = cancel
+	if t.rebuild {
+		cancel()
+	}
+}
+
+func (t *indexedTopic) requestRebuild() {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	t.rebuild = true
+	if t.cancel != nil {
+		t.cancel()
+	}
+}
+
+func (t *indexedTopic) takeRebuild() bool {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	result := t.rebuild
+	t.rebuild = false
+	return result
+}
+
+func (i *indexer) Run(ctx context.Context) error {
+	funcs := make([]run.Func, 0, len(i.topics))
+	for _, topic := range i.topics {
+		funcs = append(funcs, func(ctx context.Context) error {
+			i.runTopic(ctx, topic)
+			return nil
+		})
+	}
+	return run.CancelOnFirstError(ctx, funcs...)
+}
+
+func (i *indexer) runTopic(ctx context.Context, topic libkafka.Topic) {
+	state := i.indexedTopics[topic]
+	for {
+		topicCtx, cancel := context.WithCancel(ctx)
+		state.started(cancel)
+		err := i.indexTopic(topicCtx, topic)
+		cancel()
+		if ctx.Err() != nil {
+			return
+		}
+		if state.takeRebuild() {
+			glog.V(2).Infof("rebuild index of topic %s", topic)
+			if err := i.offsetIndex.Remove(ctx, topic); err != nil {
+				glog.Warningf("remove index of topic %s failed: %v", topic, err)
+			}
+			continue
+		}
+		glog.Warningf(
+			"index topic %s failed, retry in %v: %v",
+			topic,
+			indexerRetryDelay,
+			err,
+		)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(indexerRetryDelay):
+		}
+	}
+}
+
+// indexTopic indexes all partitions with one consumer until the first error.
+func (i *indexer) indexTopic(ctx context.Context, topic libkafka.Topic) error {
+	partitions, err := i.saramaClient.Partitions(topic.String())
+	if err != nil {
+		return errors.Wrapf(ctx, err, "get partitions of topic %s failed", topic)
+	}
+	consumer, err := i.createConsumer(i.fetchConfig)
+	if err != nil {
+		return errors.Wrapf(ctx, err, "create consumer failed")
+	}
+	defer func() {
+		if err := consumer.Close(); err != nil {
+			glog.V(2).Infof("close consumer of topic %s failed: %v", topic, err)
+		}
+	}()
+
+	funcs := make([]run.Func, 0, len(partitions))
+	for _, partition := range partitions {
+		funcs = append(funcs, func(ctx context.Context) error {
+			return i.indexPartition(ctx, consumer, topic, libkafka.Partition(partition))
+		})
+	}
+	return run.CancelOnFirstError(ctx, funcs...)
+}
+
+func (i *indexer) indexPartition(
+	ctx context.Context,
+	consumer sarama.Consumer,
+	topic libkafka.Topic,
+	partition libkafka.Partition,
+) error {
+	offset, err := i.startOffset(ctx, topic, partition)
+	if err != nil {
+		return err
+	}
+	partitionConsumer, err := consumer.ConsumePartition(
+		topic.String(),
+		partition.Int32(),
+		offset.Int64(),
+	)
+	if err != nil {
+		return errors.Wrapf(
+			ctx,
+			err,
+			"consume topic %s partition %d at offset %d failed",
+			topic,
+			partition,
+			offset,
+		)
+	}
+	defer func() {
+		if err := partitionConsumer.Close(); err != nil {
+			glog.V(2).Infof("close consumer of %s/%d failed: %v", topic, partition, err)
+		}
+	}()
+	glog.V(2).Infof("index topic %s partition %d from offset %d", topic, partition, offset)
+
+	ticker := time.NewTicker(i.flushInterval)
+	defer ticker.Stop()
+	batch := make([]IndexEntry, 0, i.batchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if err := i.offsetIndex.Add(ctx, topic, partition, batch); err != nil {
+			return err
+		}
+		next := batch[len(batch)-1].Offset + 1
+		i.indexMetrics.MessagesIndexed(topic, len(batch))
+		i.indexMetrics.Lag(topic, partition, partitionConsumer.HighWaterMarkOffset()-next.Int64())
+		batch = batch[:0]
+		return nil
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			// keep the progress of the last messages
+			if err := flush(); err != nil {
+				return err
+			}
+			return ctx.Err()
+		case msg, ok := <-partitionConsumer.Messages():
+			if !ok {
+				return errors.Errorf(ctx, "consumer of partition %d closed", partition)
+			}
+			batch = append(batch, IndexEntry{
+				Offset:    libkafka.Offset(msg.Offset),
+				Key:       msg.Key,
+				Timestamp: msg.Timestamp,
+			})
+			if len(batch) >= i.batchSize {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		case consumerErr, ok := <-partitionConsumer.Errors():
+			if !ok {
+				return errors.Errorf(ctx, "consumer of partition %d closed", partition)
+			}
+			return errors.Wrapf(ctx, consumerErr, "consume partition %d failed", partition)
+		case <-ticker.C:
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// startOffset continues after the indexed messages, or starts at the low watermark
+// if nothing is indexed yet or the indexed messages were deleted.
+func (i *indexer) startOffset(
+	ctx context.Context,
+	topic libkafka.Topic,
+	partition libkafka.Partition,
+) (libkafka.Offset, error) {
+	lowWaterMark, err := i.saramaClient.GetOffset(
+		topic.String(),
+		partition.Int32(),
+		sarama.OffsetOldest,
+	)
+	if err != nil {
+		return 0, errors.Wrapf(ctx, err, "get low watermark of partition %d failed", partition)
+	}
+	progress, err := i.offsetIndex.Progress(ctx, topic)
+	if err != nil {
+		return 0, err
+	}
+	for _, partitionProgress := range progress {
+		if partitionProgress.Partition == partition {
+			return max(partitionProgress.NextOffset, libkafka.Offset(lowWaterMark)), nil
+		}
+	}
+	return libkafka.Offset(lowWaterMark), nil
+}
+
+func (i *indexer) Topics() []libkafka.Topic {
+	return i.topics
+}
+
+func (i *indexer) Indexed(topic libkafka.Topic) bool {
+	_, ok := i.indexedTopics[topic]
+	return ok
+}
+
+func (i *indexer) Rebuild(ctx context.Context, topic libkafka.Topic) error {
+	state, ok := i.indexedTopics[topic]
+	if !ok {
+		return errors.Wrapf(ctx, ErrTopicNotIndexed, "rebuild index of topic %s failed", topic)
+	}
+	state.requestRebuild()
+	return nil
+}
+
+func (i *indexer) Compact(ctx context.Context) error {
+	for _, topic := range i.topics {
+		partitions, err := i.saramaClient.Partitions(topic.String())
+		if err != nil {
+			return errors.Wrapf(ctx, err, "get partitions of topic %s failed", topic)
+		}
+		for _, partition := range partitions {
+			lowWaterMark, err := i.saramaClient.GetOffset(
+				topic.String(),
+				partition,
+				sarama.OffsetOldest,
+			)
+			if err != nil {
+				return errors.Wrapf(
+					ctx,
+					err,
+					"get low watermark of partition %d failed",
+					partition,
+				)
+			}
+			err = i.offsetIndex.Prune(
+				ctx,
+				topic,
+				libkafka.Partition(partition),
+				libkafka.Offset(lowWaterMark),
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return i.offsetIndex.Compact(ctx)
+}
@@ -0,0 +1,179 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+	"path/filepath"
+	"time"
+
+	"github.com/IBM/sarama"
+	libkafka "github.com/bborbe/kafka"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/mocks"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("Indexer", func() {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	var saramaClient *mocks.SaramaClient
+	var saramaConsumer *mocks.SaramaConsumer
+	var messages chan *sarama.ConsumerMessage
+	var offsetIndex pkg.OffsetIndex
+	var indexMetrics *mocks.IndexMetrics
+	var indexer pkg.Indexer
+	var done chan struct{}
+	var start time.Time
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
+		start = time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC)
+		saramaClient = &mocks.SaramaClient{}
+		saramaClient.PartitionsReturns([]int32{0}, nil)
+		saramaClient.GetOffsetReturns(0, nil)
+		messages = make(chan *sarama.ConsumerMessage, 10)
+		saramaConsumer = &mocks.SaramaConsumer{}
+		saramaConsumer.ConsumePartitionCalls(
+			func(topic string, partition int32, offset int64) (sarama.PartitionConsumer, error) {
+				partitionConsumer := &mocks.SaramaPartitionConsumer{}
+				partitionConsumer.MessagesReturns(messages)
+				partitionConsumer.HighWaterMarkOffsetReturns(10)
+				return partitionConsumer, nil
+			},
+		)
+		var err error
+		offsetIndex, err = pkg.NewOffsetIndex(
+			ctx,
+			filepath.Join(GinkgoT().TempDir(), "default.db"),
+			time.Minute,
+		)
+		Expect(err).To(BeNil())
+		indexMetrics = &mocks.IndexMetrics{}
+		indexer = pkg.NewIndexer(
+			saramaClient,
+			func(fetchConfig pkg.FetchConfig) (sarama.Consumer, error) {
+				return saramaConsumer, nil
+			},
+			pkg.DefaultFetchConfig(),
+			offsetIndex,
+			indexMetrics,
+			[]libkafka.Topic{"orders"},
+			2,
+			10*time.Millisecond,
+		)
+	})
+
+	AfterEach(func() {
+		cancel()
+		if done != nil {
+			Eventually(done).Should(BeClosed())
+			done = nil
+		}
+		Expect(offsetIndex.Close()).To(Succeed())
+	})
+
+	run := func() {
+		done = make(chan struct{})
+		go func() {
+			defer close(done)
+			defer GinkgoRecover()
+			Expect(indexer.Run(ctx)).To(Succeed())
+		}()
+	}
+
+	send := func(offset int64, key string) {
+		messages <- &sarama.ConsumerMessage{
+			Topic:     "orders",
+			Partition: 0,
+			Offset:    offset,
+			Key:       []byte(key),
+			Timestamp: start.Add(time.Duration(offset) * time.Second),
+		}
+	}
+
+	progress := func() []pkg.IndexProgress {
+		result, err := offsetIndex.Progress(ctx, "orders")
+		Expect(err).To(BeNil())
+		return result
+	}
+
+	It("returns the indexed topics", func() {
+		Expect(indexer.Topics()).To(Equal([]libkafka.Topic{"orders"}))
+		Expect(indexer.Indexed("orders")).To(BeTrue())
+		Expect(indexer.Indexed("payments")).To(BeFalse())
+	})
+
+	It("indexes the messages from the low watermark", func() {
+		run()
+		send(0, "order-1")
+		send(1, "order-2")
+		send(2, "order-1")
+		Eventually(progress).Should(Equal([]pkg.IndexProgress{{Partition: 0, NextOffset: 3}}))
+
+		offsets, err := offsetIndex.KeyOffsets(ctx, "orders", []byte("order-1"), 10)
+		Expect(err).To(BeNil())
+		Expect(offsets).To(Equal([]pkg.IndexedOffset{
+			{Partition: 0, Offset: 0},
+			{Partition: 0, Offset: 2},
+		}))
+
+		_, _, offset := saramaConsumer.ConsumePartitionArgsForCall(0)
+		Expect(offset).To(Equal(int64(0)))
+		Expect(indexMetrics.MessagesIndexedCallCount()).To(BeNumerically(">=", 2))
+		_, _, lag := indexMetrics.LagArgsForCall(indexMetrics.LagCallCount() - 1)
+		Expect(lag).To(Equal(int64(7)))
+	})
+
+	It("continues after the indexed messages", func() {
+		Expect(offsetIndex.Add(ctx, "orders", 0, []pkg.IndexEntry{{Offset: 4}})).To(Succeed())
+		run()
+		Eventually(saramaConsumer.ConsumePartitionCallCount).Should(Equal(1))
+		_, _, offset := saramaConsumer.ConsumePartitionArgsForCall(0)
+		Expect(offset).To(Equal(int64(5)))
+	})
+
+	It("starts at the low watermark if the indexed messages were deleted", func() {
+		Expect(offsetIndex.Add(ctx, "orders", 0, []pkg.IndexEntry{{Offset: 4}})).To(Succeed())
+		saramaClient.GetOffsetReturns(20, nil)
+		run()
+		Eventually(saramaConsumer.ConsumePartitionCallCount).Should(Equal(1))
+		_, _, offset := saramaConsumer.ConsumePartitionArgsForCall(0)
+		Expect(offset).To(Equal(int64(20)))
+	})
+
+	It("rebuilds the index of a topic", func() {
+		run()
+		send(0, "order-1")
+		send(1, "order-2")
+		Eventually(progress).Should(Equal([]pkg.IndexProgress{{Partition: 0, NextOffset: 2}}))
+
+		Expect(indexer.Rebuild(ctx, "orders")).To(Succeed())
+		Eventually(saramaConsumer.ConsumePartitionCallCount).Should(Equal(2))
+		_, _, offset := saramaConsumer.ConsumePartitionArgsForCall(1)
+		Expect(offset).To(Equal(int64(0)))
+		Expect(progress()).To(BeEmpty())
+	})
+
+	It("rejects the rebuild of a topic not indexed", func() {
+		Expect(indexer.Rebuild(ctx, "payments")).To(MatchError(pkg.ErrTopicNotIndexed))
+	})
+
+	It("compacts the entries below the low watermark", func() {
+		Expect(offsetIndex.Add(ctx, "orders", 0, []pkg.IndexEntry{
+			{Offset: 0, Key: []byte("order-1")},
+			{Offset: 1, Key: []byte("order-1")},
+		})).To(Succeed())
+		saramaClient.GetOffsetReturns(1, nil)
+
+		Expect(indexer.Compact(ctx)).To(Succeed())
+
+		offsets, err := offsetIndex.KeyOffsets(ctx, "orders", []byte("order-1"), 10)
+		Expect(err).To(BeNil())
+		Expect(offsets).To(Equal([]pkg.IndexedOffset{{Partition: 0, Offset: 1}}))
+	})
+})
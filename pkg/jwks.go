@@ -0,0 +1,260 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/bborbe/errors"
+	"github.com/golang/glog"
+)
+
+// jwksMinRefetchInterval limits refetching a JWKS URL because of an unknown key id.
+const jwksMinRefetchInterval = time.Minute
+
+// maxJWKSSize limits the size of a fetched JWKS document.
+const maxJWKSSize = 1 << 20
+
+//counterfeiter:generate -o ../mocks/jwks.go --fake-name JWKS . JWKS
+type JWKS interface {
+	// Key returns the public key with the given id. An empty id is accepted if
+	// the set contains only one key.
+	Key(ctx context.Context, kid string) (crypto.PublicKey, error)
+}
+
+// JSONWebKeys maps key id to public key.
+type JSONWebKeys map[string]crypto.PublicKey
+
+func (j JSONWebKeys) Key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	if kid == "" && len(j) == 1 {
+		for _, key := range j {
+			return key, nil
+		}
+	}
+	key, ok := j[kid]
+	if !ok {
+		return nil, errors.Errorf(ctx, "key %s not found in jwks", kid)
+	}
+	return key, nil
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// ParseJWKS parses a JSON Web Key Set with RSA, EC and Ed25519 signing keys.
+// Keys for encryption and unsupported key types are skipped.
+func ParseJWKS(ctx context.Context, content []byte) (JSONWebKeys, error) {
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(content, &set); err != nil {
+		return nil, errors.Wrap(ctx, err, "unmarshal jwks failed")
+	}
+	result := JSONWebKeys{}
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(ctx, err, "parse key %s failed", jwk.Kid)
+		}
+		if key == nil {
+			glog.V(2).Infof("skip key %s with unsupported type %s", jwk.Kid, jwk.Kty)
+			continue
+		}
+		result[jwk.Kid] = key
+	}
+	if len(result) == 0 {
+		return nil, errors.New(ctx, "jwks contains no signing key")
+	}
+	return result, nil
+}
+
+func (j jsonWebKey) publicKey(ctx context.Context) (crypto.PublicKey, error) {
+	switch j.Kty {
+	case "RSA":
+		n, err := decodeBase64URLInt(ctx, j.N)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, "decode n failed")
+		}
+		e, err := decodeBase64URLInt(ctx, j.E)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, "decode e failed")
+		}
+		if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, errors.New(ctx, "exponent too large")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		curve, err := ellipticCurve(ctx, j.Crv)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, "get curve failed")
+		}
+		x, err := base64.RawURLEncoding.DecodeString(j.X)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, "decode x failed")
+		}
+		y, err := base64.RawURLEncoding.DecodeString(j.Y)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, "decode y failed")
+		}
+		size := (curve.Params().BitSize + 7) / 8
+		if len(x) != size || len(y) != size {
+			return nil, errors.New(ctx, "invalid coordinate length")
+		}
+		// uncompressed point encoding 0x04 || x || y
+		point := append(append([]byte{4}, x...), y...)
+		key, err := ecdsa.ParseUncompressedPublicKey(curve, point)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, "parse ec point failed")
+		}
+		return key, nil
+	case "OKP":
+		if j.Crv != "Ed25519" {
+			return nil, nil
+		}
+		x, err := base64.RawURLEncoding.DecodeString(j.X)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, "decode x failed")
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errors.New(ctx, "invalid ed25519 key length")
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, nil
+	}
+}
+
+func ellipticCurve(ctx context.Context, crv string) (elliptic.Curve, error) {
+	switch crv {
+	case "P-256":
+		return elliptic.P256(), nil
+	case "P-384":
+		return elliptic.P384(), nil
+	case "P-521":
+		return elliptic.P521(), nil
+	default:
+		return nil, errors.Errorf(ctx, "unsupported curve %s", crv)
+	}
+}
+
+func decodeBase64URLInt(ctx context.Context, value string) (*big.Int, error) {
+	content, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, "decode base64 failed")
+	}
+	if len(content) == 0 {
+		return nil, errors.New(ctx, "value empty")
+	}
+	return new(big.Int).SetBytes(content), nil
+}
+
+// NewJWKSFile reads the key set once from the given file.
+func NewJWKSFile(ctx context.Context, path string) (JWKS, error) {
+	content, err := os.ReadFile(path) // #nosec G304 -- path is configured by the operator
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "read jwks file %s failed", path)
+	}
+	keys, err := ParseJWKS(ctx, content)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "parse jwks file %s failed", path)
+	}
+	return keys, nil
+}
+
+// NewJWKSURL fetches the key set from the given URL on first use and refreshes it
+// after refreshInterval or if a token references an unknown key id. If a refresh
+// fails the previous keys are kept.
+func NewJWKSURL(
+	httpClient *http.Client,
+	url string,
+	refreshInterval time.Duration,
+) JWKS {
+	return &jwksURL{
+		httpClient:      httpClient,
+		url:             url,
+		refreshInterval: refreshInterval,
+	}
+}
+
+type jwksURL struct {
+	httpClient      *http.Client
+	url             string
+	refreshInterval time.Duration
+
+	mux       sync.Mutex
+	keys      JSONWebKeys
+	fetchedAt time.Time
+}
+
+func (j *jwksURL) Key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	j.mux.Lock()
+	defer j.mux.Unlock()
+
+	age := time.Since(j.fetchedAt)
+	_, known := j.keys[kid]
+	if j.keys == nil || age > j.refreshInterval || (!known && age > jwksMinRefetchInterval) {
+		keys, err := j.fetch(ctx)
+		j.fetchedAt = time.Now()
+		if err != nil {
+			if j.keys == nil {
+				return nil, errors.Wrap(ctx, err, "fetch jwks failed")
+			}
+			glog.Warningf("refresh jwks from %s failed, keep previous keys: %v", j.url, err)
+		} else {
+			j.keys = keys
+		}
+	}
+	return j.keys.Key(ctx, kid)
+}
+
+func (j *jwksURL) fetch(ctx context.Context) (JSONWebKeys, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, j.url, nil)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, "create request failed")
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := j.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "get %s failed", j.url)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf(ctx, "get %s returned status %d", j.url, resp.StatusCode)
+	}
+	content, err := io.ReadAll(io.LimitReader(resp.Body, maxJWKSSize))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, "read body failed")
+	}
+	keys, err := ParseJWKS(ctx, content)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, "parse jwks failed")
+	}
+	glog.V(2).Infof("fetched %d keys from %s", len(keys), j.url)
+	return keys, nil
+}
@@ -0,0 +1,226 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+func rsaJWK(kid string, key *rsa.PublicKey) map[string]string {
+	return map[string]string{
+		"kty": "RSA",
+		"kid": kid,
+		"use": "sig",
+		"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}
+}
+
+func ecJWK(kid string, key *ecdsa.PrivateKey) map[string]string {
+	point, err := key.PublicKey.Bytes()
+	Expect(err).To(BeNil())
+	size := (len(point) - 1) / 2
+	return map[string]string{
+		"kty": "EC",
+		"kid": kid,
+		"crv": "P-256",
+		"x":   base64.RawURLEncoding.EncodeToString(point[1 : 1+size]),
+		"y":   base64.RawURLEncoding.EncodeToString(point[1+size:]),
+	}
+}
+
+func ed25519JWK(kid string, key ed25519.PublicKey) map[string]string {
+	return map[string]string{
+		"kty": "OKP",
+		"kid": kid,
+		"crv": "Ed25519",
+		"x":   base64.RawURLEncoding.EncodeToString(key),
+	}
+}
+
+func jwksJSON(keys ...map[string]string) []byte {
+	content, err := json.Marshal(map[string]interface{}{"keys": keys})
+	Expect(err).To(BeNil())
+	return content
+}
+
+var _ = Describe("JWKS", func() {
+	var ctx context.Context
+	var rsaKey *rsa.PrivateKey
+	var ecKey *ecdsa.PrivateKey
+	var edKey ed25519.PublicKey
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		// keys are generated once, rsa key generation is slow
+		if rsaKey == nil {
+			var err error
+			rsaKey, err = rsa.GenerateKey(rand.Reader, 2048)
+			Expect(err).To(BeNil())
+			ecKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).To(BeNil())
+			edKey, _, err = ed25519.GenerateKey(rand.Reader)
+			Expect(err).To(BeNil())
+		}
+	})
+
+	Context("ParseJWKS", func() {
+		It("parses rsa, ec and ed25519 keys", func() {
+			keys, err := pkg.ParseJWKS(ctx, jwksJSON(
+				rsaJWK("rsa", &rsaKey.PublicKey),
+				ecJWK("ec", ecKey),
+				ed25519JWK("ed", edKey),
+			))
+			Expect(err).To(BeNil())
+			Expect(keys).To(HaveLen(3))
+			Expect(keys["rsa"]).To(Equal(&rsaKey.PublicKey))
+			Expect(keys["ec"].(*ecdsa.PublicKey).Equal(&ecKey.PublicKey)).To(BeTrue())
+			Expect(keys["ed"]).To(Equal(edKey))
+		})
+
+		It("skips encryption and unsupported keys", func() {
+			encryption := rsaJWK("enc", &rsaKey.PublicKey)
+			encryption["use"] = "enc"
+			keys, err := pkg.ParseJWKS(ctx, jwksJSON(
+				rsaJWK("rsa", &rsaKey.PublicKey),
+				encryption,
+				map[string]string{"kty": "oct", "kid": "hmac", "k": "c2VjcmV0"},
+			))
+			Expect(err).To(BeNil())
+			Expect(keys).To(HaveLen(1))
+			Expect(keys).To(HaveKey("rsa"))
+		})
+
+		DescribeTable("returns error",
+			func(content string) {
+				_, err := pkg.ParseJWKS(ctx, []byte(content))
+				Expect(err).NotTo(BeNil())
+			},
+			Entry("invalid json", `{`),
+			Entry("no keys", `{"keys": []}`),
+			Entry("invalid modulus", `{"keys": [{"kty": "RSA", "n": "!", "e": "AQAB"}]}`),
+			Entry("unknown curve", `{"keys": [{"kty": "EC", "crv": "P-1", "x": "AA", "y": "AA"}]}`),
+			Entry("point not on curve", `{"keys": [{"kty": "EC", "crv": "P-256", "x": "`+
+				base64.RawURLEncoding.EncodeToString(make([]byte, 32))+`", "y": "`+
+				base64.RawURLEncoding.EncodeToString(make([]byte, 32))+`"}]}`),
+		)
+	})
+
+	Context("JSONWebKeys", func() {
+		It("returns single key for empty kid", func() {
+			keys := pkg.JSONWebKeys{"rsa": &rsaKey.PublicKey}
+			key, err := keys.Key(ctx, "")
+			Expect(err).To(BeNil())
+			Expect(key).To(Equal(&rsaKey.PublicKey))
+		})
+
+		It("returns error for unknown kid", func() {
+			keys := pkg.JSONWebKeys{"rsa": &rsaKey.PublicKey, "ed": edKey}
+			_, err := keys.Key(ctx, "")
+			Expect(err).NotTo(BeNil())
+			_, err = keys.Key(ctx, "other")
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Context("NewJWKSFile", func() {
+		It("reads keys from file", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "jwks.json")
+			Expect(
+				os.WriteFile(path, jwksJSON(rsaJWK("rsa", &rsaKey.PublicKey)), 0600),
+			).To(Succeed())
+			jwks, err := pkg.NewJWKSFile(ctx, path)
+			Expect(err).To(BeNil())
+			key, err := jwks.Key(ctx, "rsa")
+			Expect(err).To(BeNil())
+			Expect(key).To(Equal(&rsaKey.PublicKey))
+		})
+	})
+
+	Context("NewJWKSURL", func() {
+		var server *httptest.Server
+		var requests atomic.Int32
+		var status int
+
+		BeforeEach(func() {
+			requests.Store(0)
+			status = http.StatusOK
+			server = httptest.NewServer(
+				http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+					requests.Add(1)
+					resp.WriteHeader(status)
+					_, _ = resp.Write(jwksJSON(rsaJWK("rsa", &rsaKey.PublicKey)))
+				}),
+			)
+			DeferCleanup(server.Close)
+		})
+
+		It("fetches keys once", func() {
+			jwks := pkg.NewJWKSURL(server.Client(), server.URL, time.Hour)
+			for range 3 {
+				key, err := jwks.Key(ctx, "rsa")
+				Expect(err).To(BeNil())
+				Expect(key).To(Equal(&rsaKey.PublicKey))
+			}
+			Expect(requests.Load()).To(Equal(int32(1)))
+		})
+
+		It("does not refetch immediately for unknown kid", func() {
+			jwks := pkg.NewJWKSURL(server.Client(), server.URL, time.Hour)
+			_, err := jwks.Key(ctx, "rsa")
+			Expect(err).To(BeNil())
+			_, err = jwks.Key(ctx, "other")
+			Expect(err).NotTo(BeNil())
+			Expect(requests.Load()).To(Equal(int32(1)))
+		})
+
+		It("refetches after refresh interval", func() {
+			jwks := pkg.NewJWKSURL(server.Client(), server.URL, 0)
+			_, err := jwks.Key(ctx, "rsa")
+			Expect(err).To(BeNil())
+			time.Sleep(time.Millisecond)
+			_, err = jwks.Key(ctx, "rsa")
+			Expect(err).To(BeNil())
+			Expect(requests.Load()).To(Equal(int32(2)))
+		})
+
+		It("keeps previous keys if refresh fails", func() {
+			jwks := pkg.NewJWKSURL(server.Client(), server.URL, 0)
+			_, err := jwks.Key(ctx, "rsa")
+			Expect(err).To(BeNil())
+			status = http.StatusInternalServerError
+			time.Sleep(time.Millisecond)
+			key, err := jwks.Key(ctx, "rsa")
+			Expect(err).To(BeNil())
+			Expect(key).To(Equal(&rsaKey.PublicKey))
+		})
+
+		It("returns error if first fetch fails", func() {
+			status = http.StatusInternalServerError
+			jwks := pkg.NewJWKSURL(server.Client(), server.URL, time.Hour)
+			_, err := jwks.Key(ctx, "rsa")
+			Expect(err).NotTo(BeNil())
+		})
+	})
+})
@@ -0,0 +1,94 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+	"time"
+
+	"github.com/bborbe/errors"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// jwtLeeway allows small clock differences to the token issuer.
+const jwtLeeway = 30 * time.Second
+
+var jwtValidMethods = []string{
+	"RS256", "RS384", "RS512",
+	"PS256", "PS384", "PS512",
+	"ES256", "ES384", "ES512",
+	"EdDSA",
+}
+
+// NewJWTAuthenticator accepts OIDC tokens signed by a key of the given JWKS.
+// Issuer and audience are only checked if set. The groups of the identity are
+// read from groupsClaim, which may be a string or an array of strings.
+func NewJWTAuthenticator(
+	jwks JWKS,
+	issuer string,
+	audience string,
+	groupsClaim string,
+) Authenticator {
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods(jwtValidMethods),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(jwtLeeway),
+	}
+	if issuer != "" {
+		options = append(options, jwt.WithIssuer(issuer))
+	}
+	if audience != "" {
+		options = append(options, jwt.WithAudience(audience))
+	}
+	return &jwtAuthenticator{
+		jwks:        jwks,
+		parser:      jwt.NewParser(options...),
+		groupsClaim: groupsClaim,
+	}
+}
+
+type jwtAuthenticator struct {
+	jwks        JWKS
+	parser      *jwt.Parser
+	groupsClaim string
+}
+
+func (j *jwtAuthenticator) Authenticate(ctx context.Context, token string) (*Identity, error) {
+	claims := jwt.MapClaims{}
+	_, err := j.parser.ParseWithClaims(token, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return j.jwks.Key(ctx, kid)
+	})
+	if err != nil {
+		return nil, errors.Wrapf(ctx, ErrUnauthenticated, "validate jwt failed: %v", err)
+	}
+	subject, err := claims.GetSubject()
+	if err != nil || subject == "" {
+		return nil, errors.Wrap(ctx, ErrUnauthenticated, "jwt subject missing")
+	}
+	return &Identity{
+		Subject: subject,
+		Groups:  claimStrings(claims[j.groupsClaim]),
+		Claims:  claims,
+		Method:  AuthMethodJWT,
+	}, nil
+}
+
+func claimStrings(value interface{}) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		result := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				result = append(result, s)
+			}
+		}
+		return result
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"time"
+
+	"github.com/bborbe/errors"
+	"github.com/golang-jwt/jwt/v5"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("JWTAuthenticator", func() {
+	var ctx context.Context
+	var rsaKey *rsa.PrivateKey
+	var ecKey *ecdsa.PrivateKey
+	var edPublicKey ed25519.PublicKey
+	var edPrivateKey ed25519.PrivateKey
+	var authenticator pkg.Authenticator
+	var claims jwt.MapClaims
+
+	sign := func(method jwt.SigningMethod, kid string, key crypto.PrivateKey) string {
+		token := jwt.NewWithClaims(method, claims)
+		token.Header["kid"] = kid
+		signed, err := token.SignedString(key)
+		Expect(err).To(BeNil())
+		return signed
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		// keys are generated once, rsa key generation is slow
+		if rsaKey == nil {
+			var err error
+			rsaKey, err = rsa.GenerateKey(rand.Reader, 2048)
+			Expect(err).To(BeNil())
+			ecKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).To(BeNil())
+			edPublicKey, edPrivateKey, err = ed25519.GenerateKey(rand.Reader)
+			Expect(err).To(BeNil())
+		}
+		authenticator = pkg.NewJWTAuthenticator(
+			pkg.JSONWebKeys{
+				"rsa": &rsaKey.PublicKey,
+				"ec":  &ecKey.PublicKey,
+				"ed":  edPublicKey,
+			},
+			"https://issuer.example.com",
+			"kafka-topic-reader",
+			"groups",
+		)
+		claims = jwt.MapClaims{
+			"sub":    "alice",
+			"iss":    "https://issuer.example.com",
+			"aud":    "kafka-topic-reader",
+			"exp":    time.Now().Add(time.Hour).Unix(),
+			"groups": []string{"readers", "ops"},
+			"email":  "alice@example.com",
+		}
+	})
+
+	DescribeTable("accepts valid token",
+		func(method jwt.SigningMethod, kid string, key func() crypto.PrivateKey) {
+			identity, err := authenticator.Authenticate(ctx, sign(method, kid, key()))
+			Expect(err).To(BeNil())
+			Expect(identity.Subject).To(Equal("alice"))
+			Expect(identity.Groups).To(Equal([]string{"readers", "ops"}))
+			Expect(identity.Claims).To(HaveKeyWithValue("email", "alice@example.com"))
+			Expect(identity.Method).To(Equal(pkg.AuthMethodJWT))
+		},
+		Entry("RS256", jwt.SigningMethodRS256, "rsa", func() crypto.PrivateKey { return rsaKey }),
+		Entry("PS256", jwt.SigningMethodPS256, "rsa", func() crypto.PrivateKey { return rsaKey }),
+		Entry("ES256", jwt.SigningMethodES256, "ec", func() crypto.PrivateKey { return ecKey }),
+		Entry(
+			"EdDSA",
+			jwt.SigningMethodEdDSA,
+			"ed",
+			func() crypto.PrivateKey { return edPrivateKey },
+		),
+	)
+
+	It("accepts groups claim as string", func() {
+		claims["groups"] = "readers"
+		identity, err := authenticator.Authenticate(
+			ctx,
+			sign(jwt.SigningMethodRS256, "rsa", rsaKey),
+		)
+		Expect(err).To(BeNil())
+		Expect(identity.Groups).To(Equal([]string{"readers"}))
+	})
+
+	DescribeTable("rejects invalid token",
+		func(modify func()) {
+			modify()
+			_, err := authenticator.Authenticate(ctx, sign(jwt.SigningMethodRS256, "rsa", rsaKey))
+			Expect(errors.Is(err, pkg.ErrUnauthenticated)).To(BeTrue())
+		},
+		Entry("expired", func() { claims["exp"] = time.Now().Add(-time.Hour).Unix() }),
+		Entry("without expiry", func() { delete(claims, "exp") }),
+		Entry("wrong issuer", func() { claims["iss"] = "https://other.example.com" }),
+		Entry("wrong audience", func() { claims["aud"] = "other" }),
+		Entry("without subject", func() { delete(claims, "sub") }),
+	)
+
+	It("rejects token signed by unknown key", func() {
+		otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).To(BeNil())
+		_, err = authenticator.Authenticate(ctx, sign(jwt.SigningMethodRS256, "rsa", otherKey))
+		Expect(errors.Is(err, pkg.ErrUnauthenticated)).To(BeTrue())
+	})
+
+	It("rejects token with unknown kid", func() {
+		_, err := authenticator.Authenticate(ctx, sign(jwt.SigningMethodRS256, "other", rsaKey))
+		Expect(errors.Is(err, pkg.ErrUnauthenticated)).To(BeTrue())
+	})
+
+	It("rejects hmac token", func() {
+		_, err := authenticator.Authenticate(
+			ctx,
+			sign(jwt.SigningMethodHS256, "rsa", []byte("secret")),
+		)
+		Expect(errors.Is(err, pkg.ErrUnauthenticated)).To(BeTrue())
+	})
+
+	It("rejects unsigned token", func() {
+		_, err := authenticator.Authenticate(
+			ctx,
+			sign(jwt.SigningMethodNone, "rsa", jwt.UnsafeAllowNoneSignatureType),
+		)
+		Expect(errors.Is(err, pkg.ErrUnauthenticated)).To(BeTrue())
+	})
+
+	It("rejects garbage", func() {
+		_, err := authenticator.Authenticate(ctx, "not-a-jwt")
+		Expect(errors.Is(err, pkg.ErrUnauthenticated)).To(BeTrue())
+	})
+})
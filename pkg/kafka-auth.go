@@ -0,0 +1,146 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"strings"
+
+	"github.com/IBM/sarama"
+	"github.com/bborbe/errors"
+	libkafka "github.com/bborbe/kafka"
+)
+
+// KafkaAuth configures TLS and SASL for the connection to the Kafka brokers.
+// Credentials are read from files so they can be mounted from secrets.
+type KafkaAuth struct {
+	TLSEnabled            bool   `json:"tlsEnabled,omitempty"`
+	TLSCAFile             string `json:"tlsCaFile,omitempty"`
+	TLSCertFile           string `json:"tlsCertFile,omitempty"`
+	TLSKeyFile            string `json:"tlsKeyFile,omitempty"`
+	TLSInsecureSkipVerify bool   `json:"tlsInsecureSkipVerify,omitempty"`
+	SASLMechanism         string `json:"saslMechanism,omitempty"`
+	SASLUsernameFile      string `json:"saslUsernameFile,omitempty"`
+	SASLPasswordFile      string `json:"saslPasswordFile,omitempty"`
+}
+
+// TLS reports whether TLS is enabled explicitly or implied by a configured file.
+func (k KafkaAuth) TLS() bool {
+	return k.TLSEnabled || k.TLSCAFile != "" || k.TLSCertFile != "" || k.TLSKeyFile != ""
+}
+
+// TLSConfig returns the tls config or nil if TLS is disabled.
+func (k KafkaAuth) TLSConfig(ctx context.Context) (*tls.Config, error) {
+	if !k.TLS() {
+		return nil, nil
+	}
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// #nosec G402 -- only set if explicitly configured, e.g. for local clusters
+		InsecureSkipVerify: k.TLSInsecureSkipVerify,
+	}
+	if k.TLSCAFile != "" {
+		content, err := os.ReadFile(k.TLSCAFile)
+		if err != nil {
+			return nil, errors.Wrapf(ctx, err, "read ca file %s failed", k.TLSCAFile)
+		}
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(content) {
+			return nil, errors.Errorf(ctx, "no certificate found in ca file %s", k.TLSCAFile)
+		}
+		tlsConfig.RootCAs = certPool
+	}
+	if k.TLSCertFile != "" || k.TLSKeyFile != "" {
+		if k.TLSCertFile == "" || k.TLSKeyFile == "" {
+			return nil, errors.New(ctx, "tls cert file and key file must be set together")
+		}
+		certificate, err := tls.LoadX509KeyPair(k.TLSCertFile, k.TLSKeyFile)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, "load client certificate failed")
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+	return tlsConfig, nil
+}
+
+// SaramaConfigOptions returns an option applying TLS and SASL to the sarama config.
+func (k KafkaAuth) SaramaConfigOptions(ctx context.Context) (libkafka.SaramaConfigOptions, error) {
+	tlsConfig, err := k.TLSConfig(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, "create tls config failed")
+	}
+	sasl, err := k.sasl(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, "create sasl config failed")
+	}
+	return func(config *sarama.Config) {
+		if tlsConfig != nil {
+			config.Net.TLS.Enable = true
+			config.Net.TLS.Config = tlsConfig
+		}
+		if sasl != nil {
+			config.Net.SASL.Enable = true
+			config.Net.SASL.Handshake = true
+			config.Net.SASL.Mechanism = sasl.mechanism
+			config.Net.SASL.User = sasl.username
+			config.Net.SASL.Password = sasl.password
+			config.Net.SASL.SCRAMClientGeneratorFunc = sasl.scramClientGenerator
+		}
+	}, nil
+}
+
+type saslConfig struct {
+	mechanism            sarama.SASLMechanism
+	username             string
+	password             string
+	scramClientGenerator func() sarama.SCRAMClient
+}
+
+func (k KafkaAuth) sasl(ctx context.Context) (*saslConfig, error) {
+	if k.SASLMechanism == "" {
+		return nil, nil
+	}
+	mechanism := sarama.SASLMechanism(strings.ToUpper(k.SASLMechanism))
+	result := &saslConfig{
+		mechanism: mechanism,
+	}
+	switch mechanism {
+	case sarama.SASLTypePlaintext:
+	case sarama.SASLTypeSCRAMSHA256, sarama.SASLTypeSCRAMSHA512:
+		result.scramClientGenerator = func() sarama.SCRAMClient {
+			return NewSCRAMClient(mechanism, NewRandomNonce)
+		}
+	default:
+		return nil, errors.Errorf(ctx, "unsupported sasl mechanism %s", k.SASLMechanism)
+	}
+
+	var err error
+	if result.username, err = readSecretFile(ctx, k.SASLUsernameFile); err != nil {
+		return nil, errors.Wrap(ctx, err, "read sasl username failed")
+	}
+	if result.password, err = readSecretFile(ctx, k.SASLPasswordFile); err != nil {
+		return nil, errors.Wrap(ctx, err, "read sasl password failed")
+	}
+	return result, nil
+}
+
+// readSecretFile returns the content of the file without surrounding whitespace.
+func readSecretFile(ctx context.Context, path string) (string, error) {
+	if path == "" {
+		return "", errors.New(ctx, "file not configured")
+	}
+	content, err := os.ReadFile(path) // #nosec G304 -- path is configured by the operator
+	if err != nil {
+		return "", errors.Wrapf(ctx, err, "read file %s failed", path)
+	}
+	value := strings.TrimSpace(string(content))
+	if value == "" {
+		return "", errors.Errorf(ctx, "file %s is empty", path)
+	}
+	return value, nil
+}
@@ -0,0 +1,281 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/IBM/sarama"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+type testCertificate struct {
+	certificate *x509.Certificate
+	key         *ecdsa.PrivateKey
+	certPEM     []byte
+	keyPEM      []byte
+}
+
+func createTestCertificate(
+	template *x509.Certificate,
+	parent *testCertificate,
+) *testCertificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).To(BeNil())
+	parentCertificate, parentKey := template, key
+	if parent != nil {
+		parentCertificate, parentKey = parent.certificate, parent.key
+	}
+	der, err := x509.CreateCertificate(
+		rand.Reader,
+		template,
+		parentCertificate,
+		&key.PublicKey,
+		parentKey,
+	)
+	Expect(err).To(BeNil())
+	certificate, err := x509.ParseCertificate(der)
+	Expect(err).To(BeNil())
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	Expect(err).To(BeNil())
+	return &testCertificate{
+		certificate: certificate,
+		key:         key,
+		certPEM:     pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		keyPEM:      pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+	}
+}
+
+func writeTestFile(dir string, name string, content []byte) string {
+	path := filepath.Join(dir, name)
+	Expect(os.WriteFile(path, content, 0600)).To(Succeed())
+	return path
+}
+
+var _ = Describe("KafkaAuth", func() {
+	var ctx context.Context
+	var dir string
+	var kafkaAuth pkg.KafkaAuth
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		dir = GinkgoT().TempDir()
+		kafkaAuth = pkg.KafkaAuth{}
+	})
+
+	Context("disabled", func() {
+		It("returns no tls config", func() {
+			tlsConfig, err := kafkaAuth.TLSConfig(ctx)
+			Expect(err).To(BeNil())
+			Expect(tlsConfig).To(BeNil())
+		})
+
+		It("leaves the sarama config untouched", func() {
+			options, err := kafkaAuth.SaramaConfigOptions(ctx)
+			Expect(err).To(BeNil())
+			config := sarama.NewConfig()
+			options(config)
+			Expect(config.Net.TLS.Enable).To(BeFalse())
+			Expect(config.Net.SASL.Enable).To(BeFalse())
+		})
+	})
+
+	Context("TLS against local stand-in", func() {
+		var ca *testCertificate
+		var listener net.Listener
+		var handshakeErrors chan error
+
+		BeforeEach(func() {
+			now := time.Now()
+			ca = createTestCertificate(&x509.Certificate{
+				SerialNumber:          big.NewInt(1),
+				Subject:               pkix.Name{CommonName: "test-ca"},
+				NotBefore:             now.Add(-time.Hour),
+				NotAfter:              now.Add(time.Hour),
+				IsCA:                  true,
+				KeyUsage:              x509.KeyUsageCertSign,
+				BasicConstraintsValid: true,
+			}, nil)
+			server := createTestCertificate(&x509.Certificate{
+				SerialNumber: big.NewInt(2),
+				Subject:      pkix.Name{CommonName: "kafka"},
+				NotBefore:    now.Add(-time.Hour),
+				NotAfter:     now.Add(time.Hour),
+				IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+				ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+			}, ca)
+			client := createTestCertificate(&x509.Certificate{
+				SerialNumber: big.NewInt(3),
+				Subject:      pkix.Name{CommonName: "kafka-topic-reader"},
+				NotBefore:    now.Add(-time.Hour),
+				NotAfter:     now.Add(time.Hour),
+				ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+			}, ca)
+
+			serverCertificate, err := tls.X509KeyPair(server.certPEM, server.keyPEM)
+			Expect(err).To(BeNil())
+			clientCAs := x509.NewCertPool()
+			clientCAs.AddCert(ca.certificate)
+			listener, err = tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{serverCertificate},
+				ClientAuth:   tls.RequireAndVerifyClientCert,
+				ClientCAs:    clientCAs,
+			})
+			Expect(err).To(BeNil())
+
+			handshakeErrors = make(chan error, 1)
+			go func() {
+				conn, err := listener.Accept()
+				if err != nil {
+					handshakeErrors <- err
+					return
+				}
+				defer conn.Close()
+				handshakeErrors <- conn.(*tls.Conn).Handshake() //nolint:forcetypeassert
+
+			}()
+
+			kafkaAuth.TLSCAFile = writeTestFile(dir, "ca.pem", ca.certPEM)
+			kafkaAuth.TLSCertFile = writeTestFile(dir, "client.pem", client.certPEM)
+			kafkaAuth.TLSKeyFile = writeTestFile(dir, "client-key.pem", client.keyPEM)
+		})
+
+		AfterEach(func() {
+			_ = listener.Close()
+		})
+
+		It("connects with ca and client certificate", func() {
+			tlsConfig, err := kafkaAuth.TLSConfig(ctx)
+			Expect(err).To(BeNil())
+			conn, err := tls.Dial("tcp", listener.Addr().String(), tlsConfig)
+			Expect(err).To(BeNil())
+			defer conn.Close()
+			Expect(<-handshakeErrors).To(BeNil())
+		})
+
+		It("fails without client certificate", func() {
+			kafkaAuth.TLSCertFile = ""
+			kafkaAuth.TLSKeyFile = ""
+			tlsConfig, err := kafkaAuth.TLSConfig(ctx)
+			Expect(err).To(BeNil())
+			conn, err := tls.Dial("tcp", listener.Addr().String(), tlsConfig)
+			if err == nil {
+				// TLS 1.3 reports the missing client certificate on first read
+				_, err = conn.Read(make([]byte, 1))
+				_ = conn.Close()
+			}
+			Expect(err).To(HaveOccurred())
+			Expect(<-handshakeErrors).To(HaveOccurred())
+		})
+
+		It("fails without ca", func() {
+			kafkaAuth.TLSCAFile = ""
+			tlsConfig, err := kafkaAuth.TLSConfig(ctx)
+			Expect(err).To(BeNil())
+			_, err = tls.Dial("tcp", listener.Addr().String(), tlsConfig)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("enables tls in sarama config", func() {
+			options, err := kafkaAuth.SaramaConfigOptions(ctx)
+			Expect(err).To(BeNil())
+			config := sarama.NewConfig()
+			options(config)
+			Expect(config.Net.TLS.Enable).To(BeTrue())
+			Expect(config.Net.TLS.Config.Certificates).To(HaveLen(1))
+		})
+	})
+
+	Context("invalid ca file", func() {
+		BeforeEach(func() {
+			kafkaAuth.TLSCAFile = writeTestFile(dir, "ca.pem", []byte("banana"))
+		})
+
+		It("returns error", func() {
+			_, err := kafkaAuth.TLSConfig(ctx)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("no certificate found"))
+		})
+	})
+
+	Context("cert without key", func() {
+		BeforeEach(func() {
+			kafkaAuth.TLSCertFile = writeTestFile(dir, "client.pem", []byte("banana"))
+		})
+
+		It("returns error", func() {
+			_, err := kafkaAuth.TLSConfig(ctx)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("must be set together"))
+		})
+	})
+
+	Context("SASL", func() {
+		BeforeEach(func() {
+			kafkaAuth.SASLUsernameFile = writeTestFile(dir, "username", []byte("alice\n"))
+			kafkaAuth.SASLPasswordFile = writeTestFile(dir, "password", []byte("secret\n"))
+		})
+
+		DescribeTable(
+			"configures mechanism",
+			func(mechanism string, expected sarama.SASLMechanism, scram bool) {
+				kafkaAuth.SASLMechanism = mechanism
+				options, err := kafkaAuth.SaramaConfigOptions(ctx)
+				Expect(err).To(BeNil())
+				config := sarama.NewConfig()
+				options(config)
+				Expect(config.Net.SASL.Enable).To(BeTrue())
+				Expect(config.Net.SASL.Mechanism).To(Equal(expected))
+				Expect(config.Net.SASL.User).To(Equal("alice"))
+				Expect(config.Net.SASL.Password).To(Equal("secret"))
+				Expect(config.Net.SASL.SCRAMClientGeneratorFunc != nil).To(Equal(scram))
+			},
+			Entry("plain", "PLAIN", sarama.SASLMechanism(sarama.SASLTypePlaintext), false),
+			Entry(
+				"scram sha256",
+				"scram-sha-256",
+				sarama.SASLMechanism(sarama.SASLTypeSCRAMSHA256),
+				true,
+			),
+			Entry(
+				"scram sha512",
+				"SCRAM-SHA-512",
+				sarama.SASLMechanism(sarama.SASLTypeSCRAMSHA512),
+				true,
+			),
+		)
+
+		It("returns error for unknown mechanism", func() {
+			kafkaAuth.SASLMechanism = "GSSAPI"
+			_, err := kafkaAuth.SaramaConfigOptions(ctx)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unsupported sasl mechanism"))
+		})
+
+		It("returns error for missing password file", func() {
+			kafkaAuth.SASLMechanism = "PLAIN"
+			kafkaAuth.SASLPasswordFile = filepath.Join(dir, "missing")
+			_, err := kafkaAuth.SaramaConfigOptions(ctx)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("read sasl password failed"))
+		})
+	})
+})
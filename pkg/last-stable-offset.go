@@ -0,0 +1,64 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+
+	"github.com/IBM/sarama"
+	"github.com/bborbe/errors"
+	libkafka "github.com/bborbe/kafka"
+)
+
+// LastStableOffset returns the first offset of the oldest open transaction of the
+// partition, the high watermark if no transaction is open. Consumers reading
+// committed messages do not get messages at or after it.
+func LastStableOffset(
+	ctx context.Context,
+	saramaClient libkafka.SaramaClient,
+	topic libkafka.Topic,
+	partition libkafka.Partition,
+) (*libkafka.Offset, error) {
+	// sarama has no isolation for offset lookups, so ask the leader directly
+	broker, err := saramaClient.Leader(topic.String(), partition.Int32())
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "get leader of partition %d failed", partition)
+	}
+	request := sarama.NewOffsetRequest(saramaClient.Config().Version)
+	if request.Version < 2 {
+		return nil, errors.New(ctx, "last stable offset requires kafka version 0.11 or later")
+	}
+	request.IsolationLevel = sarama.ReadCommitted
+	request.AddBlock(topic.String(), partition.Int32(), sarama.OffsetNewest, 1)
+
+	response, err := broker.GetAvailableOffsets(request)
+	if err != nil {
+		return nil, errors.Wrapf(
+			ctx,
+			err,
+			"get last stable offset of partition %d failed",
+			partition,
+		)
+	}
+	block := response.GetBlock(topic.String(), partition.Int32())
+	if block == nil {
+		return nil, errors.Wrapf(
+			ctx,
+			sarama.ErrIncompleteResponse,
+			"get last stable offset of partition %d failed",
+			partition,
+		)
+	}
+	if !errors.Is(block.Err, sarama.ErrNoError) {
+		return nil, errors.Wrapf(
+			ctx,
+			block.Err,
+			"get last stable offset of partition %d failed",
+			partition,
+		)
+	}
+	offset := libkafka.Offset(block.Offset)
+	return &offset, nil
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+
+	"github.com/IBM/sarama"
+	"github.com/bborbe/errors"
+	libkafka "github.com/bborbe/kafka"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/mocks"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("LastStableOffset", func() {
+	var ctx context.Context
+	var saramaClient *mocks.SaramaClient
+	var mockBroker *sarama.MockBroker
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		saramaClient = &mocks.SaramaClient{}
+		mockBroker = newLastStableOffsetBroker(saramaClient, "orders", 0, 7)
+	})
+
+	It("asks the leader for the last stable offset", func() {
+		offset, err := pkg.LastStableOffset(ctx, saramaClient, "orders", 0)
+		Expect(err).To(BeNil())
+		Expect(*offset).To(Equal(libkafka.Offset(7)))
+
+		history := mockBroker.History()
+		Expect(history).NotTo(BeEmpty())
+		request, ok := history[len(history)-1].Request.(*sarama.OffsetRequest)
+		Expect(ok).To(BeTrue())
+		Expect(request.IsolationLevel).To(Equal(sarama.ReadCommitted))
+	})
+
+	It("returns error without leader", func() {
+		saramaClient.LeaderReturns(nil, errors.New(ctx, "banana"))
+		_, err := pkg.LastStableOffset(ctx, saramaClient, "orders", 0)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("returns error for Kafka before 0.11", func() {
+		config := sarama.NewConfig()
+		config.Version = sarama.V0_10_2_0
+		saramaClient.ConfigReturns(config)
+		_, err := pkg.LastStableOffset(ctx, saramaClient, "orders", 0)
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+// newLastStableOffsetBroker returns a broker answering offset requests of the partition
+// with offset, set as leader of saramaClient.
+func newLastStableOffsetBroker(
+	saramaClient *mocks.SaramaClient,
+	topic string,
+	partition int32,
+	offset int64,
+) *sarama.MockBroker {
+	return newMockLeader(saramaClient, map[string]sarama.MockResponse{
+		"OffsetRequest": sarama.NewMockOffsetResponse(GinkgoT()).
+			SetOffset(topic, partition, sarama.OffsetNewest, offset),
+	})
+}
+
+// newMockLeader returns a broker answering with the handlers, set as leader of
+// saramaClient.
+func newMockLeader(
+	saramaClient *mocks.SaramaClient,
+	handlers map[string]sarama.MockResponse,
+) *sarama.MockBroker {
+	mockBroker := sarama.NewMockBroker(GinkgoT(), 1)
+	DeferCleanup(mockBroker.Close)
+	mockBroker.SetHandlerByMap(handlers)
+	config := sarama.NewConfig()
+	config.ApiVersionsRequest = false
+	broker := sarama.NewBroker(mockBroker.Addr())
+	Expect(broker.Open(config)).To(Succeed())
+	DeferCleanup(func() {
+		_ = broker.Close()
+	})
+	saramaClient.LeaderReturns(broker, nil)
+	saramaClient.ConfigReturns(config)
+	return mockBroker
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// LimitReason tells why a request was rejected with 429.
+type LimitReason string
+
+const (
+	LimitReasonRateLimit   LimitReason = "rate_limit"
+	LimitReasonConcurrency LimitReason = "concurrency"
+)
+
+//counterfeiter:generate -o ../mocks/limit-metrics.go --fake-name LimitMetrics . LimitMetrics
+type LimitMetrics interface {
+	SetLimits(requestsPerSecond float64, burst int, maxConcurrentReads int)
+	Rejected(reason LimitReason)
+	ReadStarted()
+	ReadFinished()
+}
+
+func NewLimitMetrics(
+	registerer prometheus.Registerer,
+	namespace string,
+) LimitMetrics {
+	l := &limitMetrics{
+		rateLimitRequestsPerSecond: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "rate_limit_requests_per_second",
+				Help:      "Configured requests per second per client, 0 if disabled.",
+			},
+		),
+		rateLimitBurst: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "rate_limit_burst",
+				Help:      "Configured burst of requests per client.",
+			},
+		),
+		maxConcurrentReads: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "max_concurrent_reads",
+				Help:      "Configured maximum of concurrent reads, 0 if unlimited.",
+			},
+		),
+		concurrentReads: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "concurrent_reads",
+				Help:      "Reads currently running.",
+			},
+		),
+		rejectedRequests: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "rejected_requests_total",
+				Help:      "Requests rejected with 429 by reason.",
+			},
+			[]string{"reason"},
+		),
+	}
+	registerer.MustRegister(
+		l.rateLimitRequestsPerSecond,
+		l.rateLimitBurst,
+		l.maxConcurrentReads,
+		l.concurrentReads,
+		l.rejectedRequests,
+	)
+	return l
+}
+
+type limitMetrics struct {
+	rateLimitRequestsPerSecond prometheus.Gauge
+	rateLimitBurst             prometheus.Gauge
+	maxConcurrentReads         prometheus.Gauge
+	concurrentReads            prometheus.Gauge
+	rejectedRequests           *prometheus.CounterVec
+}
+
+func (l *limitMetrics) SetLimits(requestsPerSecond float64, burst int, maxConcurrentReads int) {
+	l.rateLimitRequestsPerSecond.Set(requestsPerSecond)
+	l.rateLimitBurst.Set(float64(burst))
+	l.maxConcurrentReads.Set(float64(maxConcurrentReads))
+}
+
+func (l *limitMetrics) Rejected(reason LimitReason) {
+	l.rejectedRequests.WithLabelValues(string(reason)).Inc()
+}
+
+func (l *limitMetrics) ReadStarted() {
+	l.concurrentReads.Inc()
+}
+
+func (l *limitMetrics) ReadFinished() {
+	l.concurrentReads.Dec()
+}
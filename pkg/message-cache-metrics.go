@@ -0,0 +1,76 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+//counterfeiter:generate -o ../mocks/message-cache-metrics.go --fake-name MessageCacheMetrics . MessageCacheMetrics
+type MessageCacheMetrics interface {
+	Hit()
+	Miss()
+	Evicted()
+	BytesChanged(delta int64)
+}
+
+// NewMessageCacheMetrics is shared by the caches of all clusters.
+func NewMessageCacheMetrics(
+	registerer prometheus.Registerer,
+	namespace string,
+) MessageCacheMetrics {
+	m := &messageCacheMetrics{
+		lookups: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "message_cache_lookups_total",
+				Help:      "Lookups of an offset in the message cache by result hit or miss.",
+			},
+			[]string{"result"},
+		),
+		evictions: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "message_cache_evictions_total",
+				Help:      "Offset ranges evicted from the message cache to stay below the size limit.",
+			},
+		),
+		bytes: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "message_cache_bytes",
+				Help:      "Approximate size of the cached messages.",
+			},
+		),
+	}
+	registerer.MustRegister(
+		m.lookups,
+		m.evictions,
+		m.bytes,
+	)
+	return m
+}
+
+type messageCacheMetrics struct {
+	lookups   *prometheus.CounterVec
+	evictions prometheus.Counter
+	bytes     prometheus.Gauge
+}
+
+func (m *messageCacheMetrics) Hit() {
+	m.lookups.WithLabelValues("hit").Inc()
+}
+
+func (m *messageCacheMetrics) Miss() {
+	m.lookups.WithLabelValues("miss").Inc()
+}
+
+func (m *messageCacheMetrics) Evicted() {
+	m.evictions.Inc()
+}
+
+func (m *messageCacheMetrics) BytesChanged(delta int64) {
+	m.bytes.Add(float64(delta))
+}
@@ -0,0 +1,245 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"container/list"
+	"sort"
+	"sync"
+
+	"github.com/IBM/sarama"
+	libkafka "github.com/bborbe/kafka"
+)
+
+// messageOverheadBytes approximates the memory of a cached message besides key, value
+// and headers.
+const messageOverheadBytes = 128
+
+//counterfeiter:generate -o ../mocks/message-cache.go --fake-name MessageCache . MessageCache
+type MessageCache interface {
+	// Get returns the cached messages of the partition from offset up to next. Offsets
+	// between offset and next without message do not exist, like after compaction.
+	// ok is false if offset is not cached.
+	Get(
+		topic libkafka.Topic,
+		partition libkafka.Partition,
+		offset libkafka.Offset,
+	) (messages []*sarama.ConsumerMessage, next libkafka.Offset, ok bool)
+	// Writer caches the messages consumed from the partition starting at offset.
+	Writer(
+		topic libkafka.Topic,
+		partition libkafka.Partition,
+		offset libkafka.Offset,
+	) MessageCacheWriter
+}
+
+//counterfeiter:generate -o ../mocks/message-cache-writer.go --fake-name MessageCacheWriter . MessageCacheWriter
+type MessageCacheWriter interface {
+	// Add caches the next consumed message, messages must be added without skipping any.
+	Add(msg *sarama.ConsumerMessage)
+	// Close caches the messages added since the last flush.
+	Close()
+}
+
+// NewMessageCache caches consumed messages of all partitions up to maxBytes, the
+// least recently used offset ranges are evicted first. Messages below the high
+// watermark never change, so they can be served without reading Kafka again. maxBytes
+// 0 disables the cache.
+func NewMessageCache(
+	messageCacheMetrics MessageCacheMetrics,
+	maxBytes int64,
+) MessageCache {
+	// ranges are cached in chunks, so a long read does not evict everything else
+	chunkBytes := maxBytes / 16
+	if chunkBytes < 1 {
+		chunkBytes = 1
+	}
+	return &messageCache{
+		messageCacheMetrics: messageCacheMetrics,
+		maxBytes:            maxBytes,
+		chunkBytes:          chunkBytes,
+		ranges:              make(map[messageCacheKey][]*messageCacheRange),
+		lru:                 list.New(),
+	}
+}
+
+type messageCacheKey struct {
+	topic     libkafka.Topic
+	partition libkafka.Partition
+}
+
+// messageCacheRange contains all messages from offset up to next.
+type messageCacheRange struct {
+	key      messageCacheKey
+	offset   libkafka.Offset
+	next     libkafka.Offset
+	messages []*sarama.ConsumerMessage
+	bytes    int64
+	element  *list.Element
+}
+
+func (r *messageCacheRange) contains(offset libkafka.Offset) bool {
+	return r.offset <= offset && offset < r.next
+}
+
+type messageCache struct {
+	mux                 sync.Mutex
+	messageCacheMetrics MessageCacheMetrics
+	maxBytes            int64
+	chunkBytes          int64
+	bytes               int64
+	// ranges per partition sorted by offset
+	ranges map[messageCacheKey][]*messageCacheRange
+	// ranges with the most recently used first
+	lru *list.List
+}
+
+func (m *messageCache) Get(
+	topic libkafka.Topic,
+	partition libkafka.Partition,
+	offset libkafka.Offset,
+) ([]*sarama.ConsumerMessage, libkafka.Offset, bool) {
+	if m.maxBytes <= 0 {
+		return nil, 0, false
+	}
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	var found *messageCacheRange
+	for _, r := range m.ranges[messageCacheKey{topic: topic, partition: partition}] {
+		if r.contains(offset) && (found == nil || r.next > found.next) {
+			found = r
+		}
+	}
+	if found == nil {
+		m.messageCacheMetrics.Miss()
+		return nil, 0, false
+	}
+	m.messageCacheMetrics.Hit()
+	m.lru.MoveToFront(found.element)
+	index := sort.Search(len(found.messages), func(i int) bool {
+		return libkafka.Offset(found.messages[i].Offset) >= offset
+	})
+	return found.messages[index:], found.next, true
+}
+
+func (m *messageCache) Writer(
+	topic libkafka.Topic,
+	partition libkafka.Partition,
+	offset libkafka.Offset,
+) MessageCacheWriter {
+	return &messageCacheWriter{
+		cache:  m,
+		key:    messageCacheKey{topic: topic, partition: partition},
+		offset: offset,
+	}
+}
+
+func (m *messageCache) add(r *messageCacheRange) {
+	if m.maxBytes <= 0 || r.bytes > m.maxBytes {
+		return
+	}
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	// ranges covered by the new one are obsolete
+	ranges := m.ranges[r.key][:0]
+	for _, existing := range m.ranges[r.key] {
+		if r.offset <= existing.offset && existing.next <= r.next {
+			m.remove(existing)
+			continue
+		}
+		ranges = append(ranges, existing)
+	}
+	index := sort.Search(len(ranges), func(i int) bool {
+		return ranges[i].offset >= r.offset
+	})
+	ranges = append(ranges, nil)
+	copy(ranges[index+1:], ranges[index:])
+	ranges[index] = r
+	m.ranges[r.key] = ranges
+
+	r.element = m.lru.PushFront(r)
+	m.bytes += r.bytes
+	m.messageCacheMetrics.BytesChanged(r.bytes)
+
+	for m.bytes > m.maxBytes {
+		oldest := m.lru.Back().Value.(*messageCacheRange)
+		m.removeFromRanges(oldest)
+		m.remove(oldest)
+		m.messageCacheMetrics.Evicted()
+	}
+}
+
+// remove drops the range from the lru list and byte count, not from ranges.
+func (m *messageCache) remove(r *messageCacheRange) {
+	m.lru.Remove(r.element)
+	m.bytes -= r.bytes
+	m.messageCacheMetrics.BytesChanged(-r.bytes)
+}
+
+func (m *messageCache) removeFromRanges(r *messageCacheRange) {
+	ranges := m.ranges[r.key]
+	for i, existing := range ranges {
+		if existing == r {
+			ranges = append(ranges[:i], ranges[i+1:]...)
+			break
+		}
+	}
+	if len(ranges) == 0 {
+		delete(m.ranges, r.key)
+		return
+	}
+	m.ranges[r.key] = ranges
+}
+
+type messageCacheWriter struct {
+	cache    *messageCache
+	key      messageCacheKey
+	offset   libkafka.Offset
+	next     libkafka.Offset
+	messages []*sarama.ConsumerMessage
+	bytes    int64
+}
+
+func (w *messageCacheWriter) Add(msg *sarama.ConsumerMessage) {
+	if w.cache.maxBytes <= 0 {
+		return
+	}
+	w.messages = append(w.messages, msg)
+	w.bytes += messageBytes(msg)
+	w.next = libkafka.Offset(msg.Offset + 1)
+	if w.bytes >= w.cache.chunkBytes {
+		w.flush()
+	}
+}
+
+func (w *messageCacheWriter) Close() {
+	w.flush()
+}
+
+func (w *messageCacheWriter) flush() {
+	if len(w.messages) == 0 {
+		return
+	}
+	w.cache.add(&messageCacheRange{
+		key:      w.key,
+		offset:   w.offset,
+		next:     w.next,
+		messages: w.messages,
+		bytes:    w.bytes,
+	})
+	w.offset = w.next
+	w.messages = nil
+	w.bytes = 0
+}
+
+func messageBytes(msg *sarama.ConsumerMessage) int64 {
+	result := int64(messageOverheadBytes + len(msg.Key) + len(msg.Value))
+	for _, header := range msg.Headers {
+		result += int64(len(header.Key) + len(header.Value))
+	}
+	return result
+}
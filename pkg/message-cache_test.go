@@ -0,0 +1,189 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"bytes"
+
+	"github.com/IBM/sarama"
+	libkafka "github.com/bborbe/kafka"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/mocks"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("MessageCache", func() {
+	// 128 bytes overhead + 72 bytes value
+	const messageBytes = 200
+
+	var messageCacheMetrics *mocks.MessageCacheMetrics
+	var maxBytes int64
+	var messageCache pkg.MessageCache
+
+	newMessage := func(offset int64) *sarama.ConsumerMessage {
+		return &sarama.ConsumerMessage{
+			Topic:     "orders",
+			Partition: 1,
+			Offset:    offset,
+			Value:     bytes.Repeat([]byte("x"), messageBytes-128),
+		}
+	}
+
+	write := func(partition libkafka.Partition, offset libkafka.Offset, offsets ...int64) {
+		writer := messageCache.Writer("orders", partition, offset)
+		for _, offset := range offsets {
+			writer.Add(newMessage(offset))
+		}
+		writer.Close()
+	}
+
+	offsetsOf := func(messages []*sarama.ConsumerMessage) []int64 {
+		result := []int64{}
+		for _, msg := range messages {
+			result = append(result, msg.Offset)
+		}
+		return result
+	}
+
+	BeforeEach(func() {
+		messageCacheMetrics = &mocks.MessageCacheMetrics{}
+		maxBytes = 1024 * 1024
+	})
+
+	JustBeforeEach(func() {
+		messageCache = pkg.NewMessageCache(messageCacheMetrics, maxBytes)
+	})
+
+	It("misses if nothing is cached", func() {
+		_, _, ok := messageCache.Get("orders", 1, 10)
+		Expect(ok).To(BeFalse())
+		Expect(messageCacheMetrics.MissCallCount()).To(Equal(1))
+	})
+
+	Context("with cached range", func() {
+		JustBeforeEach(func() {
+			// offset 12 removed by compaction
+			write(1, 10, 10, 11, 13)
+		})
+
+		It("returns all messages of the range", func() {
+			messages, next, ok := messageCache.Get("orders", 1, 10)
+			Expect(ok).To(BeTrue())
+			Expect(offsetsOf(messages)).To(Equal([]int64{10, 11, 13}))
+			Expect(next).To(Equal(libkafka.Offset(14)))
+			Expect(messageCacheMetrics.HitCallCount()).To(Equal(1))
+		})
+
+		It("returns messages from an offset within the range", func() {
+			messages, next, ok := messageCache.Get("orders", 1, 12)
+			Expect(ok).To(BeTrue())
+			Expect(offsetsOf(messages)).To(Equal([]int64{13}))
+			Expect(next).To(Equal(libkafka.Offset(14)))
+		})
+
+		It("misses offsets before the range", func() {
+			_, _, ok := messageCache.Get("orders", 1, 9)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("misses offsets after the range", func() {
+			_, _, ok := messageCache.Get("orders", 1, 14)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("misses other partitions", func() {
+			_, _, ok := messageCache.Get("orders", 2, 10)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("tracks the size", func() {
+			Expect(messageCacheMetrics.BytesChangedCallCount()).To(Equal(1))
+			Expect(
+				messageCacheMetrics.BytesChangedArgsForCall(0),
+			).To(Equal(int64(3 * messageBytes)))
+		})
+
+		It("replaces the range by a larger one", func() {
+			write(1, 10, 10, 11, 13, 14)
+			messages, next, ok := messageCache.Get("orders", 1, 10)
+			Expect(ok).To(BeTrue())
+			Expect(offsetsOf(messages)).To(Equal([]int64{10, 11, 13, 14}))
+			Expect(next).To(Equal(libkafka.Offset(15)))
+			Expect(
+				messageCacheMetrics.BytesChangedArgsForCall(1),
+			).To(Equal(int64(-3 * messageBytes)))
+		})
+	})
+
+	Context("with long read", func() {
+		BeforeEach(func() {
+			// chunks of one message
+			maxBytes = 16 * messageBytes
+		})
+		JustBeforeEach(func() {
+			write(1, 10, 10, 11, 12)
+		})
+		It("caches the read in chunks", func() {
+			messages, next, ok := messageCache.Get("orders", 1, 10)
+			Expect(ok).To(BeTrue())
+			Expect(offsetsOf(messages)).To(Equal([]int64{10}))
+			Expect(next).To(Equal(libkafka.Offset(11)))
+
+			messages, next, ok = messageCache.Get("orders", 1, next)
+			Expect(ok).To(BeTrue())
+			Expect(offsetsOf(messages)).To(Equal([]int64{11}))
+			Expect(next).To(Equal(libkafka.Offset(12)))
+		})
+	})
+
+	Context("with max bytes reached", func() {
+		BeforeEach(func() {
+			maxBytes = 2 * messageBytes
+		})
+		JustBeforeEach(func() {
+			write(1, 10, 10)
+			write(1, 20, 20)
+		})
+		It("evicts the least recently used range", func() {
+			_, _, ok := messageCache.Get("orders", 1, 10)
+			Expect(ok).To(BeTrue())
+
+			write(1, 30, 30)
+			Expect(messageCacheMetrics.EvictedCallCount()).To(Equal(1))
+
+			_, _, ok = messageCache.Get("orders", 1, 20)
+			Expect(ok).To(BeFalse())
+			_, _, ok = messageCache.Get("orders", 1, 10)
+			Expect(ok).To(BeTrue())
+			_, _, ok = messageCache.Get("orders", 1, 30)
+			Expect(ok).To(BeTrue())
+		})
+	})
+
+	Context("with range larger than max bytes", func() {
+		BeforeEach(func() {
+			maxBytes = messageBytes / 2
+		})
+		It("does not cache it", func() {
+			write(1, 10, 10)
+			_, _, ok := messageCache.Get("orders", 1, 10)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Context("with max bytes 0", func() {
+		BeforeEach(func() {
+			maxBytes = 0
+		})
+		It("caches nothing", func() {
+			write(1, 10, 10)
+			_, _, ok := messageCache.Get("orders", 1, 10)
+			Expect(ok).To(BeFalse())
+			Expect(messageCacheMetrics.BytesChangedCallCount()).To(Equal(0))
+		})
+	})
+})
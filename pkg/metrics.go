@@ -0,0 +1,200 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"sync"
+	"time"
+
+	libkafka "github.com/bborbe/kafka"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// ReadStatusError is used as status in metrics for reads failed with an error.
+	ReadStatusError ReadStatus = "error"
+	// ReadStatusRejected is used as status in metrics for reads rejected by the concurrency limit.
+	ReadStatusRejected ReadStatus = "rejected"
+)
+
+// otherTopic is the topic label of reads of topics unknown to the cluster, the topic
+// parameter must not create a series per request.
+const otherTopic = "other"
+
+//counterfeiter:generate -o ../mocks/metrics.go --fake-name Metrics . Metrics
+type Metrics interface {
+	ReadCompleted(topic libkafka.Topic, status ReadStatus, duration time.Duration)
+	RecordsRead(topic libkafka.Topic, scanned uint64, returned int)
+	FilterChecked(topic libkafka.Topic, matched bool)
+	ConversionFailed(topic libkafka.Topic, reason ConversionFailureReason)
+	BytesRead(topic libkafka.Topic, bytes int)
+	FirstRecord(topic libkafka.Topic, duration time.Duration)
+}
+
+// MetricsFactory returns the metrics of the reads of a cluster, labeled with the
+// cluster. Topics unknown to the client of the cluster are labeled "other".
+type MetricsFactory func(cluster ClusterName, saramaClient libkafka.SaramaClient) Metrics
+
+// NewMetricsFactory registers the metrics shared by all clusters.
+func NewMetricsFactory(
+	registerer prometheus.Registerer,
+	namespace string,
+) MetricsFactory {
+	m := &metricVecs{
+		readRequests: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "read_requests_total",
+				Help:      "Read requests by topic and status.",
+			},
+			[]string{"cluster", "topic", "status"},
+		),
+		readDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Name:      "read_duration_seconds",
+				Help:      "Duration of read requests by topic.",
+				Buckets:   []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 15, 30, 60},
+			},
+			[]string{"cluster", "topic"},
+		),
+		recordsScanned: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "read_records_scanned_total",
+				Help:      "Messages consumed from Kafka by topic.",
+			},
+			[]string{"cluster", "topic"},
+		),
+		recordsReturned: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "read_records_returned_total",
+				Help:      "Records returned to clients by topic.",
+			},
+			[]string{"cluster", "topic"},
+		),
+		filterChecks: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "read_filter_checks_total",
+				Help:      "Messages checked against a filter by topic and result hit or miss.",
+			},
+			[]string{"cluster", "topic", "result"},
+		),
+		conversionFailures: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "read_conversion_failures_total",
+				Help:      "Messages that could not be converted by topic and reason.",
+			},
+			[]string{"cluster", "topic", "reason"},
+		),
+		bytesRead: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "read_bytes_total",
+				Help:      "Key and value bytes consumed from Kafka by topic.",
+			},
+			[]string{"cluster", "topic"},
+		),
+		firstRecord: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Name:      "read_time_to_first_record_seconds",
+				Help:      "Time from the start of a read to the first matching record by topic.",
+				Buckets:   []float64{0.005, 0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+			},
+			[]string{"cluster", "topic"},
+		),
+	}
+	registerer.MustRegister(
+		m.readRequests,
+		m.readDuration,
+		m.recordsScanned,
+		m.recordsReturned,
+		m.filterChecks,
+		m.conversionFailures,
+		m.bytesRead,
+		m.firstRecord,
+	)
+	return func(cluster ClusterName, saramaClient libkafka.SaramaClient) Metrics {
+		return &metrics{
+			metricVecs:   m,
+			cluster:      cluster.String(),
+			saramaClient: saramaClient,
+		}
+	}
+}
+
+type metricVecs struct {
+	readRequests       *prometheus.CounterVec
+	readDuration       *prometheus.HistogramVec
+	recordsScanned     *prometheus.CounterVec
+	recordsReturned    *prometheus.CounterVec
+	filterChecks       *prometheus.CounterVec
+	conversionFailures *prometheus.CounterVec
+	bytesRead          *prometheus.CounterVec
+	firstRecord        *prometheus.HistogramVec
+}
+
+type metrics struct {
+	*metricVecs
+	cluster      string
+	saramaClient libkafka.SaramaClient
+	// knownTopics caches the topics found in the metadata of the client
+	knownTopics sync.Map
+}
+
+// topic returns the topic label, otherTopic if the topic is not in the metadata of the
+// client. Only existing topics are cached, so unknown topics stay bounded.
+func (m *metrics) topic(topic libkafka.Topic) string {
+	if _, ok := m.knownTopics.Load(topic); ok {
+		return topic.String()
+	}
+	topics, err := m.saramaClient.Topics()
+	if err != nil {
+		return otherTopic
+	}
+	for _, known := range topics {
+		if known == topic.String() {
+			m.knownTopics.Store(topic, true)
+			return topic.String()
+		}
+	}
+	return otherTopic
+}
+
+func (m *metrics) ReadCompleted(topic libkafka.Topic, status ReadStatus, duration time.Duration) {
+	label := m.topic(topic)
+	m.readRequests.WithLabelValues(m.cluster, label, string(status)).Inc()
+	m.readDuration.WithLabelValues(m.cluster, label).Observe(duration.Seconds())
+}
+
+func (m *metrics) RecordsRead(topic libkafka.Topic, scanned uint64, returned int) {
+	label := m.topic(topic)
+	m.recordsScanned.WithLabelValues(m.cluster, label).Add(float64(scanned))
+	m.recordsReturned.WithLabelValues(m.cluster, label).Add(float64(returned))
+}
+
+func (m *metrics) FilterChecked(topic libkafka.Topic, matched bool) {
+	result := "miss"
+	if matched {
+		result = "hit"
+	}
+	m.filterChecks.WithLabelValues(m.cluster, m.topic(topic), result).Inc()
+}
+
+func (m *metrics) ConversionFailed(topic libkafka.Topic, reason ConversionFailureReason) {
+	m.conversionFailures.WithLabelValues(m.cluster, m.topic(topic), string(reason)).Inc()
+}
+
+func (m *metrics) BytesRead(topic libkafka.Topic, bytes int) {
+	m.bytesRead.WithLabelValues(m.cluster, m.topic(topic)).Add(float64(bytes))
+}
+
+func (m *metrics) FirstRecord(topic libkafka.Topic, duration time.Duration) {
+	m.firstRecord.WithLabelValues(m.cluster, m.topic(topic)).Observe(duration.Seconds())
+}
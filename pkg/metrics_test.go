@@ -0,0 +1,97 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/bborbe/kafka-topic-reader/mocks"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("Metrics", func() {
+	var registry *prometheus.Registry
+	var saramaClient *mocks.SaramaClient
+	var createMetrics pkg.MetricsFactory
+	var metrics pkg.Metrics
+
+	BeforeEach(func() {
+		registry = prometheus.NewRegistry()
+		saramaClient = &mocks.SaramaClient{}
+		saramaClient.TopicsReturns([]string{"orders", "payments"}, nil)
+		createMetrics = pkg.NewMetricsFactory(registry, "test")
+		metrics = createMetrics("prod", saramaClient)
+	})
+
+	It("counts requests by topic and status", func() {
+		metrics.ReadCompleted("orders", pkg.ReadStatusLimitReached, time.Second)
+		metrics.ReadCompleted("orders", pkg.ReadStatusLimitReached, time.Second)
+		metrics.ReadCompleted("orders", pkg.ReadStatusError, time.Second)
+		Expect(testutil.CollectAndCount(registry, "test_read_requests_total")).To(Equal(2))
+		Expect(testutil.CollectAndCount(registry, "test_read_duration_seconds")).To(Equal(1))
+	})
+
+	It("counts scanned and returned records", func() {
+		metrics.RecordsRead("orders", 10, 3)
+		metrics.BytesRead("orders", 42)
+		Expect(testutil.CollectAndCount(
+			registry,
+			"test_read_records_scanned_total",
+			"test_read_records_returned_total",
+			"test_read_bytes_total",
+		)).To(Equal(3))
+	})
+
+	It("counts filter hits and misses", func() {
+		metrics.FilterChecked("orders", true)
+		metrics.FilterChecked("orders", false)
+		Expect(testutil.CollectAndCount(registry, "test_read_filter_checks_total")).To(Equal(2))
+	})
+
+	It("counts conversion failures by reason", func() {
+		metrics.ConversionFailed("orders", pkg.ConversionFailureReasonInvalidJSON)
+		metrics.FirstRecord("orders", time.Millisecond)
+		Expect(testutil.CollectAndCount(
+			registry,
+			"test_read_conversion_failures_total",
+			"test_read_time_to_first_record_seconds",
+		)).To(Equal(2))
+	})
+
+	It("labels with the cluster", func() {
+		createMetrics("dev", saramaClient).BytesRead("orders", 1)
+		metrics.BytesRead("orders", 2)
+		Expect(testutil.GatherAndCompare(registry, strings.NewReader(`
+# HELP test_read_bytes_total Key and value bytes consumed from Kafka by topic.
+# TYPE test_read_bytes_total counter
+test_read_bytes_total{cluster="dev",topic="orders"} 1
+test_read_bytes_total{cluster="prod",topic="orders"} 2
+`), "test_read_bytes_total")).To(Succeed())
+	})
+
+	It("labels unknown topics as other", func() {
+		metrics.ReadCompleted("unknown-1", pkg.ReadStatusError, time.Second)
+		metrics.ReadCompleted("unknown-2", pkg.ReadStatusError, time.Second)
+		metrics.ReadCompleted("payments", pkg.ReadStatusError, time.Second)
+		Expect(testutil.GatherAndCompare(registry, strings.NewReader(`
+# HELP test_read_requests_total Read requests by topic and status.
+# TYPE test_read_requests_total counter
+test_read_requests_total{cluster="prod",status="error",topic="other"} 2
+test_read_requests_total{cluster="prod",status="error",topic="payments"} 1
+`), "test_read_requests_total")).To(Succeed())
+	})
+
+	It("looks up a known topic once", func() {
+		metrics.BytesRead("orders", 1)
+		metrics.BytesRead("orders", 1)
+		Expect(saramaClient.TopicsCallCount()).To(Equal(1))
+	})
+})
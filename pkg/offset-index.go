@@ -0,0 +1,500 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/bborbe/errors"
+	libkafka "github.com/bborbe/kafka"
+	bolt "go.etcd.io/bbolt"
+	bolterrors "go.etcd.io/bbolt/errors"
+)
+
+var (
+	offsetIndexKeysBucket  = []byte("keys")
+	offsetIndexTimesBucket = []byte("times")
+	offsetIndexNextKey     = []byte("next")
+	offsetIndexMaxTimeKey  = []byte("maxTime")
+)
+
+// offsetIndexKeyHashLength is the length of the key hash, keys of any length are
+// indexed with the same size.
+const offsetIndexKeyHashLength = 16
+
+// IndexEntry is an indexed message.
+type IndexEntry struct {
+	Offset    libkafka.Offset
+	Key       []byte
+	Timestamp time.Time
+}
+
+// IndexedOffset is the offset of a message found in the index.
+type IndexedOffset struct {
+	Partition libkafka.Partition `json:"partition"`
+	Offset    libkafka.Offset    `json:"offset"`
+}
+
+// IndexProgress tells up to which offset a partition is indexed.
+type IndexProgress struct {
+	Partition  libkafka.Partition `json:"partition"`
+	NextOffset libkafka.Offset    `json:"nextOffset"`
+}
+
+//counterfeiter:generate -o ../mocks/offset-index.go --fake-name OffsetIndex . OffsetIndex
+type OffsetIndex interface {
+	// Add indexes the entries of the partition, which must follow the already indexed
+	// ones in offset order, and continues the partition after the last entry.
+	Add(
+		ctx context.Context,
+		topic libkafka.Topic,
+		partition libkafka.Partition,
+		entries []IndexEntry,
+	) error
+	// Progress returns the next offset of every indexed partition of the topic.
+	Progress(ctx context.Context, topic libkafka.Topic) ([]IndexProgress, error)
+	// KeyOffsets returns up to limit offsets of messages with the key in partition and
+	// offset order.
+	KeyOffsets(
+		ctx context.Context,
+		topic libkafka.Topic,
+		key []byte,
+		limit int,
+	) ([]IndexedOffset, error)
+	// TimeOffset returns an offset before which all messages of the partition are older
+	// than t. It returns false if t is outside of the indexed time range.
+	TimeOffset(
+		ctx context.Context,
+		topic libkafka.Topic,
+		partition libkafka.Partition,
+		t time.Time,
+	) (libkafka.Offset, bool, error)
+	// Prune removes the entries of messages below the low watermark of the partition.
+	Prune(
+		ctx context.Context,
+		topic libkafka.Topic,
+		partition libkafka.Partition,
+		lowWaterMark libkafka.Offset,
+	) error
+	// Remove deletes the index of the topic.
+	Remove(ctx context.Context, topic libkafka.Topic) error
+	// Compact rewrites the index file to release the space of removed entries.
+	Compact(ctx context.Context) error
+	Close() error
+}
+
+// NewOffsetIndex opens or creates the index file at path. Times are indexed in buckets
+// of timeBucket, a jump to a time starts at most one bucket early.
+func NewOffsetIndex(
+	ctx context.Context,
+	path string,
+	timeBucket time.Duration,
+) (OffsetIndex, error) {
+	if timeBucket < time.Millisecond {
+		return nil, errors.Errorf(ctx, "time bucket %v must be at least 1ms", timeBucket)
+	}
+	db, err := openOffsetIndex(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return &offsetIndex{
+		path:       path,
+		db:         db,
+		timeBucket: timeBucket,
+	}, nil
+}
+
+func openOffsetIndex(ctx context.Context, path string) (*bolt.DB, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "open offset index %s failed", path)
+	}
+	return db, nil
+}
+
+// offsetIndex stores per topic and partition a bucket with the key hashes followed by
+// the offset and a bucket with the first offset at which the max timestamp reached a
+// time bucket. The mutex only guards replacing db on compaction.
+type offsetIndex struct {
+	mux        sync.RWMutex
+	path       string
+	db         *bolt.DB
+	timeBucket time.Duration
+}
+
+func (o *offsetIndex) Add(
+	ctx context.Context,
+	topic libkafka.Topic,
+	partition libkafka.Partition,
+	entries []IndexEntry,
+) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	o.mux.RLock()
+	defer o.mux.RUnlock()
+	err := o.db.Update(func(tx *bolt.Tx) error {
+		partitionBucket, err := createPartitionBucket(tx, topic, partition)
+		if err != nil {
+			return err
+		}
+		keys := partitionBucket.Bucket(offsetIndexKeysBucket)
+		times := partitionBucket.Bucket(offsetIndexTimesBucket)
+		maxTime, hasMaxTime := decodeInt64(partitionBucket.Get(offsetIndexMaxTimeKey))
+		for _, entry := range entries {
+			if entry.Key != nil {
+				if err := keys.Put(keyOffsetKey(entry.Key, entry.Offset), []byte{}); err != nil {
+					return err
+				}
+			}
+			timestamp := entry.Timestamp.UnixMilli()
+			if timestamp < 0 || hasMaxTime && timestamp <= maxTime {
+				// messages without timestamp keep the max timestamp
+				continue
+			}
+			bucket := o.bucketOf(timestamp)
+			if !hasMaxTime || bucket > o.bucketOf(maxTime) {
+				if err := times.Put(encodeInt64(bucket), encodeInt64(int64(entry.Offset))); err != nil {
+					return err
+				}
+			}
+			maxTime, hasMaxTime = timestamp, true
+		}
+		if hasMaxTime {
+			if err := partitionBucket.Put(offsetIndexMaxTimeKey, encodeInt64(maxTime)); err != nil {
+				return err
+			}
+		}
+		next := entries[len(entries)-1].Offset + 1
+		return partitionBucket.Put(offsetIndexNextKey, encodeInt64(int64(next)))
+	})
+	if err != nil {
+		return errors.Wrapf(
+			ctx,
+			err,
+			"index %d messages of topic %s partition %d failed",
+			len(entries),
+			topic,
+			partition,
+		)
+	}
+	return nil
+}
+
+// bucketOf returns the start of the time bucket in unix milliseconds.
+func (o *offsetIndex) bucketOf(timestamp int64) int64 {
+	return timestamp - timestamp%o.timeBucket.Milliseconds()
+}
+
+func (o *offsetIndex) Progress(
+	ctx context.Context,
+	topic libkafka.Topic,
+) ([]IndexProgress, error) {
+	o.mux.RLock()
+	defer o.mux.RUnlock()
+	var result []IndexProgress
+	err := o.db.View(func(tx *bolt.Tx) error {
+		return forEachPartitionBucket(
+			tx,
+			topic,
+			func(partition libkafka.Partition, bucket *bolt.Bucket) error {
+				next, _ := decodeInt64(bucket.Get(offsetIndexNextKey))
+				result = append(result, IndexProgress{
+					Partition:  partition,
+					NextOffset: libkafka.Offset(next),
+				})
+				return nil
+			},
+		)
+	})
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "get index progress of topic %s failed", topic)
+	}
+	return result, nil
+}
+
+func (o *offsetIndex) KeyOffsets(
+	ctx context.Context,
+	topic libkafka.Topic,
+	key []byte,
+	limit int,
+) ([]IndexedOffset, error) {
+	o.mux.RLock()
+	defer o.mux.RUnlock()
+	prefix := keyHash(key)
+	var result []IndexedOffset
+	err := o.db.View(func(tx *bolt.Tx) error {
+		return forEachPartitionBucket(
+			tx,
+			topic,
+			func(partition libkafka.Partition, bucket *bolt.Bucket) error {
+				cursor := bucket.Bucket(offsetIndexKeysBucket).Cursor()
+				for k, _ := cursor.Seek(prefix); k != nil && len(result) < limit; k, _ = cursor.Next() {
+					if string(k[:offsetIndexKeyHashLength]) != string(prefix) {
+						break
+					}
+					offset, _ := decodeInt64(k[offsetIndexKeyHashLength:])
+					result = append(result, IndexedOffset{
+						Partition: partition,
+						Offset:    libkafka.Offset(offset),
+					})
+				}
+				return nil
+			},
+		)
+	})
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "get offsets of key in topic %s failed", topic)
+	}
+	return result, nil
+}
+
+func (o *offsetIndex) TimeOffset(
+	ctx context.Context,
+	topic libkafka.Topic,
+	partition libkafka.Partition,
+	t time.Time,
+) (libkafka.Offset, bool, error) {
+	o.mux.RLock()
+	defer o.mux.RUnlock()
+	timestamp := t.UnixMilli()
+	var result libkafka.Offset
+	var found bool
+	err := o.db.View(func(tx *bolt.Tx) error {
+		partitionBucket := findPartitionBucket(tx, topic, partition)
+		if partitionBucket == nil {
+			return nil
+		}
+		maxTime, ok := decodeInt64(partitionBucket.Get(offsetIndexMaxTimeKey))
+		if !ok || timestamp > maxTime {
+			// messages after the indexed ones may be at t
+			return nil
+		}
+		cursor := partitionBucket.Bucket(offsetIndexTimesBucket).Cursor()
+		// the last bucket starting at or before t
+		k, v := cursor.Seek(encodeInt64(timestamp))
+		switch {
+		case k == nil:
+			k, v = cursor.Last()
+		case string(k) != string(encodeInt64(timestamp)):
+			k, v = cursor.Prev()
+		}
+		if k == nil {
+			// t is before the first indexed message
+			return nil
+		}
+		offset, _ := decodeInt64(v)
+		result, found = libkafka.Offset(offset), true
+		return nil
+	})
+	if err != nil {
+		return 0, false, errors.Wrapf(
+			ctx,
+			err,
+			"get offset of time in topic %s partition %d failed",
+			topic,
+			partition,
+		)
+	}
+	return result, found, nil
+}
+
+func (o *offsetIndex) Prune(
+	ctx context.Context,
+	topic libkafka.Topic,
+	partition libkafka.Partition,
+	lowWaterMark libkafka.Offset,
+) error {
+	o.mux.RLock()
+	defer o.mux.RUnlock()
+	err := o.db.Update(func(tx *bolt.Tx) error {
+		partitionBucket := findPartitionBucket(tx, topic, partition)
+		if partitionBucket == nil {
+			return nil
+		}
+		if err := pruneBucket(partitionBucket.Bucket(offsetIndexKeysBucket), func(k, v []byte) bool {
+			offset, _ := decodeInt64(k[offsetIndexKeyHashLength:])
+			return libkafka.Offset(offset) < lowWaterMark
+		}); err != nil {
+			return err
+		}
+		return pruneBucket(partitionBucket.Bucket(offsetIndexTimesBucket), func(k, v []byte) bool {
+			offset, _ := decodeInt64(v)
+			return libkafka.Offset(offset) < lowWaterMark
+		})
+	})
+	if err != nil {
+		return errors.Wrapf(
+			ctx,
+			err,
+			"prune index of topic %s partition %d failed",
+			topic,
+			partition,
+		)
+	}
+	return nil
+}
+
+// pruneBucket deletes the matching entries, collected first as a cursor skips entries
+// after a delete.
+func pruneBucket(bucket *bolt.Bucket, matches func(k, v []byte) bool) error {
+	var keys [][]byte
+	err := bucket.ForEach(func(k, v []byte) error {
+		if matches(k, v) {
+			keys = append(keys, append([]byte{}, k...))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, k := range keys {
+		if err := bucket.Delete(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *offsetIndex) Remove(ctx context.Context, topic libkafka.Topic) error {
+	o.mux.RLock()
+	defer o.mux.RUnlock()
+	err := o.db.Update(func(tx *bolt.Tx) error {
+		err := tx.DeleteBucket([]byte(topic))
+		if errors.Is(err, bolterrors.ErrBucketNotFound) {
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return errors.Wrapf(ctx, err, "remove index of topic %s failed", topic)
+	}
+	return nil
+}
+
+func (o *offsetIndex) Compact(ctx context.Context) error {
+	o.mux.Lock()
+	defer o.mux.Unlock()
+	compactPath := o.path + ".compact"
+	if err := os.Remove(compactPath); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(ctx, err, "remove %s failed", compactPath)
+	}
+	compactDB, err := openOffsetIndex(ctx, compactPath)
+	if err != nil {
+		return err
+	}
+	if err := bolt.Compact(compactDB, o.db, 64<<20); err != nil {
+		_ = compactDB.Close()
+		return errors.Wrapf(ctx, err, "compact offset index %s failed", o.path)
+	}
+	if err := compactDB.Close(); err != nil {
+		return errors.Wrapf(ctx, err, "close %s failed", compactPath)
+	}
+	if err := o.db.Close(); err != nil {
+		return errors.Wrapf(ctx, err, "close offset index %s failed", o.path)
+	}
+	if err := os.Rename(compactPath, o.path); err != nil {
+		return errors.Wrapf(ctx, err, "replace offset index %s failed", o.path)
+	}
+	o.db, err = openOffsetIndex(ctx, o.path)
+	return err
+}
+
+func (o *offsetIndex) Close() error {
+	o.mux.Lock()
+	defer o.mux.Unlock()
+	return o.db.Close()
+}
+
+func createPartitionBucket(
+	tx *bolt.Tx,
+	topic libkafka.Topic,
+	partition libkafka.Partition,
+) (*bolt.Bucket, error) {
+	topicBucket, err := tx.CreateBucketIfNotExists([]byte(topic))
+	if err != nil {
+		return nil, err
+	}
+	partitionBucket, err := topicBucket.CreateBucketIfNotExists(encodePartition(partition))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := partitionBucket.CreateBucketIfNotExists(offsetIndexKeysBucket); err != nil {
+		return nil, err
+	}
+	if _, err := partitionBucket.CreateBucketIfNotExists(offsetIndexTimesBucket); err != nil {
+		return nil, err
+	}
+	return partitionBucket, nil
+}
+
+func findPartitionBucket(
+	tx *bolt.Tx,
+	topic libkafka.Topic,
+	partition libkafka.Partition,
+) *bolt.Bucket {
+	topicBucket := tx.Bucket([]byte(topic))
+	if topicBucket == nil {
+		return nil
+	}
+	return topicBucket.Bucket(encodePartition(partition))
+}
+
+// forEachPartitionBucket calls fn for the partitions of the topic in partition order.
+func forEachPartitionBucket(
+	tx *bolt.Tx,
+	topic libkafka.Topic,
+	fn func(partition libkafka.Partition, bucket *bolt.Bucket) error,
+) error {
+	topicBucket := tx.Bucket([]byte(topic))
+	if topicBucket == nil {
+		return nil
+	}
+	return topicBucket.ForEachBucket(func(k []byte) error {
+		return fn(decodePartition(k), topicBucket.Bucket(k))
+	})
+}
+
+func keyHash(key []byte) []byte {
+	sum := sha256.Sum256(key)
+	return sum[:offsetIndexKeyHashLength]
+}
+
+func keyOffsetKey(key []byte, offset libkafka.Offset) []byte {
+	return append(keyHash(key), encodeInt64(int64(offset))...)
+}
+
+// encodeInt64 keeps the order of non-negative values in byte order.
+func encodeInt64(value int64) []byte {
+	result := make([]byte, 8)
+	// #nosec G115 -- only offsets and timestamps, which are not negative
+	binary.BigEndian.PutUint64(result, uint64(value))
+	return result
+}
+
+func decodeInt64(value []byte) (int64, bool) {
+	if len(value) != 8 {
+		return 0, false
+	}
+	// #nosec G115 -- reverses encodeInt64
+	return int64(binary.BigEndian.Uint64(value)), true
+}
+
+func encodePartition(partition libkafka.Partition) []byte {
+	result := make([]byte, 4)
+	// #nosec G115 -- partitions are not negative
+	binary.BigEndian.PutUint32(result, uint32(partition))
+	return result
+}
+
+func decodePartition(value []byte) libkafka.Partition {
+	// #nosec G115 -- reverses encodePartition
+	return libkafka.Partition(int32(binary.BigEndian.Uint32(value)))
+}
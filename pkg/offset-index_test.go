@@ -0,0 +1,173 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	libkafka "github.com/bborbe/kafka"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("OffsetIndex", func() {
+	var ctx context.Context
+	var path string
+	var offsetIndex pkg.OffsetIndex
+	var start time.Time
+
+	// entries returns count messages starting at offset with the keys order-0..order-9
+	// and one second between their timestamps
+	entries := func(offset libkafka.Offset, count int) []pkg.IndexEntry {
+		result := make([]pkg.IndexEntry, 0, count)
+		for i := range count {
+			current := offset + libkafka.Offset(i)
+			result = append(result, pkg.IndexEntry{
+				Offset:    current,
+				Key:       []byte(fmt.Sprintf("order-%d", current%10)),
+				Timestamp: start.Add(time.Duration(current) * time.Second),
+			})
+		}
+		return result
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		path = filepath.Join(GinkgoT().TempDir(), "default.db")
+		start = time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC)
+		var err error
+		offsetIndex, err = pkg.NewOffsetIndex(ctx, path, time.Minute)
+		Expect(err).To(BeNil())
+		Expect(offsetIndex.Add(ctx, "orders", 0, entries(0, 300))).To(Succeed())
+		Expect(offsetIndex.Add(ctx, "orders", 1, entries(1000, 5))).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(offsetIndex.Close()).To(Succeed())
+	})
+
+	It("rejects a time bucket below 1ms", func() {
+		_, err := pkg.NewOffsetIndex(ctx, filepath.Join(GinkgoT().TempDir(), "x.db"), 0)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("returns the progress of every partition", func() {
+		progress, err := offsetIndex.Progress(ctx, "orders")
+		Expect(err).To(BeNil())
+		Expect(progress).To(Equal([]pkg.IndexProgress{
+			{Partition: 0, NextOffset: 300},
+			{Partition: 1, NextOffset: 1005},
+		}))
+	})
+
+	It("returns no progress of an unknown topic", func() {
+		progress, err := offsetIndex.Progress(ctx, "unknown")
+		Expect(err).To(BeNil())
+		Expect(progress).To(BeEmpty())
+	})
+
+	It("returns the offsets of a key in order", func() {
+		offsets, err := offsetIndex.KeyOffsets(ctx, "orders", []byte("order-3"), 100)
+		Expect(err).To(BeNil())
+		Expect(offsets).To(HaveLen(31))
+		Expect(offsets[0]).To(Equal(pkg.IndexedOffset{Partition: 0, Offset: 3}))
+		Expect(offsets[1]).To(Equal(pkg.IndexedOffset{Partition: 0, Offset: 13}))
+		Expect(offsets[30]).To(Equal(pkg.IndexedOffset{Partition: 1, Offset: 1003}))
+	})
+
+	It("limits the offsets of a key", func() {
+		offsets, err := offsetIndex.KeyOffsets(ctx, "orders", []byte("order-3"), 2)
+		Expect(err).To(BeNil())
+		Expect(offsets).To(HaveLen(2))
+	})
+
+	It("returns no offsets of an unknown key", func() {
+		offsets, err := offsetIndex.KeyOffsets(ctx, "orders", []byte("order-42"), 100)
+		Expect(err).To(BeNil())
+		Expect(offsets).To(BeEmpty())
+	})
+
+	DescribeTable("TimeOffset",
+		func(after time.Duration, expectedOffset libkafka.Offset, expectedFound bool) {
+			offset, found, err := offsetIndex.TimeOffset(ctx, "orders", 0, start.Add(after))
+			Expect(err).To(BeNil())
+			Expect(found).To(Equal(expectedFound))
+			Expect(offset).To(Equal(expectedOffset))
+		},
+		Entry("first message", time.Duration(0), libkafka.Offset(0), true),
+		Entry("within first bucket", 30*time.Second, libkafka.Offset(0), true),
+		Entry("start of second bucket", time.Minute, libkafka.Offset(60), true),
+		Entry("within third bucket", 150*time.Second, libkafka.Offset(120), true),
+		Entry("last message", 299*time.Second, libkafka.Offset(240), true),
+		Entry("after last message", 300*time.Second, libkafka.Offset(0), false),
+		Entry("before first message", -time.Second, libkafka.Offset(0), false),
+	)
+
+	It("keeps the max timestamp for late messages", func() {
+		Expect(offsetIndex.Add(ctx, "orders", 2, []pkg.IndexEntry{
+			{Offset: 0, Timestamp: start.Add(2 * time.Minute)},
+			{Offset: 1, Timestamp: start},
+			{Offset: 2, Timestamp: start.Add(3 * time.Minute)},
+		})).To(Succeed())
+		offset, found, err := offsetIndex.TimeOffset(ctx, "orders", 2, start.Add(150*time.Second))
+		Expect(err).To(BeNil())
+		Expect(found).To(BeTrue())
+		Expect(offset).To(Equal(libkafka.Offset(0)))
+
+		offset, found, err = offsetIndex.TimeOffset(ctx, "orders", 2, start.Add(3*time.Minute))
+		Expect(err).To(BeNil())
+		Expect(found).To(BeTrue())
+		Expect(offset).To(Equal(libkafka.Offset(2)))
+	})
+
+	It("prunes entries below the low watermark", func() {
+		Expect(offsetIndex.Prune(ctx, "orders", 0, 100)).To(Succeed())
+
+		offsets, err := offsetIndex.KeyOffsets(ctx, "orders", []byte("order-3"), 100)
+		Expect(err).To(BeNil())
+		Expect(offsets).To(HaveLen(21))
+		Expect(offsets[0]).To(Equal(pkg.IndexedOffset{Partition: 0, Offset: 103}))
+
+		_, found, err := offsetIndex.TimeOffset(ctx, "orders", 0, start.Add(30*time.Second))
+		Expect(err).To(BeNil())
+		Expect(found).To(BeFalse())
+
+		offset, found, err := offsetIndex.TimeOffset(ctx, "orders", 0, start.Add(2*time.Minute))
+		Expect(err).To(BeNil())
+		Expect(found).To(BeTrue())
+		Expect(offset).To(Equal(libkafka.Offset(120)))
+	})
+
+	It("removes a topic", func() {
+		Expect(offsetIndex.Remove(ctx, "orders")).To(Succeed())
+		progress, err := offsetIndex.Progress(ctx, "orders")
+		Expect(err).To(BeNil())
+		Expect(progress).To(BeEmpty())
+		Expect(offsetIndex.Remove(ctx, "orders")).To(Succeed())
+	})
+
+	It("keeps the entries on compaction", func() {
+		Expect(offsetIndex.Compact(ctx)).To(Succeed())
+		offsets, err := offsetIndex.KeyOffsets(ctx, "orders", []byte("order-3"), 100)
+		Expect(err).To(BeNil())
+		Expect(offsets).To(HaveLen(31))
+		Expect(offsetIndex.Add(ctx, "orders", 1, entries(1005, 5))).To(Succeed())
+	})
+
+	It("keeps the entries after reopen", func() {
+		Expect(offsetIndex.Close()).To(Succeed())
+		var err error
+		offsetIndex, err = pkg.NewOffsetIndex(ctx, path, time.Minute)
+		Expect(err).To(BeNil())
+		progress, err := offsetIndex.Progress(ctx, "orders")
+		Expect(err).To(BeNil())
+		Expect(progress).To(HaveLen(2))
+	})
+})
@@ -0,0 +1,108 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+	"iter"
+	"sync"
+
+	"github.com/IBM/sarama"
+)
+
+// convertedMessage is the result of filter, conversion and redaction of a message.
+type convertedMessage struct {
+	msg *sarama.ConsumerMessage
+	// record is nil if the message does not match the filter
+	record *Record
+	err    error
+}
+
+type conversionJob struct {
+	msg    *sarama.ConsumerMessage
+	result chan convertedMessage
+}
+
+// convert returns the converted messages in the order of messages. With more than one
+// conversion worker the messages are converted in parallel, reading up to twice the
+// workers messages ahead.
+func (c *changesProvider) convert(
+	ctx context.Context,
+	messages iter.Seq2[*sarama.ConsumerMessage, error],
+	filter []byte,
+) iter.Seq[convertedMessage] {
+	if c.conversionWorkers > 1 {
+		return c.convertParallel(ctx, messages, filter)
+	}
+	return func(yield func(convertedMessage) bool) {
+		for msg, err := range messages {
+			if err != nil {
+				yield(convertedMessage{err: err})
+				return
+			}
+			record, err := c.record(ctx, msg, filter)
+			if !yield(convertedMessage{msg: msg, record: record, err: err}) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (c *changesProvider) convertParallel(
+	ctx context.Context,
+	messages iter.Seq2[*sarama.ConsumerMessage, error],
+	filter []byte,
+) iter.Seq[convertedMessage] {
+	return func(yield func(convertedMessage) bool) {
+		jobs := make(chan conversionJob)
+		var wg sync.WaitGroup
+		// on return stop the workers, results still converting are dropped
+		defer wg.Wait()
+		defer close(jobs)
+		for i := 0; i < c.conversionWorkers; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for job := range jobs {
+					record, err := c.record(ctx, job.msg, filter)
+					job.result <- convertedMessage{msg: job.msg, record: record, err: err}
+				}
+			}()
+		}
+
+		// results in the order of the messages
+		readAhead := 2 * c.conversionWorkers
+		pending := make([]chan convertedMessage, 0, readAhead)
+		yieldNext := func() bool {
+			converted := <-pending[0]
+			pending = pending[1:]
+			return yield(converted) && converted.err == nil
+		}
+		yieldPending := func() bool {
+			for len(pending) > 0 {
+				if !yieldNext() {
+					return false
+				}
+			}
+			return true
+		}
+
+		for msg, err := range messages {
+			if err != nil {
+				if yieldPending() {
+					yield(convertedMessage{err: err})
+				}
+				return
+			}
+			result := make(chan convertedMessage, 1)
+			jobs <- conversionJob{msg: msg, result: result}
+			pending = append(pending, result)
+			if len(pending) >= readAhead && !yieldNext() {
+				return
+			}
+		}
+		yieldPending()
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/bborbe/errors"
+	libhttp "github.com/bborbe/http"
+	libkafka "github.com/bborbe/kafka"
+	"github.com/golang/glog"
+	"github.com/gorilla/mux"
+)
+
+func NewPartitionsHandler(
+	partitionsProvider PartitionsProvider,
+) libhttp.WithError {
+	return libhttp.WithErrorFunc(
+		func(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
+			topic := libkafka.Topic(mux.Vars(req)["topic"])
+			if topic == "" {
+				return errors.New(ctx, "parameter topic missing")
+			}
+
+			partitions, err := partitionsProvider.Partitions(ctx, topic)
+			if err != nil {
+				return errors.Wrap(ctx, err, "get partitions failed")
+			}
+
+			if err := libhttp.SendJSONResponse(ctx, resp, partitions, http.StatusOK); err != nil {
+				return errors.Wrap(ctx, err, "send json failed")
+			}
+
+			glog.V(2).Infof("list %d partitions of topic %s completed", len(partitions), topic)
+			return nil
+		},
+	)
+}
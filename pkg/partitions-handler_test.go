@@ -0,0 +1,93 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/bborbe/errors"
+	libhttp "github.com/bborbe/http"
+	libkafka "github.com/bborbe/kafka"
+	"github.com/gorilla/mux"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/mocks"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("PartitionsHandler", func() {
+	var ctx context.Context
+	var partitionsProvider *mocks.PartitionsProvider
+	var handler libhttp.WithError
+	var request *http.Request
+	var response *httptest.ResponseRecorder
+	var err error
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		partitionsProvider = &mocks.PartitionsProvider{}
+		partitionsProvider.PartitionsReturns(pkg.PartitionInfos{
+			{
+				Partition:         0,
+				LowWaterMark:      5,
+				HighWaterMark:     42,
+				EstimatedMessages: 37,
+			},
+		}, nil)
+		handler = pkg.NewPartitionsHandler(partitionsProvider)
+		response = httptest.NewRecorder()
+		request = mux.SetURLVars(
+			httptest.NewRequest(http.MethodGet, "/topics/orders/partitions", nil),
+			map[string]string{"topic": "orders"},
+		)
+	})
+
+	JustBeforeEach(func() {
+		err = handler.ServeHTTP(ctx, response, request)
+	})
+
+	It("returns no error", func() {
+		Expect(err).To(BeNil())
+		Expect(response.Code).To(Equal(http.StatusOK))
+	})
+
+	It("requests partitions of topic", func() {
+		Expect(partitionsProvider.PartitionsCallCount()).To(Equal(1))
+		_, topic := partitionsProvider.PartitionsArgsForCall(0)
+		Expect(topic).To(Equal(libkafka.Topic("orders")))
+	})
+
+	It("returns watermarks", func() {
+		body := response.Body.String()
+		Expect(body).To(ContainSubstring(`"lowWaterMark":5`))
+		Expect(body).To(ContainSubstring(`"highWaterMark":42`))
+		Expect(body).To(ContainSubstring(`"estimatedMessages":37`))
+	})
+
+	Context("missing topic", func() {
+		BeforeEach(func() {
+			request = httptest.NewRequest(http.MethodGet, "/topics//partitions", nil)
+		})
+
+		It("returns error", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("parameter topic missing"))
+		})
+	})
+
+	Context("provider fails", func() {
+		BeforeEach(func() {
+			partitionsProvider.PartitionsReturns(nil, errors.New(ctx, "banana"))
+		})
+
+		It("returns error", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("get partitions failed"))
+		})
+	})
+})
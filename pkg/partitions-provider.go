@@ -0,0 +1,107 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+	"sort"
+
+	"github.com/IBM/sarama"
+	"github.com/bborbe/errors"
+	libkafka "github.com/bborbe/kafka"
+)
+
+type PartitionInfos []PartitionInfo
+
+type PartitionInfo struct {
+	Partition     libkafka.Partition `json:"partition"`
+	LowWaterMark  libkafka.Offset    `json:"lowWaterMark"`
+	HighWaterMark libkafka.Offset    `json:"highWaterMark"`
+	// EstimatedMessages is high minus low watermark. Compaction and
+	// transaction markers make the real number of messages smaller.
+	EstimatedMessages int64   `json:"estimatedMessages"`
+	Leader            int32   `json:"leader"`
+	LeaderAddr        string  `json:"leaderAddr"`
+	Replicas          []int32 `json:"replicas"`
+	ISR               []int32 `json:"isr"`
+}
+
+//counterfeiter:generate -o ../mocks/partitions-provider.go --fake-name PartitionsProvider . PartitionsProvider
+type PartitionsProvider interface {
+	// Partitions returns watermarks and replica placement of all partitions of the topic.
+	Partitions(ctx context.Context, topic libkafka.Topic) (PartitionInfos, error)
+}
+
+func NewPartitionsProvider(
+	saramaClient libkafka.SaramaClient,
+) PartitionsProvider {
+	return &partitionsProvider{
+		saramaClient: saramaClient,
+	}
+}
+
+type partitionsProvider struct {
+	saramaClient libkafka.SaramaClient
+}
+
+func (p *partitionsProvider) Partitions(
+	ctx context.Context,
+	topic libkafka.Topic,
+) (PartitionInfos, error) {
+	partitions, err := p.saramaClient.Partitions(topic.String())
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "get partitions of topic %s failed", topic)
+	}
+	// sarama returns its cached slice, sorting it in place would race with other readers
+	partitions = append([]int32{}, partitions...)
+	sort.Slice(partitions, func(i, j int) bool { return partitions[i] < partitions[j] })
+
+	result := make(PartitionInfos, 0, len(partitions))
+	for _, partition := range partitions {
+		partitionInfo, err := p.partition(ctx, topic, partition)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *partitionInfo)
+	}
+	return result, nil
+}
+
+func (p *partitionsProvider) partition(
+	ctx context.Context,
+	topic libkafka.Topic,
+	partition int32,
+) (*PartitionInfo, error) {
+	lowWaterMark, err := p.saramaClient.GetOffset(topic.String(), partition, sarama.OffsetOldest)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "get low watermark of partition %d failed", partition)
+	}
+	highWaterMark, err := p.saramaClient.GetOffset(topic.String(), partition, sarama.OffsetNewest)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "get high watermark of partition %d failed", partition)
+	}
+	leader, err := p.saramaClient.Leader(topic.String(), partition)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "get leader of partition %d failed", partition)
+	}
+	replicas, err := p.saramaClient.Replicas(topic.String(), partition)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "get replicas of partition %d failed", partition)
+	}
+	isr, err := p.saramaClient.InSyncReplicas(topic.String(), partition)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "get isr of partition %d failed", partition)
+	}
+	return &PartitionInfo{
+		Partition:         libkafka.Partition(partition),
+		LowWaterMark:      libkafka.Offset(lowWaterMark),
+		HighWaterMark:     libkafka.Offset(highWaterMark),
+		EstimatedMessages: highWaterMark - lowWaterMark,
+		Leader:            leader.ID(),
+		LeaderAddr:        leader.Addr(),
+		Replicas:          replicas,
+		ISR:               isr,
+	}, nil
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+
+	"github.com/IBM/sarama"
+	"github.com/bborbe/errors"
+	libkafka "github.com/bborbe/kafka"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/mocks"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("PartitionsProvider", func() {
+	var ctx context.Context
+	var err error
+	var saramaClient *mocks.SaramaClient
+	var partitionsProvider pkg.PartitionsProvider
+	var partitions pkg.PartitionInfos
+	var clientPartitions []int32
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		saramaClient = &mocks.SaramaClient{}
+		clientPartitions = []int32{1, 0}
+		saramaClient.PartitionsReturns(clientPartitions, nil)
+		saramaClient.GetOffsetStub = func(topic string, partition int32, time int64) (int64, error) {
+			if time == sarama.OffsetOldest {
+				return 10 * int64(partition+1), nil
+			}
+			return 100 * int64(partition+1), nil
+		}
+		saramaClient.LeaderReturns(sarama.NewBroker("broker-1:9092"), nil)
+		saramaClient.ReplicasReturns([]int32{1, 2, 3}, nil)
+		saramaClient.InSyncReplicasReturns([]int32{1, 2}, nil)
+		partitionsProvider = pkg.NewPartitionsProvider(saramaClient)
+	})
+
+	JustBeforeEach(func() {
+		partitions, err = partitionsProvider.Partitions(ctx, "orders")
+	})
+
+	It("returns no error", func() {
+		Expect(err).To(BeNil())
+	})
+
+	It("returns partitions sorted", func() {
+		Expect(partitions).To(HaveLen(2))
+		Expect(partitions[0].Partition).To(Equal(libkafka.Partition(0)))
+		Expect(partitions[1].Partition).To(Equal(libkafka.Partition(1)))
+	})
+
+	It("does not sort the partitions of the client", func() {
+		Expect(clientPartitions).To(Equal([]int32{1, 0}))
+	})
+
+	It("returns watermarks and estimated messages", func() {
+		Expect(partitions[1].LowWaterMark).To(Equal(libkafka.Offset(20)))
+		Expect(partitions[1].HighWaterMark).To(Equal(libkafka.Offset(200)))
+		Expect(partitions[1].EstimatedMessages).To(Equal(int64(180)))
+	})
+
+	It("returns leader and replicas", func() {
+		Expect(partitions[0].LeaderAddr).To(Equal("broker-1:9092"))
+		Expect(partitions[0].Replicas).To(Equal([]int32{1, 2, 3}))
+		Expect(partitions[0].ISR).To(Equal([]int32{1, 2}))
+	})
+
+	It("requests the given topic", func() {
+		Expect(saramaClient.PartitionsArgsForCall(0)).To(Equal("orders"))
+	})
+
+	Context("partitions fails", func() {
+		BeforeEach(func() {
+			saramaClient.PartitionsReturns(nil, sarama.ErrUnknownTopicOrPartition)
+		})
+
+		It("returns error", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(errors.Is(err, sarama.ErrUnknownTopicOrPartition)).To(BeTrue())
+		})
+	})
+
+	Context("get offset fails", func() {
+		BeforeEach(func() {
+			saramaClient.GetOffsetStub = nil
+			saramaClient.GetOffsetReturns(0, errors.New(ctx, "banana"))
+		})
+
+		It("returns error", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("get low watermark of partition 0 failed"))
+		})
+	})
+
+	Context("leader fails", func() {
+		BeforeEach(func() {
+			saramaClient.LeaderReturns(nil, sarama.ErrLeaderNotAvailable)
+		})
+
+		It("returns error", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("get leader of partition 0 failed"))
+		})
+	})
+})
@@ -0,0 +1,54 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/golang/glog"
+)
+
+// NewRateLimitHandler rejects requests of clients without tokens left with 429 and a
+// Retry-After header. Authenticated clients are limited by subject, others by remote IP.
+func NewRateLimitHandler(
+	rateLimiter RateLimiter,
+	limitMetrics LimitMetrics,
+	handler http.Handler,
+) http.Handler {
+	return http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+		client := rateLimitClient(req)
+		if delay := rateLimiter.Reserve(client); delay > 0 {
+			glog.V(2).Infof("rate limit of %s reached for %s %s", client, req.Method, req.URL.Path)
+			limitMetrics.Rejected(LimitReasonRateLimit)
+			sendTooManyRequests(resp, delay, "rate limit exceeded")
+			return
+		}
+		handler.ServeHTTP(resp, req)
+	})
+}
+
+func rateLimitClient(req *http.Request) string {
+	if identity := IdentityFromContext(req.Context()); identity != nil {
+		return "subject:" + identity.Subject
+	}
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		host = req.RemoteAddr
+	}
+	return "ip:" + host
+}
+
+func sendTooManyRequests(resp http.ResponseWriter, retryAfter time.Duration, message string) {
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	resp.Header().Set("Retry-After", strconv.Itoa(seconds))
+	http.Error(resp, message, http.StatusTooManyRequests)
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/mocks"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("RateLimitHandler", func() {
+	var rateLimiter *mocks.RateLimiter
+	var limitMetrics *mocks.LimitMetrics
+	var called bool
+	var request *http.Request
+	var response *httptest.ResponseRecorder
+
+	BeforeEach(func() {
+		rateLimiter = &mocks.RateLimiter{}
+		limitMetrics = &mocks.LimitMetrics{}
+		called = false
+		request = httptest.NewRequest(http.MethodGet, "/read", nil)
+		request.RemoteAddr = "10.0.0.1:4711"
+		response = httptest.NewRecorder()
+	})
+
+	JustBeforeEach(func() {
+		pkg.NewRateLimitHandler(
+			rateLimiter,
+			limitMetrics,
+			http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+				called = true
+			}),
+		).ServeHTTP(response, request)
+	})
+
+	Context("allowed", func() {
+		It("calls handler", func() {
+			Expect(called).To(BeTrue())
+			Expect(response.Code).To(Equal(http.StatusOK))
+		})
+
+		It("limits by ip", func() {
+			Expect(rateLimiter.ReserveArgsForCall(0)).To(Equal("ip:10.0.0.1"))
+		})
+	})
+
+	Context("authenticated", func() {
+		BeforeEach(func() {
+			request = request.WithContext(
+				pkg.WithIdentity(request.Context(), &pkg.Identity{Subject: "alice"}),
+			)
+		})
+
+		It("limits by subject", func() {
+			Expect(rateLimiter.ReserveArgsForCall(0)).To(Equal("subject:alice"))
+		})
+	})
+
+	Context("limited", func() {
+		BeforeEach(func() {
+			rateLimiter.ReserveReturns(1500 * time.Millisecond)
+		})
+
+		It("returns 429 with Retry-After", func() {
+			Expect(called).To(BeFalse())
+			Expect(response.Code).To(Equal(http.StatusTooManyRequests))
+			Expect(response.Header().Get("Retry-After")).To(Equal("2"))
+		})
+
+		It("counts rejection", func() {
+			Expect(limitMetrics.RejectedArgsForCall(0)).To(Equal(pkg.LimitReasonRateLimit))
+		})
+	})
+})
@@ -0,0 +1,91 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+//counterfeiter:generate -o ../mocks/rate-limiter.go --fake-name RateLimiter . RateLimiter
+type RateLimiter interface {
+	// Reserve takes a token of the client and returns 0, or the time until the next
+	// token is available if the client is out of tokens.
+	Reserve(client string) time.Duration
+}
+
+// NewRateLimiter returns a token bucket per client, refilled with requestsPerSecond
+// up to burst tokens. Buckets of clients idle for idleTimeout are removed.
+func NewRateLimiter(
+	requestsPerSecond float64,
+	burst int,
+	idleTimeout time.Duration,
+) RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimiter{
+		limit:       rate.Limit(requestsPerSecond),
+		burst:       burst,
+		idleTimeout: idleTimeout,
+		clients:     make(map[string]*rateLimiterClient),
+		now:         time.Now,
+	}
+}
+
+type rateLimiterClient struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+type rateLimiter struct {
+	mux         sync.Mutex
+	limit       rate.Limit
+	burst       int
+	idleTimeout time.Duration
+	clients     map[string]*rateLimiterClient
+	lastCleanup time.Time
+	now         func() time.Time
+}
+
+func (r *rateLimiter) Reserve(client string) time.Duration {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	now := r.now()
+	r.cleanup(now)
+
+	c, ok := r.clients[client]
+	if !ok {
+		c = &rateLimiterClient{limiter: rate.NewLimiter(r.limit, r.burst)}
+		r.clients[client] = c
+	}
+	c.lastSeen = now
+
+	reservation := c.limiter.ReserveN(now, 1)
+	if !reservation.OK() {
+		return time.Second
+	}
+	delay := reservation.DelayFrom(now)
+	if delay > 0 {
+		// rejected requests must not consume tokens
+		reservation.CancelAt(now)
+	}
+	return delay
+}
+
+func (r *rateLimiter) cleanup(now time.Time) {
+	if now.Sub(r.lastCleanup) < r.idleTimeout {
+		return
+	}
+	for client, c := range r.clients {
+		if now.Sub(c.lastSeen) >= r.idleTimeout {
+			delete(r.clients, client)
+		}
+	}
+	r.lastCleanup = now
+}
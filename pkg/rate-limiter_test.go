@@ -0,0 +1,49 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("RateLimiter", func() {
+	var rateLimiter pkg.RateLimiter
+
+	BeforeEach(func() {
+		rateLimiter = pkg.NewRateLimiter(1, 2, time.Minute)
+	})
+
+	It("allows burst", func() {
+		Expect(rateLimiter.Reserve("alice")).To(BeZero())
+		Expect(rateLimiter.Reserve("alice")).To(BeZero())
+	})
+
+	It("returns delay if out of tokens", func() {
+		rateLimiter.Reserve("alice")
+		rateLimiter.Reserve("alice")
+		delay := rateLimiter.Reserve("alice")
+		Expect(delay).To(BeNumerically(">", 0))
+		Expect(delay).To(BeNumerically("<=", time.Second))
+	})
+
+	It("does not consume tokens of rejected requests", func() {
+		rateLimiter.Reserve("alice")
+		rateLimiter.Reserve("alice")
+		first := rateLimiter.Reserve("alice")
+		second := rateLimiter.Reserve("alice")
+		Expect(second).To(BeNumerically("<=", first))
+	})
+
+	It("limits clients independently", func() {
+		rateLimiter.Reserve("alice")
+		rateLimiter.Reserve("alice")
+		Expect(rateLimiter.Reserve("bob")).To(BeZero())
+	})
+})
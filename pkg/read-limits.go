@@ -0,0 +1,75 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+	"time"
+
+	"github.com/bborbe/errors"
+)
+
+// TruncatedReason tells which cap cut a page short.
+type TruncatedReason string
+
+const (
+	TruncatedReasonMaxLimit         TruncatedReason = "maxLimit"
+	TruncatedReasonTimeout          TruncatedReason = "timeout"
+	TruncatedReasonMaxResponseBytes TruncatedReason = "maxResponseBytes"
+)
+
+// ReadLimits bounds the work of a single read request.
+type ReadLimits struct {
+	// DefaultLimit is used if the request has no limit.
+	DefaultLimit uint64
+	// MaxLimit caps the limit of the request.
+	MaxLimit uint64
+	// DefaultTimeout is used if the request has no timeout.
+	DefaultTimeout time.Duration
+	// MaxTimeout caps the timeout of the request.
+	MaxTimeout time.Duration
+	// MaxResponseBytes caps the JSON size of the records, 0 is unlimited.
+	MaxResponseBytes int
+}
+
+// DefaultReadLimits returns the limits used if nothing is configured.
+func DefaultReadLimits() ReadLimits {
+	return ReadLimits{
+		DefaultLimit:     100,
+		MaxLimit:         1000,
+		DefaultTimeout:   15 * time.Second,
+		MaxTimeout:       60 * time.Second,
+		MaxResponseBytes: 10 * 1024 * 1024,
+	}
+}
+
+func (r ReadLimits) Validate(ctx context.Context) error {
+	if r.DefaultLimit == 0 {
+		return errors.New(ctx, "default limit must be greater than 0")
+	}
+	if r.MaxLimit < r.DefaultLimit {
+		return errors.Errorf(
+			ctx,
+			"max limit %d is less than default limit %d",
+			r.MaxLimit,
+			r.DefaultLimit,
+		)
+	}
+	if r.DefaultTimeout <= 0 {
+		return errors.New(ctx, "default timeout must be greater than 0")
+	}
+	if r.MaxTimeout < r.DefaultTimeout {
+		return errors.Errorf(
+			ctx,
+			"max timeout %v is less than default timeout %v",
+			r.MaxTimeout,
+			r.DefaultTimeout,
+		)
+	}
+	if r.MaxResponseBytes < 0 {
+		return errors.New(ctx, "max response bytes must not be negative")
+	}
+	return nil
+}
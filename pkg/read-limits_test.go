@@ -0,0 +1,50 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("ReadLimits", func() {
+	DescribeTable(
+		"Validate",
+		func(update func(readLimits *pkg.ReadLimits), expectError bool) {
+			readLimits := pkg.DefaultReadLimits()
+			update(&readLimits)
+			err := readLimits.Validate(context.Background())
+			if expectError {
+				Expect(err).To(HaveOccurred())
+			} else {
+				Expect(err).To(BeNil())
+			}
+		},
+		Entry("default", func(readLimits *pkg.ReadLimits) {}, false),
+		Entry("unlimited response bytes", func(readLimits *pkg.ReadLimits) {
+			readLimits.MaxResponseBytes = 0
+		}, false),
+		Entry("zero default limit", func(readLimits *pkg.ReadLimits) {
+			readLimits.DefaultLimit = 0
+		}, true),
+		Entry("max limit below default", func(readLimits *pkg.ReadLimits) {
+			readLimits.MaxLimit = 10
+		}, true),
+		Entry("zero timeout", func(readLimits *pkg.ReadLimits) {
+			readLimits.DefaultTimeout = 0
+		}, true),
+		Entry("max timeout below default", func(readLimits *pkg.ReadLimits) {
+			readLimits.MaxTimeout = time.Second
+		}, true),
+		Entry("negative response bytes", func(readLimits *pkg.ReadLimits) {
+			readLimits.MaxResponseBytes = -1
+		}, true),
+	)
+})
@@ -17,4 +17,24 @@ type Record struct {
 	Partition libkafka.Partition `json:"partition"`
 	Topic     libkafka.Topic     `json:"topic"`
 	Header    libkafka.Header    `json:"header"`
+	// Redacted lists location and action of every redaction, e.g. "value.email:mask".
+	Redacted []string `json:"redacted,omitempty"`
+	// ContentEncoding is the compression of the value applied by the producer, the
+	// value is shown decompressed.
+	ContentEncoding ContentEncoding `json:"contentEncoding,omitempty"`
+
+	// valueDecodeFailure is set if the value contains the decode error instead of the value.
+	valueDecodeFailure ConversionFailureReason
+	// valueDecompressed is set if Value was decoded from the decompressed message value.
+	valueDecompressed bool
+}
+
+func (r *Record) addRedaction(location string, action RedactionAction) {
+	entry := location + ":" + action.String()
+	for _, existing := range r.Redacted {
+		if existing == entry {
+			return
+		}
+	}
+	r.Redacted = append(r.Redacted, entry)
 }
@@ -0,0 +1,136 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+	"sort"
+
+	"github.com/IBM/sarama"
+	"github.com/bborbe/errors"
+	libkafka "github.com/bborbe/kafka"
+)
+
+// recordsBeforeFetchBytes is the size of the fetches of RecordsBefore, the offsets it
+// checks are usually only a few transaction markers.
+const recordsBeforeFetchBytes = 1024 * 1024
+
+// RecordsBefore asks the leader of the partition whether a consumer at offset still
+// gets a record before endOffset. Consumers skip control records like transaction
+// markers, with isolation read_committed also the records of aborted transactions.
+// An empty fetch counts as records pending, the leader did not tell otherwise.
+func RecordsBefore(
+	ctx context.Context,
+	saramaClient libkafka.SaramaClient,
+	topic libkafka.Topic,
+	partition libkafka.Partition,
+	offset libkafka.Offset,
+	endOffset libkafka.Offset,
+	isolation Isolation,
+) (bool, error) {
+	broker, err := saramaClient.Leader(topic.String(), partition.Int32())
+	if err != nil {
+		return false, errors.Wrapf(ctx, err, "get leader of partition %d failed", partition)
+	}
+	if !saramaClient.Config().Version.IsAtLeast(sarama.V0_11_0_0) {
+		return false, errors.New(
+			ctx,
+			"fetch of record batches requires kafka version 0.11 or later",
+		)
+	}
+	for offset < endOffset {
+		request := &sarama.FetchRequest{
+			Version:   4,
+			MaxBytes:  recordsBeforeFetchBytes,
+			Isolation: isolation.SaramaIsolationLevel(),
+		}
+		request.AddBlock(
+			topic.String(),
+			partition.Int32(),
+			offset.Int64(),
+			recordsBeforeFetchBytes,
+			-1,
+		)
+		response, err := broker.Fetch(request)
+		if err != nil {
+			return false, errors.Wrapf(ctx, err, "fetch partition %d failed", partition)
+		}
+		block := response.GetBlock(topic.String(), partition.Int32())
+		if block == nil {
+			return false, errors.Wrapf(
+				ctx,
+				sarama.ErrIncompleteResponse,
+				"fetch partition %d failed",
+				partition,
+			)
+		}
+		if !errors.Is(block.Err, sarama.ErrNoError) {
+			return false, errors.Wrapf(ctx, block.Err, "fetch partition %d failed", partition)
+		}
+		found, next := recordsInBlock(block, offset, endOffset, isolation)
+		if found || next <= offset {
+			return true, nil
+		}
+		offset = next
+	}
+	return false, nil
+}
+
+// recordsInBlock returns true if the block has a record a consumer gets from offset up
+// to endOffset, else the offset after the last complete batch.
+func recordsInBlock(
+	block *sarama.FetchResponseBlock,
+	offset libkafka.Offset,
+	endOffset libkafka.Offset,
+	isolation Isolation,
+) (bool, libkafka.Offset) {
+	abortedTransactions := append([]*sarama.AbortedTransaction{}, block.AbortedTransactions...)
+	sort.Slice(abortedTransactions, func(i, j int) bool {
+		return abortedTransactions[i].FirstOffset < abortedTransactions[j].FirstOffset
+	})
+	abortedProducerIDs := make(map[int64]struct{}, len(abortedTransactions))
+
+	next := offset
+	for _, records := range block.RecordsSet {
+		if records.MsgSet != nil {
+			// messages before 0.11 have no transactions
+			for _, message := range records.MsgSet.Messages {
+				messageOffset := libkafka.Offset(message.Offset)
+				if messageOffset >= offset && messageOffset < endOffset {
+					return true, 0
+				}
+				next = max(next, messageOffset+1)
+			}
+			continue
+		}
+		batch := records.RecordBatch
+		if batch == nil || batch.PartialTrailingRecord {
+			break
+		}
+		lastOffset := libkafka.Offset(batch.FirstOffset + int64(batch.LastOffsetDelta))
+		for len(abortedTransactions) > 0 &&
+			abortedTransactions[0].FirstOffset <= lastOffset.Int64() {
+			abortedProducerIDs[abortedTransactions[0].ProducerID] = struct{}{}
+			abortedTransactions = abortedTransactions[1:]
+		}
+		next = max(next, lastOffset+1)
+		if batch.Control {
+			// the marker ends the transaction of the producer
+			delete(abortedProducerIDs, batch.ProducerID)
+			continue
+		}
+		if _, aborted := abortedProducerIDs[batch.ProducerID]; aborted &&
+			batch.IsTransactional && isolation == IsolationReadCommitted {
+			continue
+		}
+		for _, record := range batch.Records {
+			recordOffset := libkafka.Offset(batch.FirstOffset + record.OffsetDelta)
+			if recordOffset >= offset && recordOffset < endOffset {
+				return true, 0
+			}
+		}
+	}
+	return false, next
+}
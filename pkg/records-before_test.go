@@ -0,0 +1,151 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+
+	"github.com/IBM/sarama"
+	"github.com/bborbe/errors"
+	libkafka "github.com/bborbe/kafka"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/mocks"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("RecordsBefore", func() {
+	var ctx context.Context
+	var saramaClient *mocks.SaramaClient
+	var response *sarama.FetchResponse
+	var mockBroker *sarama.MockBroker
+	var isolation pkg.Isolation
+	var left bool
+	var err error
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		saramaClient = &mocks.SaramaClient{}
+		response = newFetchResponse()
+		response.SetLastStableOffset("orders", 0, 12)
+		isolation = pkg.IsolationReadUncommitted
+	})
+
+	JustBeforeEach(func() {
+		mockBroker = newMockLeader(saramaClient, map[string]sarama.MockResponse{
+			"FetchRequest": sarama.NewMockWrapper(response),
+		})
+		left, err = pkg.RecordsBefore(ctx, saramaClient, "orders", 0, 10, 12, isolation)
+	})
+
+	Context("with transaction markers only", func() {
+		BeforeEach(func() {
+			response.AddControlRecord("orders", 0, 10, 1, sarama.ControlRecordCommit)
+			response.AddControlRecord("orders", 0, 11, 2, sarama.ControlRecordCommit)
+		})
+		It("returns no records left", func() {
+			Expect(err).To(BeNil())
+			Expect(left).To(BeFalse())
+		})
+		It("fetches at the offset with the isolation", func() {
+			history := mockBroker.History()
+			Expect(history).NotTo(BeEmpty())
+			request, ok := history[len(history)-1].Request.(*sarama.FetchRequest)
+			Expect(ok).To(BeTrue())
+			Expect(request.Isolation).To(Equal(sarama.ReadUncommitted))
+		})
+	})
+
+	Context("with record before the end offset", func() {
+		BeforeEach(func() {
+			response.AddControlRecord("orders", 0, 10, 1, sarama.ControlRecordCommit)
+			response.AddRecordBatch("orders", 0, nil, sarama.StringEncoder("v"), 11, 2, true)
+		})
+		It("returns records left", func() {
+			Expect(err).To(BeNil())
+			Expect(left).To(BeTrue())
+		})
+	})
+
+	Context("with record at the end offset", func() {
+		BeforeEach(func() {
+			response.AddControlRecord("orders", 0, 10, 1, sarama.ControlRecordCommit)
+			response.AddControlRecord("orders", 0, 11, 1, sarama.ControlRecordCommit)
+			response.AddRecordBatch("orders", 0, nil, sarama.StringEncoder("v"), 12, 2, false)
+		})
+		It("returns no records left", func() {
+			Expect(err).To(BeNil())
+			Expect(left).To(BeFalse())
+		})
+	})
+
+	Context("with aborted transaction", func() {
+		BeforeEach(func() {
+			response.AddRecordBatch("orders", 0, nil, sarama.StringEncoder("v"), 10, 1, true)
+			response.AddControlRecord("orders", 0, 11, 1, sarama.ControlRecordAbort)
+			block := response.GetBlock("orders", 0)
+			block.AbortedTransactions = []*sarama.AbortedTransaction{
+				{ProducerID: 1, FirstOffset: 10},
+			}
+		})
+		It("returns the aborted record as left", func() {
+			Expect(err).To(BeNil())
+			Expect(left).To(BeTrue())
+		})
+
+		Context("with read committed", func() {
+			BeforeEach(func() {
+				isolation = pkg.IsolationReadCommitted
+			})
+			It("returns no records left", func() {
+				Expect(err).To(BeNil())
+				Expect(left).To(BeFalse())
+			})
+		})
+	})
+
+	Context("with empty fetch", func() {
+		It("returns records left", func() {
+			Expect(err).To(BeNil())
+			Expect(left).To(BeTrue())
+		})
+	})
+
+	Context("with fetch error", func() {
+		BeforeEach(func() {
+			response.AddError("orders", 0, sarama.ErrNotLeaderForPartition)
+		})
+		It("returns error", func() {
+			Expect(errors.Is(err, sarama.ErrNotLeaderForPartition)).To(BeTrue())
+		})
+	})
+
+	It("returns no records left for an empty range", func() {
+		left, err := pkg.RecordsBefore(
+			ctx,
+			saramaClient,
+			"orders",
+			0,
+			libkafka.Offset(12),
+			libkafka.Offset(12),
+			isolation,
+		)
+		Expect(err).To(BeNil())
+		Expect(left).To(BeFalse())
+	})
+
+	It("returns error without leader", func() {
+		saramaClient.LeaderReturns(nil, errors.New(ctx, "banana"))
+		_, err := pkg.RecordsBefore(ctx, saramaClient, "orders", 0, 10, 12, isolation)
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+// newFetchResponse returns an empty fetch response of the version RecordsBefore
+// requests.
+func newFetchResponse() *sarama.FetchResponse {
+	return &sarama.FetchResponse{Version: 4}
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/bborbe/errors"
+	libkafka "github.com/bborbe/kafka"
+)
+
+type RedactionAction string
+
+const (
+	// RedactionActionMask replaces the value with a fixed placeholder.
+	RedactionActionMask RedactionAction = "mask"
+	// RedactionActionHash replaces the value with a keyed hash, equal values get equal hashes.
+	RedactionActionHash RedactionAction = "hash"
+	// RedactionActionDrop removes the field, header or match.
+	RedactionActionDrop RedactionAction = "drop"
+)
+
+func (r RedactionAction) String() string {
+	return string(r)
+}
+
+type RedactionRules []RedactionRule
+
+// RedactionRule redacts JSON paths of the value, headers and regex matches in key,
+// value strings and header values of all topics matching one of the topic globs.
+// JSON paths are dot separated, "*" matches every key or array element.
+type RedactionRule struct {
+	Topics    []string        `json:"topics"`
+	JSONPaths []string        `json:"jsonPaths,omitempty"`
+	Headers   []string        `json:"headers,omitempty"`
+	Patterns  []string        `json:"patterns,omitempty"`
+	Action    RedactionAction `json:"action"`
+}
+
+// ParseRedactionRulesFile reads and validates JSON redaction rules.
+func ParseRedactionRulesFile(ctx context.Context, path string) (RedactionRules, error) {
+	content, err := os.ReadFile(path) // #nosec G304 -- path is configured by the operator
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "read redaction file %s failed", path)
+	}
+	var result struct {
+		Rules RedactionRules `json:"rules"`
+	}
+	if err := json.Unmarshal(content, &result); err != nil {
+		return nil, errors.Wrapf(ctx, err, "parse redaction file %s failed", path)
+	}
+	if err := result.Rules.Validate(ctx); err != nil {
+		return nil, errors.Wrapf(ctx, err, "validate redaction file %s failed", path)
+	}
+	return result.Rules, nil
+}
+
+func (r RedactionRules) Validate(ctx context.Context) error {
+	for i, rule := range r {
+		if err := rule.Validate(ctx); err != nil {
+			return errors.Wrapf(ctx, err, "validate rule %d failed", i)
+		}
+	}
+	return nil
+}
+
+func (r RedactionRule) Validate(ctx context.Context) error {
+	if len(r.Topics) == 0 {
+		return errors.New(ctx, "topics missing")
+	}
+	for _, pattern := range r.Topics {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return errors.Wrapf(ctx, err, "invalid topic pattern %s", pattern)
+		}
+	}
+	if len(r.JSONPaths) == 0 && len(r.Headers) == 0 && len(r.Patterns) == 0 {
+		return errors.New(ctx, "jsonPaths, headers or patterns required")
+	}
+	for _, jsonPath := range r.JSONPaths {
+		if len(splitJSONPath(jsonPath)) == 0 {
+			return errors.Errorf(ctx, "invalid json path %s", jsonPath)
+		}
+	}
+	for _, pattern := range r.Patterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return errors.Wrapf(ctx, err, "invalid pattern %s", pattern)
+		}
+	}
+	switch r.Action {
+	case RedactionActionMask, RedactionActionHash, RedactionActionDrop:
+		return nil
+	default:
+		return errors.Errorf(ctx, "unknown action %s", r.Action)
+	}
+}
+
+// Covers reports whether the rule applies to the topic.
+func (r RedactionRule) Covers(topic libkafka.Topic) bool {
+	return matchesAny(r.Topics, topic.String())
+}
+
+// splitJSONPath splits "$.customer.email" or "customer.email" into its segments.
+func splitJSONPath(jsonPath string) []string {
+	jsonPath = strings.TrimPrefix(strings.TrimPrefix(jsonPath, "$"), ".")
+	if jsonPath == "" {
+		return nil
+	}
+	segments := strings.Split(jsonPath, ".")
+	for _, segment := range segments {
+		if segment == "" {
+			return nil
+		}
+	}
+	return segments
+}
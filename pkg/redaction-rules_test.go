@@ -0,0 +1,86 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("RedactionRules", func() {
+	var ctx context.Context
+	var dir string
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		dir = GinkgoT().TempDir()
+	})
+
+	writeFile := func(content string) string {
+		path := filepath.Join(dir, "redaction.json")
+		Expect(os.WriteFile(path, []byte(content), 0600)).To(Succeed())
+		return path
+	}
+
+	It("parses rules", func() {
+		rules, err := pkg.ParseRedactionRulesFile(ctx, writeFile(`{"rules": [
+			{"topics": ["customers*"], "jsonPaths": ["$.email", "payment.iban"], "action": "mask"},
+			{"topics": ["*"], "headers": ["authorization"], "patterns": ["token-[a-z0-9]+"], "action": "drop"}
+		]}`))
+		Expect(err).To(BeNil())
+		Expect(rules).To(Equal(pkg.RedactionRules{
+			{
+				Topics:    []string{"customers*"},
+				JSONPaths: []string{"$.email", "payment.iban"},
+				Action:    pkg.RedactionActionMask,
+			},
+			{
+				Topics:   []string{"*"},
+				Headers:  []string{"authorization"},
+				Patterns: []string{"token-[a-z0-9]+"},
+				Action:   pkg.RedactionActionDrop,
+			},
+		}))
+	})
+
+	DescribeTable(
+		"returns error",
+		func(content string) {
+			_, err := pkg.ParseRedactionRulesFile(ctx, writeFile(content))
+			Expect(err).NotTo(BeNil())
+		},
+		Entry("invalid json", `{`),
+		Entry("without topics", `{"rules": [{"jsonPaths": ["email"], "action": "mask"}]}`),
+		Entry(
+			"invalid topic",
+			`{"rules": [{"topics": ["["], "jsonPaths": ["email"], "action": "mask"}]}`,
+		),
+		Entry("without targets", `{"rules": [{"topics": ["*"], "action": "mask"}]}`),
+		Entry(
+			"empty json path",
+			`{"rules": [{"topics": ["*"], "jsonPaths": ["a..b"], "action": "mask"}]}`,
+		),
+		Entry(
+			"invalid pattern",
+			`{"rules": [{"topics": ["*"], "patterns": ["("], "action": "mask"}]}`,
+		),
+		Entry(
+			"unknown action",
+			`{"rules": [{"topics": ["*"], "headers": ["a"], "action": "encrypt"}]}`,
+		),
+	)
+
+	It("covers matching topics", func() {
+		rule := pkg.RedactionRule{Topics: []string{"customers*"}}
+		Expect(rule.Covers("customers-v2")).To(BeTrue())
+		Expect(rule.Covers("orders")).To(BeFalse())
+	})
+})
@@ -0,0 +1,301 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/bborbe/errors"
+	libkafka "github.com/bborbe/kafka"
+)
+
+// RedactionMask replaces masked values.
+const RedactionMask = "***"
+
+//counterfeiter:generate -o ../mocks/redactor.go --fake-name Redactor . Redactor
+type Redactor interface {
+	// Redacts reports whether any rule applies to the topic.
+	Redacts(topic libkafka.Topic) bool
+	// Redact applies all rules of the record topic and lists what was redacted in
+	// record.Redacted. Previews of values that failed to decode are always removed
+	// for redacted topics, they contain raw bytes.
+	Redact(record *Record)
+}
+
+// NewRedactor compiles the rules. The hash key makes hashes unguessable, equal
+// values get equal hashes as long as the key is unchanged.
+func NewRedactor(
+	ctx context.Context,
+	rules RedactionRules,
+	hashKey []byte,
+) (Redactor, error) {
+	if err := rules.Validate(ctx); err != nil {
+		return nil, errors.Wrap(ctx, err, "validate rules failed")
+	}
+	result := &redactor{
+		hashKey: hashKey,
+	}
+	for _, rule := range rules {
+		compiled := compiledRedactionRule{
+			RedactionRule: rule,
+		}
+		for _, jsonPath := range rule.JSONPaths {
+			compiled.jsonPaths = append(compiled.jsonPaths, splitJSONPath(jsonPath))
+		}
+		for _, pattern := range rule.Patterns {
+			compiled.patterns = append(compiled.patterns, regexp.MustCompile(pattern))
+		}
+		result.rules = append(result.rules, compiled)
+	}
+	return result, nil
+}
+
+type compiledRedactionRule struct {
+	RedactionRule
+	jsonPaths [][]string
+	patterns  []*regexp.Regexp
+}
+
+type redactor struct {
+	rules   []compiledRedactionRule
+	hashKey []byte
+}
+
+func (r *redactor) Redacts(topic libkafka.Topic) bool {
+	for _, rule := range r.rules {
+		if rule.Covers(topic) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *redactor) Redact(record *Record) {
+	if !r.Redacts(record.Topic) {
+		return
+	}
+	if record.valueDecodeFailure != "" {
+		if value, ok := record.Value.(map[string]interface{}); ok {
+			delete(value, "previewBase64")
+			delete(value, "previewHex")
+			record.addRedaction("value.preview", RedactionActionDrop)
+		}
+	}
+	for _, rule := range r.rules {
+		if !rule.Covers(record.Topic) {
+			continue
+		}
+		for _, segments := range rule.jsonPaths {
+			value, keep, changed := r.redactPath(record.Value, segments, rule.Action)
+			if !changed {
+				continue
+			}
+			if !keep {
+				value = nil
+			}
+			record.Value = value
+			record.addRedaction("value."+strings.Join(segments, "."), rule.Action)
+		}
+		for _, name := range rule.Headers {
+			if r.redactHeader(record.Header, name, rule.Action) {
+				record.addRedaction("header."+strings.ToLower(name), rule.Action)
+			}
+		}
+		for _, pattern := range rule.patterns {
+			r.redactPattern(record, pattern, rule.Action)
+		}
+	}
+}
+
+// redactPath applies the action to all values matching the path. It returns the new
+// value, false if the value should be removed and whether anything changed.
+func (r *redactor) redactPath(
+	value interface{},
+	segments []string,
+	action RedactionAction,
+) (interface{}, bool, bool) {
+	if len(segments) == 0 {
+		return r.redactValue(value, action)
+	}
+	segment, rest := segments[0], segments[1:]
+	switch v := value.(type) {
+	case map[string]interface{}:
+		changed := false
+		for key, child := range v {
+			if segment != "*" && segment != key {
+				continue
+			}
+			newChild, keep, childChanged := r.redactPath(child, rest, action)
+			if !childChanged {
+				continue
+			}
+			changed = true
+			if keep {
+				v[key] = newChild
+			} else {
+				delete(v, key)
+			}
+		}
+		return v, true, changed
+	case []interface{}:
+		index, err := strconv.Atoi(segment)
+		if segment != "*" && err != nil {
+			return v, true, false
+		}
+		changed := false
+		result := v[:0]
+		for i, child := range v {
+			if segment != "*" && i != index {
+				result = append(result, child)
+				continue
+			}
+			newChild, keep, childChanged := r.redactPath(child, rest, action)
+			changed = changed || childChanged
+			if keep {
+				result = append(result, newChild)
+			}
+		}
+		return result, true, changed
+	default:
+		return value, true, false
+	}
+}
+
+func (r *redactor) redactValue(
+	value interface{},
+	action RedactionAction,
+) (interface{}, bool, bool) {
+	switch action {
+	case RedactionActionDrop:
+		return nil, false, true
+	case RedactionActionHash:
+		content, _ := json.Marshal(value)
+		return r.hash(content), true, true
+	default:
+		return RedactionMask, true, true
+	}
+}
+
+func (r *redactor) redactHeader(
+	header libkafka.Header,
+	name string,
+	action RedactionAction,
+) bool {
+	changed := false
+	for key, values := range header {
+		if !strings.EqualFold(key, name) {
+			continue
+		}
+		changed = true
+		if action == RedactionActionDrop {
+			delete(header, key)
+			continue
+		}
+		for i, value := range values {
+			values[i] = r.replacement(value, action)
+		}
+	}
+	return changed
+}
+
+func (r *redactor) redactPattern(
+	record *Record,
+	pattern *regexp.Regexp,
+	action RedactionAction,
+) {
+	replace := func(value string) (string, bool) {
+		if !pattern.MatchString(value) {
+			return value, false
+		}
+		return pattern.ReplaceAllStringFunc(value, func(match string) string {
+			return r.replacement(match, action)
+		}), true
+	}
+	if key, changed := replace(record.Key); changed {
+		record.Key = key
+		record.addRedaction("key", action)
+	}
+	if value, changed := r.redactStrings(record.Value, replace); changed {
+		record.Value = value
+		record.addRedaction("value", action)
+	}
+	for key, values := range record.Header {
+		changed := false
+		for i, value := range values {
+			var valueChanged bool
+			values[i], valueChanged = replace(value)
+			changed = changed || valueChanged
+		}
+		if changed {
+			record.addRedaction("header."+strings.ToLower(key), action)
+		}
+	}
+}
+
+// redactStrings applies replace to all strings in the value, including map keys.
+func (r *redactor) redactStrings(
+	value interface{},
+	replace func(string) (string, bool),
+) (interface{}, bool) {
+	switch v := value.(type) {
+	case string:
+		return replace(v)
+	case map[string]interface{}:
+		changed := false
+		// keys are collected first, renamed keys must not be visited again
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		for _, key := range keys {
+			child := v[key]
+			newChild, childChanged := r.redactStrings(child, replace)
+			newKey, keyChanged := replace(key)
+			if keyChanged {
+				delete(v, key)
+			}
+			if childChanged || keyChanged {
+				v[newKey] = newChild
+				changed = true
+			}
+		}
+		return v, changed
+	case []interface{}:
+		changed := false
+		for i, child := range v {
+			var childChanged bool
+			v[i], childChanged = r.redactStrings(child, replace)
+			changed = changed || childChanged
+		}
+		return v, changed
+	default:
+		return value, false
+	}
+}
+
+func (r *redactor) replacement(value string, action RedactionAction) string {
+	switch action {
+	case RedactionActionDrop:
+		return ""
+	case RedactionActionHash:
+		return r.hash([]byte(value))
+	default:
+		return RedactionMask
+	}
+}
+
+func (r *redactor) hash(content []byte) string {
+	mac := hmac.New(sha256.New, r.hashKey)
+	mac.Write(content)
+	return fmt.Sprintf("hash:%s", hex.EncodeToString(mac.Sum(nil))[:32])
+}
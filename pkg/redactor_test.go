@@ -0,0 +1,226 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/IBM/sarama"
+	libkafka "github.com/bborbe/kafka"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("Redactor", func() {
+	var ctx context.Context
+	var rules pkg.RedactionRules
+	var redactor pkg.Redactor
+	var record *pkg.Record
+
+	parseValue := func(content string) interface{} {
+		var value interface{}
+		Expect(json.Unmarshal([]byte(content), &value)).To(Succeed())
+		return value
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		rules = pkg.RedactionRules{}
+		record = &pkg.Record{
+			Key:   "customer-1",
+			Topic: "customers",
+			Value: parseValue(`{
+				"name": "Alice",
+				"email": "alice@example.com",
+				"payment": {"iban": "DE89370400440532013000"},
+				"contacts": [{"phone": "+49 1"}, {"phone": "+49 2"}],
+				"note": "session token-abc123 expired"
+			}`),
+			Header: libkafka.Header{
+				"Authorization": []string{"Bearer secret"},
+				"trace":         []string{"token-def456"},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		redactor, err = pkg.NewRedactor(ctx, rules, []byte("hash-key"))
+		Expect(err).To(BeNil())
+		redactor.Redact(record)
+	})
+
+	value := func() map[string]interface{} {
+		return record.Value.(map[string]interface{})
+	}
+
+	Context("without rules", func() {
+		It("redacts nothing", func() {
+			Expect(redactor.Redacts("customers")).To(BeFalse())
+			Expect(value()["email"]).To(Equal("alice@example.com"))
+			Expect(record.Redacted).To(BeEmpty())
+		})
+	})
+
+	Context("mask json paths", func() {
+		BeforeEach(func() {
+			rules = pkg.RedactionRules{{
+				Topics:    []string{"customers"},
+				JSONPaths: []string{"$.email", "payment.iban", "contacts.*.phone", "missing.field"},
+				Action:    pkg.RedactionActionMask,
+			}}
+		})
+
+		It("masks fields", func() {
+			Expect(value()["name"]).To(Equal("Alice"))
+			Expect(value()["email"]).To(Equal(pkg.RedactionMask))
+			Expect(value()["payment"]).To(Equal(map[string]interface{}{"iban": pkg.RedactionMask}))
+			Expect(value()["contacts"]).To(Equal([]interface{}{
+				map[string]interface{}{"phone": pkg.RedactionMask},
+				map[string]interface{}{"phone": pkg.RedactionMask},
+			}))
+		})
+
+		It("marks record as redacted", func() {
+			Expect(record.Redacted).To(Equal([]string{
+				"value.email:mask",
+				"value.payment.iban:mask",
+				"value.contacts.*.phone:mask",
+			}))
+		})
+	})
+
+	Context("other topic", func() {
+		BeforeEach(func() {
+			rules = pkg.RedactionRules{{
+				Topics:    []string{"orders"},
+				JSONPaths: []string{"email"},
+				Action:    pkg.RedactionActionMask,
+			}}
+		})
+
+		It("redacts nothing", func() {
+			Expect(value()["email"]).To(Equal("alice@example.com"))
+			Expect(record.Redacted).To(BeNil())
+		})
+	})
+
+	Context("hash json path", func() {
+		BeforeEach(func() {
+			rules = pkg.RedactionRules{{
+				Topics:    []string{"customers"},
+				JSONPaths: []string{"email"},
+				Action:    pkg.RedactionActionHash,
+			}}
+		})
+
+		It("hashes value stable", func() {
+			hash := value()["email"].(string)
+			Expect(hash).To(HavePrefix("hash:"))
+			Expect(hash).NotTo(ContainSubstring("alice"))
+
+			other := &pkg.Record{
+				Topic: "customers",
+				Value: map[string]interface{}{"email": "alice@example.com"},
+			}
+			redactor.Redact(other)
+			Expect(other.Value.(map[string]interface{})["email"]).To(Equal(hash))
+		})
+
+		It("depends on hash key", func() {
+			otherRedactor, err := pkg.NewRedactor(ctx, rules, []byte("other-key"))
+			Expect(err).To(BeNil())
+			other := &pkg.Record{
+				Topic: "customers",
+				Value: map[string]interface{}{"email": "alice@example.com"},
+			}
+			otherRedactor.Redact(other)
+			Expect(other.Value.(map[string]interface{})["email"]).NotTo(Equal(value()["email"]))
+		})
+	})
+
+	Context("drop json paths", func() {
+		BeforeEach(func() {
+			rules = pkg.RedactionRules{{
+				Topics:    []string{"customers"},
+				JSONPaths: []string{"email", "contacts.0"},
+				Action:    pkg.RedactionActionDrop,
+			}}
+		})
+
+		It("removes fields and array elements", func() {
+			Expect(value()).NotTo(HaveKey("email"))
+			Expect(value()["contacts"]).To(Equal([]interface{}{
+				map[string]interface{}{"phone": "+49 2"},
+			}))
+			Expect(record.Redacted).To(ContainElement("value.email:drop"))
+		})
+	})
+
+	Context("headers", func() {
+		BeforeEach(func() {
+			rules = pkg.RedactionRules{{
+				Topics:  []string{"*"},
+				Headers: []string{"authorization"},
+				Action:  pkg.RedactionActionDrop,
+			}}
+		})
+
+		It("drops header case insensitive", func() {
+			Expect(record.Header).NotTo(HaveKey("Authorization"))
+			Expect(record.Header).To(HaveKey("trace"))
+			Expect(record.Redacted).To(Equal([]string{"header.authorization:drop"}))
+		})
+	})
+
+	Context("patterns", func() {
+		BeforeEach(func() {
+			rules = pkg.RedactionRules{{
+				Topics:   []string{"*"},
+				Patterns: []string{`token-[a-z0-9]+`, `customer-\d+`},
+				Action:   pkg.RedactionActionMask,
+			}}
+		})
+
+		It("masks matches in value, header and key", func() {
+			Expect(value()["note"]).To(Equal("session *** expired"))
+			Expect(record.Header["trace"]).To(Equal([]string{pkg.RedactionMask}))
+			Expect(record.Key).To(Equal(pkg.RedactionMask))
+			Expect(record.Redacted).To(ConsistOf("value:mask", "header.trace:mask", "key:mask"))
+		})
+	})
+
+	Context("value that failed to decode", func() {
+		BeforeEach(func() {
+			rules = pkg.RedactionRules{{
+				Topics:    []string{"customers"},
+				JSONPaths: []string{"email"},
+				Action:    pkg.RedactionActionMask,
+			}}
+			var err error
+			record, err = pkg.NewConverter(100, 1024).Convert(ctx, &sarama.ConsumerMessage{
+				Topic: "customers",
+				Value: []byte("email=alice@example.com"),
+			})
+			Expect(err).To(BeNil())
+		})
+
+		It("removes raw previews", func() {
+			Expect(value()).To(HaveKey("error"))
+			Expect(value()).To(HaveKey("valueLength"))
+			Expect(value()).NotTo(HaveKey("previewHex"))
+			Expect(value()).NotTo(HaveKey("previewBase64"))
+			Expect(record.Redacted).To(Equal([]string{"value.preview:drop"}))
+		})
+	})
+
+	It("returns error for invalid rules", func() {
+		_, err := pkg.NewRedactor(ctx, pkg.RedactionRules{{Topics: []string{"*"}}}, nil)
+		Expect(err).NotTo(BeNil())
+	})
+})
@@ -0,0 +1,11 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+//counterfeiter:generate -o ../mocks/sarama-cluster-admin.go --fake-name SaramaClusterAdmin github.com/IBM/sarama.ClusterAdmin
+//counterfeiter:generate -o ../mocks/sarama-client.go --fake-name SaramaClient github.com/IBM/sarama.Client
+//counterfeiter:generate -o ../mocks/sarama-sync-producer.go --fake-name SaramaSyncProducer github.com/IBM/sarama.SyncProducer
+//counterfeiter:generate -o ../mocks/sarama-consumer.go --fake-name SaramaConsumer github.com/IBM/sarama.Consumer
+//counterfeiter:generate -o ../mocks/sarama-partition-consumer.go --fake-name SaramaPartitionConsumer github.com/IBM/sarama.PartitionConsumer
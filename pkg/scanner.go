@@ -0,0 +1,90 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+	"iter"
+
+	libkafka "github.com/bborbe/kafka"
+	"github.com/bborbe/sentry"
+)
+
+// ScannedMessage is a message read by a scan. Record is nil if the message does not
+// match the filter.
+type ScannedMessage struct {
+	Offset libkafka.Offset
+	Record *Record
+}
+
+//counterfeiter:generate -o ../mocks/scanner.go --fake-name Scanner . Scanner
+type Scanner interface {
+	// Scan yields the messages of the partition from offset up to endOffset in offset
+	// order. endOffset must not be above the high watermark, else the scan waits for new
+	// messages until ctx is done.
+	Scan(
+		ctx context.Context,
+		topic libkafka.Topic,
+		partition libkafka.Partition,
+		offset libkafka.Offset,
+		endOffset libkafka.Offset,
+		filter []byte,
+		fetchConfig FetchConfig,
+	) iter.Seq2[ScannedMessage, error]
+}
+
+// NewScanner reads like the changes provider, but without limit and deadline, for
+// scans of large offset ranges. Scanned messages are not added to the message cache.
+func NewScanner(
+	sentryClient sentry.Client,
+	saramaClient libkafka.SaramaClient,
+	converter Converter,
+	redactor Redactor,
+	metrics Metrics,
+	consumerPool ConsumerPool,
+	messageCache MessageCache,
+	conversionWorkers int,
+) Scanner {
+	return newChangesProvider(
+		sentryClient,
+		saramaClient,
+		converter,
+		redactor,
+		metrics,
+		consumerPool,
+		messageCache,
+		conversionWorkers,
+	)
+}
+
+func (c *changesProvider) Scan(
+	ctx context.Context,
+	topic libkafka.Topic,
+	partition libkafka.Partition,
+	offset libkafka.Offset,
+	endOffset libkafka.Offset,
+	filter []byte,
+	fetchConfig FetchConfig,
+) iter.Seq2[ScannedMessage, error] {
+	return func(yield func(ScannedMessage, error) bool) {
+		if offset >= endOffset {
+			return
+		}
+		messages := c.messages(ctx, topic, partition, offset, endOffset, fetchConfig, true)
+		for converted := range c.convert(ctx, messages, filter) {
+			if converted.err != nil {
+				yield(ScannedMessage{}, converted.err)
+				return
+			}
+			scannedMessage := ScannedMessage{
+				Offset: libkafka.Offset(converted.msg.Offset),
+				Record: converted.record,
+			}
+			if !yield(scannedMessage, nil) {
+				return
+			}
+		}
+	}
+}
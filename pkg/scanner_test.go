@@ -0,0 +1,139 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM/sarama"
+	libkafka "github.com/bborbe/kafka"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/bborbe/kafka-topic-reader/mocks"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("Scanner", func() {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	var saramaClient *mocks.SaramaClient
+	var consumerPool *mocks.ConsumerPool
+	var messageCache pkg.MessageCache
+	var scanner pkg.Scanner
+	var filter []byte
+	var offsets []libkafka.Offset
+	var matches []libkafka.Offset
+	var err error
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+		consumerPool = newBenchmarkConsumerPool(newBenchmarkMessages(100))
+		redactor, err := pkg.NewRedactor(ctx, nil, nil)
+		Expect(err).To(BeNil())
+		saramaClient = &mocks.SaramaClient{}
+		messageCache = pkg.NewMessageCache(&mocks.MessageCacheMetrics{}, 1024*1024)
+		scanner = pkg.NewScanner(
+			nil,
+			saramaClient,
+			pkg.NewConverter(100, 1024),
+			redactor,
+			pkg.NewMetricsFactory(prometheus.NewRegistry(), "test")("default", saramaClient),
+			consumerPool,
+			messageCache,
+			1,
+		)
+		filter = nil
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	scan := func(offset libkafka.Offset, endOffset libkafka.Offset) {
+		offsets = nil
+		matches = nil
+		err = nil
+		for scannedMessage, scanErr := range scanner.Scan(
+			ctx,
+			"orders",
+			0,
+			offset,
+			endOffset,
+			filter,
+			pkg.DefaultFetchConfig(),
+		) {
+			if scanErr != nil {
+				err = scanErr
+				return
+			}
+			offsets = append(offsets, scannedMessage.Offset)
+			if scannedMessage.Record != nil {
+				matches = append(matches, scannedMessage.Record.Offset)
+			}
+		}
+	}
+
+	It("yields all messages of the range", func() {
+		scan(10, 20)
+		Expect(err).To(BeNil())
+		Expect(offsets).To(HaveLen(10))
+		Expect(offsets[0]).To(Equal(libkafka.Offset(10)))
+		Expect(offsets[9]).To(Equal(libkafka.Offset(19)))
+		Expect(matches).To(Equal(offsets))
+	})
+
+	It("does not add the messages to the message cache", func() {
+		scan(10, 20)
+		Expect(err).To(BeNil())
+		_, _, ok := messageCache.Get("orders", 0, 10)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("yields nothing for an empty range", func() {
+		scan(20, 20)
+		Expect(err).To(BeNil())
+		Expect(offsets).To(BeEmpty())
+		Expect(consumerPool.AcquireCallCount()).To(Equal(0))
+	})
+
+	Context("with filter", func() {
+		BeforeEach(func() {
+			filter = []byte(`"id":42,`)
+		})
+		It("yields records only for matching messages", func() {
+			scan(0, 100)
+			Expect(err).To(BeNil())
+			Expect(offsets).To(HaveLen(100))
+			Expect(matches).To(Equal([]libkafka.Offset{42}))
+		})
+	})
+
+	Context("with transaction marker at the end offset", func() {
+		BeforeEach(func() {
+			cancel()
+			ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
+			response := newFetchResponse()
+			response.SetLastStableOffset("orders", 0, 101)
+			response.AddControlRecord("orders", 0, 100, 1, sarama.ControlRecordCommit)
+			newTransactionalLeader(saramaClient, 101, response)
+		})
+		It("ends after the last message", func() {
+			scan(90, 101)
+			Expect(err).To(BeNil())
+			Expect(offsets).To(HaveLen(10))
+		})
+	})
+
+	Context("with end offset above the messages", func() {
+		It("ends with the error of ctx", func() {
+			cancel()
+			scan(100, 200)
+			Expect(err).To(MatchError(context.Canceled))
+		})
+	})
+})
@@ -0,0 +1,183 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"crypto/hmac"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"fmt"
+	"hash"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/sarama"
+)
+
+// NonceGenerator returns the client nonce of a SCRAM exchange.
+type NonceGenerator func() (string, error)
+
+// NewRandomNonce returns a base64 encoded random nonce.
+func NewRandomNonce() (string, error) {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawStdEncoding.EncodeToString(buf), nil
+}
+
+// NewSCRAMClient returns a SCRAM client (RFC 5802) for SCRAM-SHA-256 or SCRAM-SHA-512.
+func NewSCRAMClient(
+	mechanism sarama.SASLMechanism,
+	nonceGenerator NonceGenerator,
+) sarama.SCRAMClient {
+	hashGenerator := sha512.New
+	if mechanism == sarama.SASLTypeSCRAMSHA256 {
+		hashGenerator = sha256.New
+	}
+	return &scramClient{
+		hashGenerator:  hashGenerator,
+		nonceGenerator: nonceGenerator,
+	}
+}
+
+type scramClient struct {
+	hashGenerator  func() hash.Hash
+	nonceGenerator NonceGenerator
+
+	step            int
+	gs2Header       string
+	clientNonce     string
+	clientFirstBare string
+	password        string
+	serverSignature []byte
+}
+
+func (s *scramClient) Begin(userName, password, authzID string) error {
+	nonce, err := s.nonceGenerator()
+	if err != nil {
+		return fmt.Errorf("generate nonce failed: %w", err)
+	}
+	s.gs2Header = "n,,"
+	if authzID != "" {
+		s.gs2Header = "n,a=" + scramEscape(authzID) + ","
+	}
+	s.clientNonce = nonce
+	s.clientFirstBare = "n=" + scramEscape(userName) + ",r=" + nonce
+	s.password = password
+	s.step = 0
+	s.serverSignature = nil
+	return nil
+}
+
+func (s *scramClient) Step(challenge string) (string, error) {
+	s.step++
+	switch s.step {
+	case 1:
+		return s.gs2Header + s.clientFirstBare, nil
+	case 2:
+		return s.clientFinal(challenge)
+	case 3:
+		return "", s.verifyServerFinal(challenge)
+	default:
+		return "", fmt.Errorf("unexpected scram step %d", s.step)
+	}
+}
+
+func (s *scramClient) Done() bool {
+	return s.step >= 3
+}
+
+func (s *scramClient) clientFinal(serverFirst string) (string, error) {
+	attributes := parseSCRAMAttributes(serverFirst)
+	if e, ok := attributes["e"]; ok {
+		return "", fmt.Errorf("server rejected authentication: %s", e)
+	}
+	nonce := attributes["r"]
+	if !strings.HasPrefix(nonce, s.clientNonce) {
+		return "", fmt.Errorf("server nonce does not start with client nonce")
+	}
+	salt, err := base64.StdEncoding.DecodeString(attributes["s"])
+	if err != nil {
+		return "", fmt.Errorf("decode salt failed: %w", err)
+	}
+	iterations, err := strconv.Atoi(attributes["i"])
+	if err != nil || iterations <= 0 {
+		return "", fmt.Errorf("invalid iteration count %q", attributes["i"])
+	}
+
+	saltedPassword, err := pbkdf2.Key(
+		s.hashGenerator,
+		s.password,
+		salt,
+		iterations,
+		s.hashGenerator().Size(),
+	)
+	if err != nil {
+		return "", fmt.Errorf("derive salted password failed: %w", err)
+	}
+
+	clientFinalWithoutProof := "c=" + base64.StdEncoding.EncodeToString(
+		[]byte(s.gs2Header),
+	) + ",r=" + nonce
+	authMessage := s.clientFirstBare + "," + serverFirst + "," + clientFinalWithoutProof
+
+	clientKey := s.hmac(saltedPassword, []byte("Client Key"))
+	storedKey := s.hash(clientKey)
+	clientSignature := s.hmac(storedKey, []byte(authMessage))
+	clientProof := make([]byte, len(clientKey))
+	for i := range clientKey {
+		clientProof[i] = clientKey[i] ^ clientSignature[i]
+	}
+	serverKey := s.hmac(saltedPassword, []byte("Server Key"))
+	s.serverSignature = s.hmac(serverKey, []byte(authMessage))
+
+	return clientFinalWithoutProof + ",p=" + base64.StdEncoding.EncodeToString(clientProof), nil
+}
+
+func (s *scramClient) verifyServerFinal(serverFinal string) error {
+	attributes := parseSCRAMAttributes(serverFinal)
+	if e, ok := attributes["e"]; ok {
+		return fmt.Errorf("server rejected authentication: %s", e)
+	}
+	serverSignature, err := base64.StdEncoding.DecodeString(attributes["v"])
+	if err != nil {
+		return fmt.Errorf("decode server signature failed: %w", err)
+	}
+	if !hmac.Equal(serverSignature, s.serverSignature) {
+		return fmt.Errorf("server signature mismatch")
+	}
+	return nil
+}
+
+func (s *scramClient) hmac(key []byte, data []byte) []byte {
+	mac := hmac.New(s.hashGenerator, key)
+	mac.Write(data)
+	return mac.Sum(nil)
+}
+
+func (s *scramClient) hash(data []byte) []byte {
+	h := s.hashGenerator()
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+func parseSCRAMAttributes(message string) map[string]string {
+	result := map[string]string{}
+	for _, part := range strings.Split(message, ",") {
+		if len(part) < 2 || part[1] != '=' {
+			continue
+		}
+		result[part[:1]] = part[2:]
+	}
+	return result
+}
+
+func scramEscape(value string) string {
+	return strings.NewReplacer("=", "=3D", ",", "=2C").Replace(value)
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"github.com/IBM/sarama"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("SCRAMClient", func() {
+	var scramClient sarama.SCRAMClient
+
+	// test vector from RFC 7677 section 3
+	const serverFirst = "r=rOprNGfwEbeRWgbNEkqO%hvYDpWUa2RaTCAfuxFIlj)hNlF$k0,s=W22ZaJ0SNY7soEsUEjb6gQ==,i=4096"
+	const clientFinal = "c=biws,r=rOprNGfwEbeRWgbNEkqO%hvYDpWUa2RaTCAfuxFIlj)hNlF$k0,p=dHzbZapWIk4jUhN+Ute9ytag9zjfMHgsqmmiz7AndVQ="
+	const serverFinal = "v=6rriTRBi23WpRR/wtup+mMhUZUn/dB5nLTJRsjl95G4="
+
+	BeforeEach(func() {
+		scramClient = pkg.NewSCRAMClient(
+			sarama.SASLTypeSCRAMSHA256,
+			func() (string, error) { return "rOprNGfwEbeRWgbNEkqO", nil },
+		)
+		Expect(scramClient.Begin("user", "pencil", "")).To(Succeed())
+	})
+
+	It("completes the exchange", func() {
+		response, err := scramClient.Step("")
+		Expect(err).To(BeNil())
+		Expect(response).To(Equal("n,,n=user,r=rOprNGfwEbeRWgbNEkqO"))
+		Expect(scramClient.Done()).To(BeFalse())
+
+		response, err = scramClient.Step(serverFirst)
+		Expect(err).To(BeNil())
+		Expect(response).To(Equal(clientFinal))
+		Expect(scramClient.Done()).To(BeFalse())
+
+		response, err = scramClient.Step(serverFinal)
+		Expect(err).To(BeNil())
+		Expect(response).To(BeEmpty())
+		Expect(scramClient.Done()).To(BeTrue())
+	})
+
+	It("rejects a wrong server signature", func() {
+		_, err := scramClient.Step("")
+		Expect(err).To(BeNil())
+		_, err = scramClient.Step(serverFirst)
+		Expect(err).To(BeNil())
+		_, err = scramClient.Step("v=AAAATRBi23WpRR/wtup+mMhUZUn/dB5nLTJRsjl95G4=")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("server signature mismatch"))
+	})
+
+	It("rejects a server nonce not based on the client nonce", func() {
+		_, err := scramClient.Step("")
+		Expect(err).To(BeNil())
+		_, err = scramClient.Step("r=other,s=W22ZaJ0SNY7soEsUEjb6gQ==,i=4096")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("returns server error", func() {
+		_, err := scramClient.Step("")
+		Expect(err).To(BeNil())
+		_, err = scramClient.Step("e=invalid-proof")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("invalid-proof"))
+	})
+
+	It("escapes the username", func() {
+		Expect(scramClient.Begin("a=b,c", "pencil", "")).To(Succeed())
+		response, err := scramClient.Step("")
+		Expect(err).To(BeNil())
+		Expect(response).To(Equal("n,,n=a=3Db=2Cc,r=rOprNGfwEbeRWgbNEkqO"))
+	})
+})
+
+var _ = Describe("NewRandomNonce", func() {
+	It("returns different nonces", func() {
+		first, err := pkg.NewRandomNonce()
+		Expect(err).To(BeNil())
+		second, err := pkg.NewRandomNonce()
+		Expect(err).To(BeNil())
+		Expect(first).NotTo(BeEmpty())
+		Expect(first).NotTo(Equal(second))
+	})
+})
@@ -0,0 +1,235 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bborbe/errors"
+	libhttp "github.com/bborbe/http"
+	libkafka "github.com/bborbe/kafka"
+	"github.com/golang/glog"
+	"github.com/gorilla/mux"
+)
+
+// SearchPage is a search with a page of its matches.
+type SearchPage struct {
+	Search    *Search `json:"search"`
+	Records   Records `json:"records"`
+	NextIndex int     `json:"nextIndex"`
+}
+
+// NewSearchCreateHandler starts a search of the topic, partitions, offset range and
+// filter given as parameters and answers with the search.
+func NewSearchCreateHandler(
+	searchManager SearchManager,
+	fetchLimits FetchLimits,
+) libhttp.WithError {
+	return libhttp.WithErrorFunc(
+		func(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
+			request, err := parseSearchRequest(ctx, req, fetchLimits)
+			if err != nil {
+				return err
+			}
+
+			search, err := searchManager.Start(ctx, *request)
+			if err != nil {
+				if errors.Is(err, ErrTooManySearches) || errors.Is(err, ErrTooManyOwnerSearches) {
+					sendTooManyRequests(resp, time.Minute, err.Error())
+					return nil
+				}
+				if errors.Is(err, ErrTooManyConcurrentReads) {
+					sendTooManyRequests(resp, time.Second, err.Error())
+					return nil
+				}
+				return errors.Wrap(ctx, err, "start search failed")
+			}
+			AuditEventFromContext(ctx).SetSearch(search, nil)
+
+			if err := libhttp.SendJSONResponse(ctx, resp, search, http.StatusCreated); err != nil {
+				return errors.Wrap(ctx, err, "send json failed")
+			}
+			return nil
+		},
+	)
+}
+
+func parseSearchRequest(
+	ctx context.Context,
+	req *http.Request,
+	fetchLimits FetchLimits,
+) (*SearchRequest, error) {
+	topic := libkafka.Topic(req.FormValue("topic"))
+	if topic == "" {
+		return nil, errors.New(ctx, "parameter topic missing")
+	}
+
+	var partitions []libkafka.Partition
+	if value := req.FormValue("partitions"); value != "" {
+		for _, part := range strings.Split(value, ",") {
+			partition, err := libkafka.ParsePartition(ctx, strings.TrimSpace(part))
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, "parse parameter partitions failed")
+			}
+			partitions = append(partitions, *partition)
+		}
+	}
+
+	from, err := parseOptionalOffset(ctx, req.FormValue("from"))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, "parse parameter from failed")
+	}
+	to, err := parseOptionalOffset(ctx, req.FormValue("to"))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, "parse parameter to failed")
+	}
+
+	filter := req.FormValue("filter")
+	if filter == "" {
+		return nil, errors.New(ctx, "parameter filter missing")
+	}
+	if len(filter) > 1024 {
+		return nil, errors.New(ctx, "filter parameter exceeds maximum length of 1024 bytes")
+	}
+
+	fetchConfig, err := parseFetchConfig(ctx, req, fetchLimits)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SearchRequest{
+		Topic:       topic,
+		Partitions:  partitions,
+		From:        from,
+		To:          to,
+		Filter:      []byte(filter),
+		FetchConfig: fetchConfig,
+		Owner:       identitySubject(IdentityFromContext(ctx)),
+	}, nil
+}
+
+// parseOptionalOffset returns nil for an empty value.
+func parseOptionalOffset(ctx context.Context, value string) (*libkafka.Offset, error) {
+	if value == "" {
+		return nil, nil
+	}
+	return libkafka.ParseOffset(ctx, value)
+}
+
+// NewSearchHandler answers with the progress of the search and a page of its matches,
+// selected by the parameters index and limit.
+func NewSearchHandler(
+	searchManager SearchManager,
+	readLimits ReadLimits,
+) libhttp.WithError {
+	return libhttp.WithErrorFunc(
+		func(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
+			search, ok, err := ownSearch(ctx, req, searchManager)
+			if err != nil || !ok {
+				sendSearchNotFound(resp, ok)
+				return err
+			}
+
+			index, err := parseIndex(ctx, req.FormValue("index"))
+			if err != nil {
+				return errors.Wrap(ctx, err, "parse parameter index failed")
+			}
+			limit, _, err := parseLimit(ctx, req.FormValue("limit"), readLimits)
+			if err != nil {
+				return errors.Wrap(ctx, err, "parse parameter limit failed")
+			}
+
+			records, err := searchManager.Records(ctx, search.ID, index, int(limit))
+			if err != nil {
+				return errors.Wrap(ctx, err, "get search records failed")
+			}
+			AuditEventFromContext(ctx).SetSearch(search, records)
+
+			page := SearchPage{
+				Search:    search,
+				Records:   records,
+				NextIndex: index + len(records),
+			}
+			if err := libhttp.SendJSONResponse(ctx, resp, page, http.StatusOK); err != nil {
+				return errors.Wrap(ctx, err, "send json failed")
+			}
+			return nil
+		},
+	)
+}
+
+// NewSearchCancelHandler stops the search and answers with its final state.
+func NewSearchCancelHandler(
+	searchManager SearchManager,
+) libhttp.WithError {
+	return libhttp.WithErrorFunc(
+		func(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
+			search, ok, err := ownSearch(ctx, req, searchManager)
+			if err != nil || !ok {
+				sendSearchNotFound(resp, ok)
+				return err
+			}
+
+			search, err = searchManager.Cancel(ctx, search.ID)
+			if err != nil {
+				return errors.Wrap(ctx, err, "cancel search failed")
+			}
+			AuditEventFromContext(ctx).SetSearch(search, nil)
+
+			if err := libhttp.SendJSONResponse(ctx, resp, search, http.StatusOK); err != nil {
+				return errors.Wrap(ctx, err, "send json failed")
+			}
+			glog.V(2).Infof("search %s canceled by %s", search.ID, search.Owner)
+			return nil
+		},
+	)
+}
+
+// ownSearch returns the search of the route variable id. A search of another owner is
+// reported as missing, so ids of others can not be probed.
+func ownSearch(
+	ctx context.Context,
+	req *http.Request,
+	searchManager SearchManager,
+) (*Search, bool, error) {
+	search, err := searchManager.Get(ctx, SearchID(mux.Vars(req)["id"]))
+	if err != nil {
+		if errors.Is(err, ErrSearchNotFound) {
+			return nil, false, nil
+		}
+		return nil, true, errors.Wrap(ctx, err, "get search failed")
+	}
+	if search.Owner != identitySubject(IdentityFromContext(ctx)) {
+		return nil, false, nil
+	}
+	return search, true, nil
+}
+
+// sendSearchNotFound answers 404 if the search was not found, other errors are sent by
+// the error handler.
+func sendSearchNotFound(resp http.ResponseWriter, found bool) {
+	if !found {
+		http.Error(resp, ErrSearchNotFound.Error(), http.StatusNotFound)
+	}
+}
+
+// parseIndex returns 0 for an empty value.
+func parseIndex(ctx context.Context, value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	index, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, errors.Wrapf(ctx, err, "invalid index %s", value)
+	}
+	if index < 0 {
+		return 0, errors.Errorf(ctx, "index %s must not be negative", value)
+	}
+	return index, nil
+}
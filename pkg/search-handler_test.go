@@ -0,0 +1,261 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+
+	libkafka "github.com/bborbe/kafka"
+	"github.com/gorilla/mux"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/mocks"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("SearchHandler", func() {
+	var ctx context.Context
+	var searchManager *mocks.SearchManager
+	var event *pkg.AuditEvent
+	var response *httptest.ResponseRecorder
+	var search *pkg.Search
+
+	BeforeEach(func() {
+		event = &pkg.AuditEvent{}
+		ctx = pkg.WithAuditEvent(
+			pkg.WithIdentity(context.Background(), &pkg.Identity{Subject: "alice"}),
+			event,
+		)
+		searchManager = &mocks.SearchManager{}
+		response = httptest.NewRecorder()
+		search = &pkg.Search{
+			ID:     "abc",
+			Topic:  "orders",
+			Filter: "needle",
+			Status: pkg.SearchStatusRunning,
+			Owner:  "alice",
+		}
+	})
+
+	Context("create", func() {
+		var values url.Values
+		var err error
+
+		BeforeEach(func() {
+			values = url.Values{}
+			values.Set("topic", "orders")
+			values.Set("partitions", "0, 2")
+			values.Set("from", "-1000")
+			values.Set("filter", "needle")
+			searchManager.StartReturns(search, nil)
+		})
+
+		JustBeforeEach(func() {
+			request := httptest.NewRequest(
+				http.MethodPost,
+				"/searches",
+				strings.NewReader(values.Encode()),
+			)
+			request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			err = pkg.NewSearchCreateHandler(searchManager, pkg.DefaultFetchLimits()).
+				ServeHTTP(ctx, response, request)
+		})
+
+		It("starts the search", func() {
+			Expect(err).To(BeNil())
+			Expect(response.Code).To(Equal(http.StatusCreated))
+			Expect(searchManager.StartCallCount()).To(Equal(1))
+			_, request := searchManager.StartArgsForCall(0)
+			Expect(request.Topic).To(Equal(libkafka.Topic("orders")))
+			Expect(request.Partitions).To(Equal([]libkafka.Partition{0, 2}))
+			Expect(*request.From).To(Equal(libkafka.Offset(-1000)))
+			Expect(request.To).To(BeNil())
+			Expect(request.Filter).To(Equal([]byte("needle")))
+			Expect(request.FetchConfig).To(Equal(pkg.DefaultFetchConfig()))
+			Expect(request.Owner).To(Equal("alice"))
+		})
+
+		It("returns the search", func() {
+			var result pkg.Search
+			Expect(json.Unmarshal(response.Body.Bytes(), &result)).To(Succeed())
+			Expect(result.ID).To(Equal(pkg.SearchID("abc")))
+			Expect(result.Status).To(Equal(pkg.SearchStatusRunning))
+			Expect(response.Body.String()).NotTo(ContainSubstring("alice"))
+		})
+
+		It("fills the audit event", func() {
+			Expect(event.Topic).To(Equal(libkafka.Topic("orders")))
+			Expect(event.Filter).To(Equal("needle"))
+		})
+
+		Context("without filter", func() {
+			BeforeEach(func() {
+				values.Del("filter")
+			})
+			It("returns an error", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("parameter filter missing"))
+				Expect(searchManager.StartCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("with invalid partitions", func() {
+			BeforeEach(func() {
+				values.Set("partitions", "0,banana")
+			})
+			It("returns an error", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("parse parameter partitions failed"))
+			})
+		})
+
+		Context("with too many searches", func() {
+			BeforeEach(func() {
+				searchManager.StartReturns(nil, pkg.ErrTooManySearches)
+			})
+			It("returns too many requests", func() {
+				Expect(err).To(BeNil())
+				Expect(response.Code).To(Equal(http.StatusTooManyRequests))
+				Expect(response.Header().Get("Retry-After")).NotTo(BeEmpty())
+			})
+		})
+
+		Context("with too many searches of the owner", func() {
+			BeforeEach(func() {
+				searchManager.StartReturns(nil, pkg.ErrTooManyOwnerSearches)
+			})
+			It("returns too many requests", func() {
+				Expect(err).To(BeNil())
+				Expect(response.Code).To(Equal(http.StatusTooManyRequests))
+				Expect(response.Header().Get("Retry-After")).NotTo(BeEmpty())
+			})
+		})
+
+		Context("with too many concurrent reads", func() {
+			BeforeEach(func() {
+				searchManager.StartReturns(nil, pkg.ErrTooManyConcurrentReads)
+			})
+			It("returns too many requests", func() {
+				Expect(err).To(BeNil())
+				Expect(response.Code).To(Equal(http.StatusTooManyRequests))
+				Expect(response.Header().Get("Retry-After")).To(Equal("1"))
+			})
+		})
+	})
+
+	Context("get", func() {
+		var query string
+		var err error
+
+		BeforeEach(func() {
+			query = "index=2&limit=1"
+			searchManager.GetReturns(search, nil)
+			searchManager.RecordsReturns(pkg.Records{{Topic: "orders", Offset: 42}}, nil)
+		})
+
+		JustBeforeEach(func() {
+			request := mux.SetURLVars(
+				httptest.NewRequest(http.MethodGet, "/searches/abc?"+query, nil),
+				map[string]string{"id": "abc"},
+			)
+			err = pkg.NewSearchHandler(searchManager, pkg.DefaultReadLimits()).
+				ServeHTTP(ctx, response, request)
+		})
+
+		It("returns the search with a page of matches", func() {
+			Expect(err).To(BeNil())
+			Expect(response.Code).To(Equal(http.StatusOK))
+
+			var page pkg.SearchPage
+			Expect(json.Unmarshal(response.Body.Bytes(), &page)).To(Succeed())
+			Expect(page.Search.ID).To(Equal(pkg.SearchID("abc")))
+			Expect(page.Records).To(HaveLen(1))
+			Expect(page.NextIndex).To(Equal(3))
+
+			_, id, index, limit := searchManager.RecordsArgsForCall(0)
+			Expect(id).To(Equal(pkg.SearchID("abc")))
+			Expect(index).To(Equal(2))
+			Expect(limit).To(Equal(1))
+			Expect(event.RecordCount).To(Equal(1))
+		})
+
+		Context("with negative index", func() {
+			BeforeEach(func() {
+				query = "index=-1"
+			})
+			It("returns an error", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("parse parameter index failed"))
+			})
+		})
+
+		Context("of another owner", func() {
+			BeforeEach(func() {
+				search.Owner = "bob"
+			})
+			It("returns not found", func() {
+				Expect(err).To(BeNil())
+				Expect(response.Code).To(Equal(http.StatusNotFound))
+				Expect(searchManager.RecordsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("unknown", func() {
+			BeforeEach(func() {
+				searchManager.GetReturns(nil, pkg.ErrSearchNotFound)
+			})
+			It("returns not found", func() {
+				Expect(err).To(BeNil())
+				Expect(response.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+	})
+
+	Context("cancel", func() {
+		var err error
+
+		BeforeEach(func() {
+			searchManager.GetReturns(search, nil)
+			canceled := *search
+			canceled.Status = pkg.SearchStatusCanceled
+			searchManager.CancelReturns(&canceled, nil)
+		})
+
+		JustBeforeEach(func() {
+			request := mux.SetURLVars(
+				httptest.NewRequest(http.MethodDelete, "/searches/abc", nil),
+				map[string]string{"id": "abc"},
+			)
+			err = pkg.NewSearchCancelHandler(searchManager).ServeHTTP(ctx, response, request)
+		})
+
+		It("cancels the search", func() {
+			Expect(err).To(BeNil())
+			Expect(response.Code).To(Equal(http.StatusOK))
+			Expect(searchManager.CancelCallCount()).To(Equal(1))
+
+			var result pkg.Search
+			Expect(json.Unmarshal(response.Body.Bytes(), &result)).To(Succeed())
+			Expect(result.Status).To(Equal(pkg.SearchStatusCanceled))
+		})
+
+		Context("of another owner", func() {
+			BeforeEach(func() {
+				search.Owner = "bob"
+			})
+			It("returns not found", func() {
+				Expect(err).To(BeNil())
+				Expect(response.Code).To(Equal(http.StatusNotFound))
+				Expect(searchManager.CancelCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,469 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+	stderrors "errors"
+	"sync"
+	"time"
+
+	"github.com/bborbe/errors"
+	libkafka "github.com/bborbe/kafka"
+	"github.com/bborbe/run"
+	"github.com/golang/glog"
+)
+
+// ErrSearchNotFound is returned for unknown or expired searches.
+var ErrSearchNotFound = stderrors.New("search not found")
+
+// ErrTooManySearches is returned if the max number of searches is already running.
+var ErrTooManySearches = stderrors.New("too many running searches")
+
+// ErrTooManyOwnerSearches is returned if the owner already runs the max number of
+// searches per owner.
+var ErrTooManyOwnerSearches = stderrors.New("too many running searches of owner")
+
+// errMaxMatchesReached stops the scans of all partitions of a search.
+var errMaxMatchesReached = stderrors.New("max matches reached")
+
+//counterfeiter:generate -o ../mocks/search-manager.go --fake-name SearchManager . SearchManager
+type SearchManager interface {
+	// Start resolves the offset ranges of the request and scans them in the background.
+	Start(ctx context.Context, request SearchRequest) (*Search, error)
+	// Get returns the current state of the search.
+	Get(ctx context.Context, id SearchID) (*Search, error)
+	// Records returns up to limit matches of the search starting at index.
+	Records(ctx context.Context, id SearchID, index int, limit int) (Records, error)
+	// Cancel stops a running search. The search and its matches are kept until they
+	// expire.
+	Cancel(ctx context.Context, id SearchID) (*Search, error)
+}
+
+// NewSearchManager runs searches until ctx is canceled. Up to maxRunning searches scan
+// at the same time, up to maxRunningPerOwner of the same owner (0 is unlimited). Each
+// running search takes a slot of the concurrency limiter of reads and stops after
+// maxMatches matches (0 is unlimited) or timeout. Finished searches are removed with
+// their matches after retention.
+func NewSearchManager(
+	ctx context.Context,
+	scanner Scanner,
+	partitionsProvider PartitionsProvider,
+	createResults SearchResultsFactory,
+	concurrencyLimiter ConcurrencyLimiter,
+	limitMetrics LimitMetrics,
+	maxRunning int,
+	maxRunningPerOwner int,
+	maxMatches uint64,
+	timeout time.Duration,
+	retention time.Duration,
+) SearchManager {
+	return &searchManager{
+		ctx:                ctx,
+		scanner:            scanner,
+		partitionsProvider: partitionsProvider,
+		createResults:      createResults,
+		concurrencyLimiter: concurrencyLimiter,
+		limitMetrics:       limitMetrics,
+		maxRunning:         maxRunning,
+		maxRunningPerOwner: maxRunningPerOwner,
+		maxMatches:         maxMatches,
+		timeout:            timeout,
+		retention:          retention,
+		searches:           make(map[SearchID]*searchJob),
+		now:                time.Now,
+	}
+}
+
+type searchManager struct {
+	mux                sync.Mutex
+	ctx                context.Context
+	scanner            Scanner
+	partitionsProvider PartitionsProvider
+	createResults      SearchResultsFactory
+	concurrencyLimiter ConcurrencyLimiter
+	limitMetrics       LimitMetrics
+	maxRunning         int
+	maxRunningPerOwner int
+	maxMatches         uint64
+	timeout            time.Duration
+	retention          time.Duration
+	searches           map[SearchID]*searchJob
+	now                func() time.Time
+}
+
+func (m *searchManager) Start(ctx context.Context, request SearchRequest) (*Search, error) {
+	m.removeExpired()
+
+	partitions, err := m.searchPartitions(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	id, err := NewSearchID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	running, runningOfOwner := m.running(request.Owner)
+	if running >= m.maxRunning {
+		return nil, ErrTooManySearches
+	}
+	if m.maxRunningPerOwner > 0 && runningOfOwner >= m.maxRunningPerOwner {
+		return nil, ErrTooManyOwnerSearches
+	}
+	if !m.concurrencyLimiter.TryAcquire() {
+		m.limitMetrics.Rejected(LimitReasonConcurrency)
+		return nil, ErrTooManyConcurrentReads
+	}
+	results, err := m.createResults(ctx, id)
+	if err != nil {
+		m.concurrencyLimiter.Release()
+		return nil, errors.Wrapf(ctx, err, "create search results failed")
+	}
+	m.limitMetrics.ReadStarted()
+	jobCtx, cancel := context.WithTimeout(m.ctx, m.timeout)
+	job := &searchJob{
+		search: Search{
+			ID:         id,
+			Topic:      request.Topic,
+			Filter:     string(request.Filter),
+			Status:     SearchStatusRunning,
+			Partitions: partitions,
+			Created:    m.now().UTC(),
+			Owner:      request.Owner,
+		},
+		request: request,
+		results: results,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+	m.searches[id] = job
+	go m.run(jobCtx, job)
+
+	glog.V(2).Infof(
+		"search %s of topic %s in %d partitions started",
+		id,
+		request.Topic,
+		len(partitions),
+	)
+	search := job.snapshot()
+	return &search, nil
+}
+
+// searchPartitions resolves the offset range of every requested partition.
+func (m *searchManager) searchPartitions(
+	ctx context.Context,
+	request SearchRequest,
+) (SearchPartitions, error) {
+	partitionInfos, err := m.partitionsProvider.Partitions(ctx, request.Topic)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "get partitions failed")
+	}
+	byPartition := make(map[libkafka.Partition]PartitionInfo, len(partitionInfos))
+	for _, partitionInfo := range partitionInfos {
+		byPartition[partitionInfo.Partition] = partitionInfo
+	}
+	if len(request.Partitions) == 0 {
+		for _, partitionInfo := range partitionInfos {
+			request.Partitions = append(request.Partitions, partitionInfo.Partition)
+		}
+	}
+
+	result := make(SearchPartitions, 0, len(request.Partitions))
+	for _, partition := range request.Partitions {
+		partitionInfo, ok := byPartition[partition]
+		if !ok {
+			return nil, errors.Errorf(
+				ctx,
+				"partition %d of topic %s not found",
+				partition,
+				request.Topic,
+			)
+		}
+		startOffset, endOffset := searchRange(request, partitionInfo)
+		result = append(result, SearchPartition{
+			Partition:   partition,
+			StartOffset: startOffset,
+			EndOffset:   endOffset,
+			NextOffset:  startOffset,
+		})
+	}
+	return result, nil
+}
+
+// searchRange returns the offsets from and to of the request within the watermarks.
+func searchRange(
+	request SearchRequest,
+	partitionInfo PartitionInfo,
+) (libkafka.Offset, libkafka.Offset) {
+	resolve := func(offset libkafka.Offset) libkafka.Offset {
+		if offset < 0 {
+			offset += partitionInfo.HighWaterMark
+		}
+		return min(max(offset, partitionInfo.LowWaterMark), partitionInfo.HighWaterMark)
+	}
+	startOffset := partitionInfo.LowWaterMark
+	if request.From != nil {
+		startOffset = resolve(*request.From)
+	}
+	endOffset := partitionInfo.HighWaterMark
+	if request.To != nil {
+		endOffset = resolve(*request.To)
+	}
+	return startOffset, max(startOffset, endOffset)
+}
+
+// running returns the number of all running searches and of those of the owner.
+func (m *searchManager) running(owner string) (int, int) {
+	var all, ofOwner int
+	for _, job := range m.searches {
+		if job.status() != SearchStatusRunning {
+			continue
+		}
+		all++
+		if job.search.Owner == owner {
+			ofOwner++
+		}
+	}
+	return all, ofOwner
+}
+
+// run scans all partitions in parallel, the first error stops the others. The slot of
+// the concurrency limiter is released before the final status is set.
+func (m *searchManager) run(ctx context.Context, job *searchJob) {
+	defer job.cancel()
+
+	funcs := make([]run.Func, 0, len(job.search.Partitions))
+	for index := range job.search.Partitions {
+		funcs = append(funcs, func(ctx context.Context) error {
+			return m.scanPartition(ctx, job, index)
+		})
+	}
+	err := run.CancelOnFirstError(ctx, funcs...)
+	m.limitMetrics.ReadFinished()
+	m.concurrencyLimiter.Release()
+
+	status := SearchStatusCompleted
+	switch {
+	case err == nil:
+	case stderrors.Is(err, errMaxMatchesReached):
+		status = SearchStatusMaxMatchesReached
+	case stderrors.Is(ctx.Err(), context.DeadlineExceeded):
+		status = SearchStatusTimeout
+	case stderrors.Is(ctx.Err(), context.Canceled):
+		status = SearchStatusCanceled
+	default:
+		status = SearchStatusFailed
+		glog.Warningf("search %s failed: %v", job.search.ID, err)
+	}
+	search := job.finish(status, err, m.now().UTC())
+	close(job.done)
+	glog.V(2).Infof(
+		"search %s finished with %s after %d scanned and %d matched",
+		search.ID,
+		search.Status,
+		search.Scanned,
+		search.Matched,
+	)
+
+	if m.ctx.Err() != nil {
+		// shutdown, nobody can fetch the matches anymore
+		m.remove(job)
+	}
+}
+
+func (m *searchManager) scanPartition(ctx context.Context, job *searchJob, index int) error {
+	partition := job.partition(index)
+	for scannedMessage, err := range m.scanner.Scan(
+		ctx,
+		job.request.Topic,
+		partition.Partition,
+		partition.StartOffset,
+		partition.EndOffset,
+		job.request.Filter,
+		job.request.FetchConfig,
+	) {
+		if err != nil {
+			return errors.Wrapf(ctx, err, "scan partition %d failed", partition.Partition)
+		}
+		matched := scannedMessage.Record != nil
+		if matched {
+			if !job.reserveMatch(m.maxMatches) {
+				return errMaxMatchesReached
+			}
+			if err := job.results.Add(ctx, *scannedMessage.Record); err != nil {
+				return errors.Wrapf(ctx, err, "add match failed")
+			}
+		}
+		job.scanned(index, scannedMessage.Offset, matched)
+		if matched && job.full(m.maxMatches) {
+			return errMaxMatchesReached
+		}
+	}
+	job.partitionDone(index)
+	return nil
+}
+
+func (m *searchManager) Get(ctx context.Context, id SearchID) (*Search, error) {
+	job, err := m.find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	search := job.snapshot()
+	return &search, nil
+}
+
+func (m *searchManager) Records(
+	ctx context.Context,
+	id SearchID,
+	index int,
+	limit int,
+) (Records, error) {
+	job, err := m.find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return job.results.Records(ctx, index, limit)
+}
+
+func (m *searchManager) Cancel(ctx context.Context, id SearchID) (*Search, error) {
+	job, err := m.find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	job.cancel()
+	// wait for run to set the final status
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-job.done:
+	}
+	search := job.snapshot()
+	return &search, nil
+}
+
+func (m *searchManager) find(ctx context.Context, id SearchID) (*searchJob, error) {
+	m.removeExpired()
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	job, ok := m.searches[id]
+	if !ok {
+		return nil, errors.Wrapf(ctx, ErrSearchNotFound, "get search %s failed", id)
+	}
+	return job, nil
+}
+
+func (m *searchManager) removeExpired() {
+	now := m.now()
+	var expired []*searchJob
+	m.mux.Lock()
+	for _, job := range m.searches {
+		if job.expired(now, m.retention) {
+			expired = append(expired, job)
+		}
+	}
+	m.mux.Unlock()
+	for _, job := range expired {
+		m.remove(job)
+	}
+}
+
+func (m *searchManager) remove(job *searchJob) {
+	m.mux.Lock()
+	delete(m.searches, job.search.ID)
+	m.mux.Unlock()
+	if err := job.results.Remove(); err != nil {
+		glog.Warningf("remove results of search %s failed: %v", job.search.ID, err)
+	}
+}
+
+// searchJob guards the progress of a search, ID, request and results never change.
+type searchJob struct {
+	mux     sync.Mutex
+	search  Search
+	request SearchRequest
+	results SearchResults
+	cancel  context.CancelFunc
+	// done is closed after the final status is set
+	done chan struct{}
+}
+
+func (j *searchJob) snapshot() Search {
+	j.mux.Lock()
+	defer j.mux.Unlock()
+	result := j.search
+	result.Partitions = append(SearchPartitions{}, j.search.Partitions...)
+	return result
+}
+
+func (j *searchJob) status() SearchStatus {
+	j.mux.Lock()
+	defer j.mux.Unlock()
+	return j.search.Status
+}
+
+func (j *searchJob) partition(index int) SearchPartition {
+	j.mux.Lock()
+	defer j.mux.Unlock()
+	return j.search.Partitions[index]
+}
+
+// reserveMatch counts a match, it returns false if maxMatches was already reached by
+// another partition.
+func (j *searchJob) reserveMatch(maxMatches uint64) bool {
+	j.mux.Lock()
+	defer j.mux.Unlock()
+	if maxMatches > 0 && j.search.Matched >= maxMatches {
+		return false
+	}
+	j.search.Matched++
+	return true
+}
+
+func (j *searchJob) full(maxMatches uint64) bool {
+	j.mux.Lock()
+	defer j.mux.Unlock()
+	return maxMatches > 0 && j.search.Matched >= maxMatches
+}
+
+func (j *searchJob) scanned(index int, offset libkafka.Offset, matched bool) {
+	j.mux.Lock()
+	defer j.mux.Unlock()
+	partition := &j.search.Partitions[index]
+	partition.NextOffset = offset + 1
+	partition.Scanned++
+	j.search.Scanned++
+	if matched {
+		partition.Matched++
+	}
+}
+
+// partitionDone moves the partition to its end, the last offsets may be missing by
+// compaction or transaction markers.
+func (j *searchJob) partitionDone(index int) {
+	j.mux.Lock()
+	defer j.mux.Unlock()
+	partition := &j.search.Partitions[index]
+	partition.NextOffset = partition.EndOffset
+}
+
+func (j *searchJob) finish(status SearchStatus, err error, now time.Time) Search {
+	j.mux.Lock()
+	defer j.mux.Unlock()
+	j.search.Status = status
+	if status == SearchStatusFailed {
+		j.search.Error = err.Error()
+	}
+	j.search.Finished = &now
+	return j.search
+}
+
+func (j *searchJob) expired(now time.Time, retention time.Duration) bool {
+	j.mux.Lock()
+	defer j.mux.Unlock()
+	return j.search.Finished != nil && now.Sub(*j.search.Finished) >= retention
+}
@@ -0,0 +1,324 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+	"iter"
+	"time"
+
+	"github.com/bborbe/errors"
+	libkafka "github.com/bborbe/kafka"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/mocks"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("SearchManager", func() {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	var scanner *mocks.Scanner
+	var partitionsProvider *mocks.PartitionsProvider
+	var concurrencyLimiter pkg.ConcurrencyLimiter
+	var limitMetrics *mocks.LimitMetrics
+	var maxRunning int
+	var maxRunningPerOwner int
+	var maxMatches uint64
+	var timeout time.Duration
+	var retention time.Duration
+	var block bool
+	var scanErr error
+	var searchManager pkg.SearchManager
+	var request pkg.SearchRequest
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
+		partitionsProvider = &mocks.PartitionsProvider{}
+		partitionsProvider.PartitionsReturns(pkg.PartitionInfos{
+			{Partition: 0, LowWaterMark: 0, HighWaterMark: 100},
+			{Partition: 1, LowWaterMark: 50, HighWaterMark: 80},
+		}, nil)
+		concurrencyLimiter = pkg.NewConcurrencyLimiter(10)
+		limitMetrics = &mocks.LimitMetrics{}
+		maxRunning = 2
+		maxRunningPerOwner = 0
+		maxMatches = 0
+		timeout = time.Minute
+		retention = time.Hour
+		block = false
+		scanErr = nil
+		request = pkg.SearchRequest{
+			Topic:  "orders",
+			Filter: []byte("needle"),
+			Owner:  "alice",
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		// copies, scans of finished specs may still run
+		block := block
+		scanErr := scanErr
+		scanner = &mocks.Scanner{}
+		// every tenth message matches, block waits at the end offset like an
+		// endless partition
+		scanner.ScanCalls(func(
+			ctx context.Context,
+			topic libkafka.Topic,
+			partition libkafka.Partition,
+			offset libkafka.Offset,
+			endOffset libkafka.Offset,
+			filter []byte,
+			fetchConfig pkg.FetchConfig,
+		) iter.Seq2[pkg.ScannedMessage, error] {
+			return func(yield func(pkg.ScannedMessage, error) bool) {
+				for ; offset < endOffset; offset++ {
+					scannedMessage := pkg.ScannedMessage{Offset: offset}
+					if offset%10 == 0 {
+						scannedMessage.Record = &pkg.Record{
+							Topic:     topic,
+							Partition: partition,
+							Offset:    offset,
+						}
+					}
+					if !yield(scannedMessage, nil) {
+						return
+					}
+				}
+				if scanErr != nil {
+					yield(pkg.ScannedMessage{}, scanErr)
+					return
+				}
+				if block {
+					<-ctx.Done()
+					yield(pkg.ScannedMessage{}, ctx.Err())
+				}
+			}
+		})
+		searchManager = pkg.NewSearchManager(
+			ctx,
+			scanner,
+			partitionsProvider,
+			pkg.NewMemorySearchResultsFactory(),
+			concurrencyLimiter,
+			limitMetrics,
+			maxRunning,
+			maxRunningPerOwner,
+			maxMatches,
+			timeout,
+			retention,
+		)
+	})
+
+	wait := func(id pkg.SearchID) *pkg.Search {
+		var search *pkg.Search
+		Eventually(func(g Gomega) {
+			var err error
+			search, err = searchManager.Get(ctx, id)
+			g.Expect(err).To(BeNil())
+			g.Expect(search.Status).NotTo(Equal(pkg.SearchStatusRunning))
+		}).Should(Succeed())
+		return search
+	}
+
+	It("scans all partitions of the topic", func() {
+		search, err := searchManager.Start(ctx, request)
+		Expect(err).To(BeNil())
+		Expect(search.ID).NotTo(BeEmpty())
+		Expect(search.Topic).To(Equal(libkafka.Topic("orders")))
+		Expect(search.Filter).To(Equal("needle"))
+		Expect(search.Owner).To(Equal("alice"))
+
+		search = wait(search.ID)
+		Expect(search.Status).To(Equal(pkg.SearchStatusCompleted))
+		Expect(search.Finished).NotTo(BeNil())
+		Expect(search.Scanned).To(Equal(uint64(130)))
+		Expect(search.Matched).To(Equal(uint64(13)))
+		Expect(search.Partitions).To(Equal(pkg.SearchPartitions{
+			{
+				Partition:   0,
+				StartOffset: 0,
+				EndOffset:   100,
+				NextOffset:  100,
+				Scanned:     100,
+				Matched:     10,
+			},
+			{
+				Partition:   1,
+				StartOffset: 50,
+				EndOffset:   80,
+				NextOffset:  80,
+				Scanned:     30,
+				Matched:     3,
+			},
+		}))
+
+		records, err := searchManager.Records(ctx, search.ID, 0, 100)
+		Expect(err).To(BeNil())
+		Expect(records).To(HaveLen(13))
+	})
+
+	It("resolves the range of the request within the watermarks", func() {
+		from := libkafka.Offset(-20)
+		to := libkafka.Offset(1000)
+		request.Partitions = []libkafka.Partition{0, 1}
+		request.From = &from
+		request.To = &to
+
+		search, err := searchManager.Start(ctx, request)
+		Expect(err).To(BeNil())
+		Expect(search.Partitions[0].StartOffset).To(Equal(libkafka.Offset(80)))
+		Expect(search.Partitions[0].EndOffset).To(Equal(libkafka.Offset(100)))
+		Expect(search.Partitions[1].StartOffset).To(Equal(libkafka.Offset(60)))
+		Expect(search.Partitions[1].EndOffset).To(Equal(libkafka.Offset(80)))
+	})
+
+	It("returns an error for an unknown partition", func() {
+		request.Partitions = []libkafka.Partition{7}
+		_, err := searchManager.Start(ctx, request)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("partition 7 of topic orders not found"))
+	})
+
+	Context("with max matches", func() {
+		BeforeEach(func() {
+			maxMatches = 5
+		})
+		It("stops at max matches", func() {
+			search, err := searchManager.Start(ctx, request)
+			Expect(err).To(BeNil())
+			search = wait(search.ID)
+			Expect(search.Status).To(Equal(pkg.SearchStatusMaxMatchesReached))
+			Expect(search.Matched).To(Equal(uint64(5)))
+
+			records, err := searchManager.Records(ctx, search.ID, 0, 100)
+			Expect(err).To(BeNil())
+			Expect(records).To(HaveLen(5))
+		})
+	})
+
+	Context("with failing scan", func() {
+		BeforeEach(func() {
+			scanErr = errors.New(context.Background(), "banana")
+		})
+		It("fails with the error", func() {
+			search, err := searchManager.Start(ctx, request)
+			Expect(err).To(BeNil())
+			search = wait(search.ID)
+			Expect(search.Status).To(Equal(pkg.SearchStatusFailed))
+			Expect(search.Error).To(ContainSubstring("banana"))
+		})
+	})
+
+	Context("with blocking scan", func() {
+		BeforeEach(func() {
+			block = true
+		})
+
+		It("cancels the search", func() {
+			search, err := searchManager.Start(ctx, request)
+			Expect(err).To(BeNil())
+			search, err = searchManager.Cancel(ctx, search.ID)
+			Expect(err).To(BeNil())
+			Expect(search.Status).To(Equal(pkg.SearchStatusCanceled))
+			Expect(search.Finished).NotTo(BeNil())
+		})
+
+		It("rejects searches above max running", func() {
+			_, err := searchManager.Start(ctx, request)
+			Expect(err).To(BeNil())
+			_, err = searchManager.Start(ctx, request)
+			Expect(err).To(BeNil())
+			_, err = searchManager.Start(ctx, request)
+			Expect(err).To(MatchError(pkg.ErrTooManySearches))
+		})
+
+		It("holds a slot of the concurrency limiter until the search finished", func() {
+			search, err := searchManager.Start(ctx, request)
+			Expect(err).To(BeNil())
+			Expect(limitMetrics.ReadStartedCallCount()).To(Equal(1))
+			Expect(limitMetrics.ReadFinishedCallCount()).To(Equal(0))
+			_, err = searchManager.Cancel(ctx, search.ID)
+			Expect(err).To(BeNil())
+			Expect(limitMetrics.ReadFinishedCallCount()).To(Equal(1))
+		})
+
+		Context("with max running per owner", func() {
+			BeforeEach(func() {
+				maxRunningPerOwner = 1
+			})
+			It("rejects searches of the owner above max running per owner", func() {
+				_, err := searchManager.Start(ctx, request)
+				Expect(err).To(BeNil())
+				_, err = searchManager.Start(ctx, request)
+				Expect(err).To(MatchError(pkg.ErrTooManyOwnerSearches))
+
+				request.Owner = "bob"
+				_, err = searchManager.Start(ctx, request)
+				Expect(err).To(BeNil())
+			})
+		})
+
+		Context("with all slots of the concurrency limiter taken", func() {
+			BeforeEach(func() {
+				concurrencyLimiter = pkg.NewConcurrencyLimiter(1)
+			})
+			It("rejects the search until the running search finished", func() {
+				search, err := searchManager.Start(ctx, request)
+				Expect(err).To(BeNil())
+				_, err = searchManager.Start(ctx, request)
+				Expect(err).To(MatchError(pkg.ErrTooManyConcurrentReads))
+				Expect(limitMetrics.RejectedCallCount()).To(Equal(1))
+				Expect(limitMetrics.RejectedArgsForCall(0)).To(Equal(pkg.LimitReasonConcurrency))
+
+				_, err = searchManager.Cancel(ctx, search.ID)
+				Expect(err).To(BeNil())
+				_, err = searchManager.Start(ctx, request)
+				Expect(err).To(BeNil())
+			})
+		})
+
+		Context("with timeout", func() {
+			BeforeEach(func() {
+				timeout = 10 * time.Millisecond
+			})
+			It("stops at the timeout", func() {
+				search, err := searchManager.Start(ctx, request)
+				Expect(err).To(BeNil())
+				search = wait(search.ID)
+				Expect(search.Status).To(Equal(pkg.SearchStatusTimeout))
+				Expect(search.Scanned).To(Equal(uint64(130)))
+			})
+		})
+	})
+
+	Context("without retention", func() {
+		BeforeEach(func() {
+			retention = 0
+		})
+		It("removes the finished search", func() {
+			search, err := searchManager.Start(ctx, request)
+			Expect(err).To(BeNil())
+			Eventually(func() error {
+				_, err := searchManager.Get(ctx, search.ID)
+				return err
+			}).Should(MatchError(pkg.ErrSearchNotFound))
+		})
+	})
+
+	It("returns not found for an unknown id", func() {
+		_, err := searchManager.Get(ctx, "unknown")
+		Expect(err).To(MatchError(pkg.ErrSearchNotFound))
+		_, err = searchManager.Records(ctx, "unknown", 0, 10)
+		Expect(err).To(MatchError(pkg.ErrSearchNotFound))
+		_, err = searchManager.Cancel(ctx, "unknown")
+		Expect(err).To(MatchError(pkg.ErrSearchNotFound))
+	})
+})
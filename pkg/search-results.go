@@ -0,0 +1,167 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/bborbe/errors"
+)
+
+//counterfeiter:generate -o ../mocks/search-results.go --fake-name SearchResults . SearchResults
+type SearchResults interface {
+	// Add appends a matched record.
+	Add(ctx context.Context, record Record) error
+	// Records returns up to limit records starting at index in the order they were added.
+	Records(ctx context.Context, index int, limit int) (Records, error)
+	// Len returns the number of added records.
+	Len() int
+	// Remove deletes all records.
+	Remove() error
+}
+
+// SearchResultsFactory creates the results of a new search.
+type SearchResultsFactory func(ctx context.Context, id SearchID) (SearchResults, error)
+
+// NewMemorySearchResultsFactory keeps the matches in memory.
+func NewMemorySearchResultsFactory() SearchResultsFactory {
+	return func(ctx context.Context, id SearchID) (SearchResults, error) {
+		return &memorySearchResults{}, nil
+	}
+}
+
+type memorySearchResults struct {
+	mux     sync.Mutex
+	records Records
+}
+
+func (m *memorySearchResults) Add(ctx context.Context, record Record) error {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	m.records = append(m.records, record)
+	return nil
+}
+
+func (m *memorySearchResults) Records(ctx context.Context, index int, limit int) (Records, error) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	start, end := pageBounds(len(m.records), index, limit)
+	// copy, Add may reuse the backing array
+	return append(Records{}, m.records[start:end]...), nil
+}
+
+func (m *memorySearchResults) Len() int {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	return len(m.records)
+}
+
+func (m *memorySearchResults) Remove() error {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	m.records = nil
+	return nil
+}
+
+// NewFileSearchResultsFactory writes the matches of every search as JSON lines to an own
+// file in dir, created if missing. Only the line positions are kept in memory.
+func NewFileSearchResultsFactory(dir string) SearchResultsFactory {
+	return func(ctx context.Context, id SearchID) (SearchResults, error) {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return nil, errors.Wrapf(ctx, err, "create search dir %s failed", dir)
+		}
+		path := filepath.Join(dir, id.String()+".jsonl")
+		// #nosec G304 -- dir is configured by the operator, id is generated
+		file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0600)
+		if err != nil {
+			return nil, errors.Wrapf(ctx, err, "create search results file %s failed", path)
+		}
+		return &fileSearchResults{file: file}, nil
+	}
+}
+
+type fileSearchResults struct {
+	mux  sync.Mutex
+	file *os.File
+	// positions holds the start of every line followed by the end of the last one
+	positions []int64
+}
+
+func (f *fileSearchResults) Add(ctx context.Context, record Record) error {
+	content, err := json.Marshal(record)
+	if err != nil {
+		return errors.Wrapf(ctx, err, "marshal record failed")
+	}
+	content = append(content, '\n')
+
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	if len(f.positions) == 0 {
+		f.positions = append(f.positions, 0)
+	}
+	end := f.positions[len(f.positions)-1]
+	if _, err := f.file.WriteAt(content, end); err != nil {
+		return errors.Wrapf(ctx, err, "write record to %s failed", f.file.Name())
+	}
+	f.positions = append(f.positions, end+int64(len(content)))
+	return nil
+}
+
+func (f *fileSearchResults) Records(ctx context.Context, index int, limit int) (Records, error) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	start, end := pageBounds(f.len(), index, limit)
+	if start == end {
+		return Records{}, nil
+	}
+	content := make([]byte, f.positions[end]-f.positions[start])
+	if _, err := f.file.ReadAt(content, f.positions[start]); err != nil {
+		return nil, errors.Wrapf(ctx, err, "read records from %s failed", f.file.Name())
+	}
+	result := make(Records, 0, end-start)
+	for i := start; i < end; i++ {
+		var record Record
+		line := content[f.positions[i]-f.positions[start] : f.positions[i+1]-f.positions[start]]
+		if err := json.Unmarshal(line, &record); err != nil {
+			return nil, errors.Wrapf(ctx, err, "parse record %d of %s failed", i, f.file.Name())
+		}
+		result = append(result, record)
+	}
+	return result, nil
+}
+
+func (f *fileSearchResults) Len() int {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	return f.len()
+}
+
+func (f *fileSearchResults) len() int {
+	if len(f.positions) == 0 {
+		return 0
+	}
+	return len(f.positions) - 1
+}
+
+func (f *fileSearchResults) Remove() error {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	f.positions = nil
+	if err := f.file.Close(); err != nil {
+		return err
+	}
+	return os.Remove(f.file.Name())
+}
+
+// pageBounds returns the slice bounds of limit elements starting at index.
+func pageBounds(length int, index int, limit int) (int, int) {
+	start := min(max(index, 0), length)
+	end := min(start+max(limit, 0), length)
+	return start, end
+}
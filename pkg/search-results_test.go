@@ -0,0 +1,105 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	libkafka "github.com/bborbe/kafka"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("SearchResults", func() {
+	var ctx context.Context
+	var dir string
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		dir = GinkgoT().TempDir()
+	})
+
+	DescribeTable("pages the added records",
+		func(createFactory func() pkg.SearchResultsFactory) {
+			results, err := createFactory()(ctx, "abc")
+			Expect(err).To(BeNil())
+			Expect(results.Len()).To(Equal(0))
+
+			records, err := results.Records(ctx, 0, 10)
+			Expect(err).To(BeNil())
+			Expect(records).To(BeEmpty())
+
+			for offset := range 5 {
+				Expect(results.Add(ctx, pkg.Record{
+					Key:    "key",
+					Value:  map[string]interface{}{"id": float64(offset)},
+					Offset: libkafka.Offset(offset),
+				})).To(Succeed())
+			}
+			Expect(results.Len()).To(Equal(5))
+
+			records, err = results.Records(ctx, 1, 2)
+			Expect(err).To(BeNil())
+			Expect(records).To(HaveLen(2))
+			Expect(records[0].Offset).To(Equal(libkafka.Offset(1)))
+			Expect(records[1].Offset).To(Equal(libkafka.Offset(2)))
+			Expect(records[1].Value).To(Equal(map[string]interface{}{"id": float64(2)}))
+
+			records, err = results.Records(ctx, 4, 10)
+			Expect(err).To(BeNil())
+			Expect(records).To(HaveLen(1))
+			Expect(records[0].Offset).To(Equal(libkafka.Offset(4)))
+
+			records, err = results.Records(ctx, 10, 10)
+			Expect(err).To(BeNil())
+			Expect(records).To(BeEmpty())
+
+			Expect(results.Remove()).To(Succeed())
+			Expect(results.Len()).To(Equal(0))
+		},
+		Entry("memory", func() pkg.SearchResultsFactory {
+			return pkg.NewMemorySearchResultsFactory()
+		}),
+		Entry("file", func() pkg.SearchResultsFactory {
+			return pkg.NewFileSearchResultsFactory(dir)
+		}),
+	)
+
+	Context("file", func() {
+		var factory pkg.SearchResultsFactory
+
+		BeforeEach(func() {
+			factory = pkg.NewFileSearchResultsFactory(dir)
+		})
+
+		It("writes a file per search and removes it", func() {
+			results, err := factory(ctx, "abc")
+			Expect(err).To(BeNil())
+			Expect(results.Add(ctx, pkg.Record{Key: "key"})).To(Succeed())
+			Expect(filepath.Join(dir, "abc.jsonl")).To(BeARegularFile())
+
+			Expect(results.Remove()).To(Succeed())
+			_, err = os.Stat(filepath.Join(dir, "abc.jsonl"))
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+
+		It("creates a missing dir", func() {
+			_, err := pkg.NewFileSearchResultsFactory(filepath.Join(dir, "default"))(ctx, "abc")
+			Expect(err).To(BeNil())
+			Expect(filepath.Join(dir, "default", "abc.jsonl")).To(BeARegularFile())
+		})
+
+		It("does not overwrite an existing file", func() {
+			_, err := factory(ctx, "abc")
+			Expect(err).To(BeNil())
+			_, err = factory(ctx, "abc")
+			Expect(err).NotTo(BeNil())
+		})
+	})
+})
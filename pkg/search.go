@@ -0,0 +1,99 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/bborbe/errors"
+	libkafka "github.com/bborbe/kafka"
+)
+
+// SearchID identifies a search job.
+type SearchID string
+
+func (s SearchID) String() string {
+	return string(s)
+}
+
+// NewSearchID returns a random, unguessable id.
+func NewSearchID(ctx context.Context) (SearchID, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", errors.Wrapf(ctx, err, "generate search id failed")
+	}
+	return SearchID(hex.EncodeToString(id)), nil
+}
+
+// SearchStatus tells whether a search is running or why it stopped.
+type SearchStatus string
+
+const (
+	// SearchStatusRunning means the search still scans.
+	SearchStatusRunning SearchStatus = "running"
+	// SearchStatusCompleted means all partitions were scanned up to their end offset.
+	SearchStatusCompleted SearchStatus = "completed"
+	// SearchStatusMaxMatchesReached means the search stopped with the max number of matches.
+	SearchStatusMaxMatchesReached SearchStatus = "maxMatchesReached"
+	// SearchStatusTimeout means the search stopped at the max duration of a search.
+	SearchStatusTimeout SearchStatus = "timeout"
+	// SearchStatusCanceled means the search was canceled.
+	SearchStatusCanceled SearchStatus = "canceled"
+	// SearchStatusFailed means reading a partition failed, Error tells why.
+	SearchStatusFailed SearchStatus = "failed"
+)
+
+// SearchRequest describes what a search scans.
+type SearchRequest struct {
+	Topic libkafka.Topic
+	// Partitions to scan, all partitions of the topic if empty.
+	Partitions []libkafka.Partition
+	// From is the first offset, negative is relative to the high watermark. Nil starts
+	// at the low watermark.
+	From *libkafka.Offset
+	// To is the exclusive end offset, negative is relative to the high watermark. Nil
+	// or an offset above the high watermark ends at the high watermark at start.
+	To          *libkafka.Offset
+	Filter      []byte
+	FetchConfig FetchConfig
+	// Owner is the subject that created the search, only it can get or cancel it.
+	Owner string
+}
+
+// Search is the state of a search job.
+type Search struct {
+	ID         SearchID         `json:"id"`
+	Topic      libkafka.Topic   `json:"topic"`
+	Filter     string           `json:"filter"`
+	Status     SearchStatus     `json:"status"`
+	Error      string           `json:"error,omitempty"`
+	Partitions SearchPartitions `json:"partitions"`
+	Scanned    uint64           `json:"scanned"`
+	Matched    uint64           `json:"matched"`
+	Created    time.Time        `json:"created"`
+	Finished   *time.Time       `json:"finished,omitempty"`
+	Owner      string           `json:"-"`
+}
+
+type SearchPartitions []SearchPartition
+
+// SearchPartition is the progress of a search in one partition.
+type SearchPartition struct {
+	Partition   libkafka.Partition `json:"partition"`
+	StartOffset libkafka.Offset    `json:"startOffset"`
+	EndOffset   libkafka.Offset    `json:"endOffset"`
+	// NextOffset is the offset the scan continues at, EndOffset if done.
+	NextOffset libkafka.Offset `json:"nextOffset"`
+	Scanned    uint64          `json:"scanned"`
+	Matched    uint64          `json:"matched"`
+}
+
+// Done returns true if the partition is scanned up to its end offset.
+func (s SearchPartition) Done() bool {
+	return s.NextOffset >= s.EndOffset
+}
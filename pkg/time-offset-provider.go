@@ -0,0 +1,81 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/bborbe/errors"
+	libkafka "github.com/bborbe/kafka"
+)
+
+//counterfeiter:generate -o ../mocks/time-offset-provider.go --fake-name TimeOffsetProvider . TimeOffsetProvider
+type TimeOffsetProvider interface {
+	// Offset returns an offset of the partition before which all messages are older
+	// than t, the high watermark if all messages are older.
+	Offset(
+		ctx context.Context,
+		topic libkafka.Topic,
+		partition libkafka.Partition,
+		t time.Time,
+	) (libkafka.Offset, error)
+}
+
+// NewTimeOffsetProvider looks up the time in the offset index and asks Kafka for
+// times outside the indexed range. offsetIndex is nil if indexing is disabled.
+func NewTimeOffsetProvider(
+	saramaClient libkafka.SaramaClient,
+	offsetIndex OffsetIndex,
+) TimeOffsetProvider {
+	return &timeOffsetProvider{
+		saramaClient: saramaClient,
+		offsetIndex:  offsetIndex,
+	}
+}
+
+type timeOffsetProvider struct {
+	saramaClient libkafka.SaramaClient
+	offsetIndex  OffsetIndex
+}
+
+func (t *timeOffsetProvider) Offset(
+	ctx context.Context,
+	topic libkafka.Topic,
+	partition libkafka.Partition,
+	timestamp time.Time,
+) (libkafka.Offset, error) {
+	if t.offsetIndex != nil {
+		offset, found, err := t.offsetIndex.TimeOffset(ctx, topic, partition, timestamp)
+		if err != nil {
+			return 0, err
+		}
+		if found {
+			return offset, nil
+		}
+	}
+	offset, err := t.saramaClient.GetOffset(
+		topic.String(),
+		partition.Int32(),
+		timestamp.UnixMilli(),
+	)
+	if err != nil {
+		return 0, errors.Wrapf(ctx, err, "get offset of time in partition %d failed", partition)
+	}
+	if offset >= 0 {
+		return libkafka.Offset(offset), nil
+	}
+	// no message at or after the time
+	highWaterMark, err := t.saramaClient.GetOffset(
+		topic.String(),
+		partition.Int32(),
+		sarama.OffsetNewest,
+	)
+	if err != nil {
+		return 0, errors.Wrapf(ctx, err, "get high watermark of partition %d failed", partition)
+	}
+	return libkafka.Offset(highWaterMark), nil
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM/sarama"
+	libkafka "github.com/bborbe/kafka"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/mocks"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("TimeOffsetProvider", func() {
+	var ctx context.Context
+	var saramaClient *mocks.SaramaClient
+	var offsetIndex *mocks.OffsetIndex
+	var t time.Time
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		saramaClient = &mocks.SaramaClient{}
+		saramaClient.GetOffsetCalls(func(topic string, partition int32, time int64) (int64, error) {
+			if time == sarama.OffsetNewest {
+				return 500, nil
+			}
+			return 42, nil
+		})
+		offsetIndex = &mocks.OffsetIndex{}
+		t = time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC)
+	})
+
+	It("returns the offset of the index", func() {
+		offsetIndex.TimeOffsetReturns(7, true, nil)
+		offset, err := pkg.NewTimeOffsetProvider(saramaClient, offsetIndex).
+			Offset(ctx, "orders", 1, t)
+		Expect(err).To(BeNil())
+		Expect(offset).To(Equal(libkafka.Offset(7)))
+		Expect(saramaClient.GetOffsetCallCount()).To(Equal(0))
+
+		_, topic, partition, indexTime := offsetIndex.TimeOffsetArgsForCall(0)
+		Expect(topic).To(Equal(libkafka.Topic("orders")))
+		Expect(partition).To(Equal(libkafka.Partition(1)))
+		Expect(indexTime).To(Equal(t))
+	})
+
+	It("asks Kafka for a time outside the index", func() {
+		offsetIndex.TimeOffsetReturns(0, false, nil)
+		offset, err := pkg.NewTimeOffsetProvider(saramaClient, offsetIndex).
+			Offset(ctx, "orders", 1, t)
+		Expect(err).To(BeNil())
+		Expect(offset).To(Equal(libkafka.Offset(42)))
+
+		topic, partition, timestamp := saramaClient.GetOffsetArgsForCall(0)
+		Expect(topic).To(Equal("orders"))
+		Expect(partition).To(Equal(int32(1)))
+		Expect(timestamp).To(Equal(t.UnixMilli()))
+	})
+
+	It("asks Kafka without index", func() {
+		offset, err := pkg.NewTimeOffsetProvider(saramaClient, nil).Offset(ctx, "orders", 1, t)
+		Expect(err).To(BeNil())
+		Expect(offset).To(Equal(libkafka.Offset(42)))
+	})
+
+	It("returns the high watermark if all messages are older", func() {
+		saramaClient.GetOffsetCalls(func(topic string, partition int32, time int64) (int64, error) {
+			if time == sarama.OffsetNewest {
+				return 500, nil
+			}
+			return -1, nil
+		})
+		offset, err := pkg.NewTimeOffsetProvider(saramaClient, nil).Offset(ctx, "orders", 1, t)
+		Expect(err).To(BeNil())
+		Expect(offset).To(Equal(libkafka.Offset(500)))
+	})
+})
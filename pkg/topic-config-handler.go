@@ -0,0 +1,41 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/bborbe/errors"
+	libhttp "github.com/bborbe/http"
+	libkafka "github.com/bborbe/kafka"
+	"github.com/golang/glog"
+	"github.com/gorilla/mux"
+)
+
+func NewTopicConfigHandler(
+	topicConfigProvider TopicConfigProvider,
+) libhttp.WithError {
+	return libhttp.WithErrorFunc(
+		func(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
+			topic := libkafka.Topic(mux.Vars(req)["topic"])
+			if topic == "" {
+				return errors.New(ctx, "parameter topic missing")
+			}
+
+			entries, err := topicConfigProvider.TopicConfig(ctx, topic)
+			if err != nil {
+				return errors.Wrap(ctx, err, "get topic config failed")
+			}
+
+			if err := libhttp.SendJSONResponse(ctx, resp, entries, http.StatusOK); err != nil {
+				return errors.Wrap(ctx, err, "send json failed")
+			}
+
+			glog.V(2).Infof("get %d configs of topic %s completed", len(entries), topic)
+			return nil
+		},
+	)
+}
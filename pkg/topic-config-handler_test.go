@@ -0,0 +1,78 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/bborbe/errors"
+	libhttp "github.com/bborbe/http"
+	libkafka "github.com/bborbe/kafka"
+	"github.com/gorilla/mux"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/mocks"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("TopicConfigHandler", func() {
+	var ctx context.Context
+	var topicConfigProvider *mocks.TopicConfigProvider
+	var handler libhttp.WithError
+	var request *http.Request
+	var response *httptest.ResponseRecorder
+	var err error
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		topicConfigProvider = &mocks.TopicConfigProvider{}
+		topicConfigProvider.TopicConfigReturns(pkg.TopicConfigEntries{
+			{Name: "retention.ms", Value: "3600000", Source: pkg.ConfigSourceDynamicTopic},
+		}, nil)
+		handler = pkg.NewTopicConfigHandler(topicConfigProvider)
+		response = httptest.NewRecorder()
+		request = mux.SetURLVars(
+			httptest.NewRequest(http.MethodGet, "/topics/orders/config", nil),
+			map[string]string{"topic": "orders"},
+		)
+	})
+
+	JustBeforeEach(func() {
+		err = handler.ServeHTTP(ctx, response, request)
+	})
+
+	It("returns configs of topic", func() {
+		Expect(err).To(BeNil())
+		_, topic := topicConfigProvider.TopicConfigArgsForCall(0)
+		Expect(topic).To(Equal(libkafka.Topic("orders")))
+		Expect(response.Body.String()).To(ContainSubstring(`"name":"retention.ms"`))
+		Expect(response.Body.String()).To(ContainSubstring(`"source":"dynamic-topic"`))
+	})
+
+	Context("missing topic", func() {
+		BeforeEach(func() {
+			request = httptest.NewRequest(http.MethodGet, "/topics//config", nil)
+		})
+
+		It("returns error", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("parameter topic missing"))
+		})
+	})
+
+	Context("provider fails", func() {
+		BeforeEach(func() {
+			topicConfigProvider.TopicConfigReturns(nil, errors.New(ctx, "banana"))
+		})
+
+		It("returns error", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("get topic config failed"))
+		})
+	})
+})
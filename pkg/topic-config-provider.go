@@ -0,0 +1,144 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+	"sort"
+
+	"github.com/IBM/sarama"
+	"github.com/bborbe/errors"
+	libkafka "github.com/bborbe/kafka"
+)
+
+type ConfigSource string
+
+const (
+	ConfigSourceUnknown              ConfigSource = "unknown"
+	ConfigSourceDefault              ConfigSource = "default"
+	ConfigSourceStatic               ConfigSource = "static"
+	ConfigSourceDynamicTopic         ConfigSource = "dynamic-topic"
+	ConfigSourceDynamicBroker        ConfigSource = "dynamic-broker"
+	ConfigSourceDynamicDefaultBroker ConfigSource = "dynamic-default-broker"
+)
+
+// ParseConfigSource converts the sarama config source into its ConfigSource.
+func ParseConfigSource(source sarama.ConfigSource) ConfigSource {
+	switch source {
+	case sarama.SourceDefault:
+		return ConfigSourceDefault
+	case sarama.SourceStaticBroker:
+		return ConfigSourceStatic
+	case sarama.SourceTopic:
+		return ConfigSourceDynamicTopic
+	case sarama.SourceDynamicBroker:
+		return ConfigSourceDynamicBroker
+	case sarama.SourceDynamicDefaultBroker:
+		return ConfigSourceDynamicDefaultBroker
+	default:
+		return ConfigSourceUnknown
+	}
+}
+
+type TopicConfigEntries []TopicConfigEntry
+
+type TopicConfigEntry struct {
+	Name      string              `json:"name"`
+	Value     string              `json:"value"`
+	Source    ConfigSource        `json:"source"`
+	ReadOnly  bool                `json:"readOnly"`
+	Sensitive bool                `json:"sensitive"`
+	Synonyms  TopicConfigSynonyms `json:"synonyms,omitempty"`
+}
+
+// TopicConfigSynonyms lists all values a config has in precedence order,
+// which explains where the effective value comes from.
+type TopicConfigSynonyms []TopicConfigSynonym
+
+type TopicConfigSynonym struct {
+	Name   string       `json:"name"`
+	Value  string       `json:"value"`
+	Source ConfigSource `json:"source"`
+}
+
+//counterfeiter:generate -o ../mocks/topic-config-provider.go --fake-name TopicConfigProvider . TopicConfigProvider
+type TopicConfigProvider interface {
+	// TopicConfig returns the effective configs of the topic sorted by name.
+	TopicConfig(ctx context.Context, topic libkafka.Topic) (TopicConfigEntries, error)
+}
+
+func NewTopicConfigProvider(
+	clusterAdmin sarama.ClusterAdmin,
+) TopicConfigProvider {
+	return &topicConfigProvider{
+		clusterAdmin: clusterAdmin,
+	}
+}
+
+type topicConfigProvider struct {
+	clusterAdmin sarama.ClusterAdmin
+}
+
+func (t *topicConfigProvider) TopicConfig(
+	ctx context.Context,
+	topic libkafka.Topic,
+) (TopicConfigEntries, error) {
+	results, err := t.clusterAdmin.DescribeConfigs(
+		[]*sarama.ConfigResource{
+			{
+				Type: sarama.TopicResource,
+				Name: topic.String(),
+			},
+		},
+		sarama.DescribeConfigsOptions{
+			IncludeSynonyms: true,
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "describe configs of topic %s failed", topic)
+	}
+	if len(results) == 0 {
+		return nil, errors.Errorf(ctx, "no configs for topic %s returned", topic)
+	}
+	result := results[0]
+	if !errors.Is(result.ErrorCode, sarama.ErrNoError) {
+		return nil, errors.Wrapf(
+			ctx,
+			result.ErrorCode,
+			"describe configs of topic %s failed: %s",
+			topic,
+			result.ErrorMsg,
+		)
+	}
+
+	entries := make(TopicConfigEntries, 0, len(result.Configs))
+	for _, config := range result.Configs {
+		entries = append(entries, TopicConfigEntry{
+			Name:      config.Name,
+			Value:     config.Value,
+			Source:    ParseConfigSource(config.Source),
+			ReadOnly:  config.ReadOnly,
+			Sensitive: config.Sensitive,
+			Synonyms:  convertConfigSynonyms(config.Synonyms),
+		})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name < entries[j].Name })
+	return entries, nil
+}
+
+func convertConfigSynonyms(synonyms []*sarama.ConfigSynonym) TopicConfigSynonyms {
+	if len(synonyms) == 0 {
+		return nil
+	}
+	result := make(TopicConfigSynonyms, 0, len(synonyms))
+	for _, synonym := range synonyms {
+		result = append(result, TopicConfigSynonym{
+			Name:   synonym.ConfigName,
+			Value:  synonym.ConfigValue,
+			Source: ParseConfigSource(synonym.Source),
+		})
+	}
+	return result
+}
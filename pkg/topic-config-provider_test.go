@@ -0,0 +1,154 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+
+	"github.com/IBM/sarama"
+	"github.com/bborbe/errors"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/mocks"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("TopicConfigProvider", func() {
+	var ctx context.Context
+	var err error
+	var clusterAdmin *mocks.SaramaClusterAdmin
+	var topicConfigProvider pkg.TopicConfigProvider
+	var entries pkg.TopicConfigEntries
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		clusterAdmin = &mocks.SaramaClusterAdmin{}
+		clusterAdmin.DescribeConfigsReturns([]*sarama.ConfigResourceResult{
+			{
+				Type: sarama.TopicResource,
+				Name: "orders",
+				Configs: []sarama.ConfigEntry{
+					{
+						Name:   "retention.ms",
+						Value:  "3600000",
+						Source: sarama.SourceTopic,
+						Synonyms: []*sarama.ConfigSynonym{
+							{
+								ConfigName:  "retention.ms",
+								ConfigValue: "3600000",
+								Source:      sarama.SourceTopic,
+							},
+							{
+								ConfigName:  "log.retention.hours",
+								ConfigValue: "168",
+								Source:      sarama.SourceStaticBroker,
+							},
+						},
+					},
+					{
+						Name:     "cleanup.policy",
+						Value:    "delete",
+						Default:  true,
+						Source:   sarama.SourceDefault,
+						ReadOnly: true,
+					},
+				},
+			},
+		}, nil)
+		topicConfigProvider = pkg.NewTopicConfigProvider(clusterAdmin)
+	})
+
+	JustBeforeEach(func() {
+		entries, err = topicConfigProvider.TopicConfig(ctx, "orders")
+	})
+
+	It("returns no error", func() {
+		Expect(err).To(BeNil())
+	})
+
+	It("describes the topic with synonyms", func() {
+		Expect(clusterAdmin.DescribeConfigsCallCount()).To(Equal(1))
+		resources, options := clusterAdmin.DescribeConfigsArgsForCall(0)
+		Expect(resources).To(HaveLen(1))
+		Expect(resources[0].Type).To(Equal(sarama.TopicResource))
+		Expect(resources[0].Name).To(Equal("orders"))
+		Expect(options.IncludeSynonyms).To(BeTrue())
+	})
+
+	It("returns entries sorted by name", func() {
+		Expect(entries).To(Equal(pkg.TopicConfigEntries{
+			{
+				Name:     "cleanup.policy",
+				Value:    "delete",
+				Source:   pkg.ConfigSourceDefault,
+				ReadOnly: true,
+			},
+			{
+				Name:   "retention.ms",
+				Value:  "3600000",
+				Source: pkg.ConfigSourceDynamicTopic,
+				Synonyms: pkg.TopicConfigSynonyms{
+					{
+						Name:   "retention.ms",
+						Value:  "3600000",
+						Source: pkg.ConfigSourceDynamicTopic,
+					},
+					{
+						Name:   "log.retention.hours",
+						Value:  "168",
+						Source: pkg.ConfigSourceStatic,
+					},
+				},
+			},
+		}))
+	})
+
+	Context("describe fails", func() {
+		BeforeEach(func() {
+			clusterAdmin.DescribeConfigsReturns(nil, errors.New(ctx, "banana"))
+		})
+
+		It("returns error", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("describe configs of topic orders failed"))
+		})
+	})
+
+	Context("resource error", func() {
+		BeforeEach(func() {
+			clusterAdmin.DescribeConfigsReturns([]*sarama.ConfigResourceResult{
+				{
+					Name:      "orders",
+					ErrorCode: sarama.ErrUnknownTopicOrPartition,
+					ErrorMsg:  "unknown topic",
+				},
+			}, nil)
+		})
+
+		It("returns error", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(errors.Is(err, sarama.ErrUnknownTopicOrPartition)).To(BeTrue())
+		})
+	})
+})
+
+var _ = Describe("ParseConfigSource", func() {
+	DescribeTable("converts",
+		func(source sarama.ConfigSource, expected pkg.ConfigSource) {
+			Expect(pkg.ParseConfigSource(source)).To(Equal(expected))
+		},
+		Entry("default", sarama.SourceDefault, pkg.ConfigSourceDefault),
+		Entry("static", sarama.SourceStaticBroker, pkg.ConfigSourceStatic),
+		Entry("topic", sarama.SourceTopic, pkg.ConfigSourceDynamicTopic),
+		Entry("dynamic broker", sarama.SourceDynamicBroker, pkg.ConfigSourceDynamicBroker),
+		Entry(
+			"dynamic default broker",
+			sarama.SourceDynamicDefaultBroker,
+			pkg.ConfigSourceDynamicDefaultBroker,
+		),
+		Entry("unknown", sarama.SourceUnknown, pkg.ConfigSourceUnknown),
+	)
+})
@@ -0,0 +1,100 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"regexp"
+	"strconv"
+
+	"github.com/bborbe/errors"
+	libhttp "github.com/bborbe/http"
+	"github.com/golang/glog"
+)
+
+type topicsParams struct {
+	name         string
+	regex        *regexp.Regexp
+	hideInternal bool
+}
+
+func parseTopicsParams(ctx context.Context, req *http.Request) (*topicsParams, error) {
+	var params topicsParams
+
+	params.name = req.FormValue("name")
+	if params.name != "" {
+		if _, err := path.Match(params.name, ""); err != nil {
+			return nil, errors.Wrap(ctx, err, "parse parameter name failed")
+		}
+	}
+
+	if value := req.FormValue("regex"); value != "" {
+		regex, err := regexp.Compile(value)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, "parse parameter regex failed")
+		}
+		params.regex = regex
+	}
+
+	if value := req.FormValue("hideInternal"); value != "" {
+		hideInternal, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, "parse parameter hideInternal failed")
+		}
+		params.hideInternal = hideInternal
+	}
+
+	return &params, nil
+}
+
+// Matches reports whether the topic passes the glob, regex and internal filter.
+func (p *topicsParams) Matches(topicInfo TopicInfo) bool {
+	if p.hideInternal && topicInfo.Internal {
+		return false
+	}
+	if p.name != "" {
+		if ok, _ := path.Match(p.name, topicInfo.Name.String()); !ok {
+			return false
+		}
+	}
+	if p.regex != nil && !p.regex.MatchString(topicInfo.Name.String()) {
+		return false
+	}
+	return true
+}
+
+func NewTopicsHandler(
+	topicsProvider TopicsProvider,
+) libhttp.WithError {
+	return libhttp.WithErrorFunc(
+		func(ctx context.Context, resp http.ResponseWriter, req *http.Request) error {
+			params, err := parseTopicsParams(ctx, req)
+			if err != nil {
+				return err
+			}
+
+			topics, err := topicsProvider.Topics(ctx)
+			if err != nil {
+				return errors.Wrap(ctx, err, "get topics failed")
+			}
+
+			result := make(TopicInfos, 0, len(topics))
+			for _, topic := range topics {
+				if params.Matches(topic) {
+					result = append(result, topic)
+				}
+			}
+
+			if err := libhttp.SendJSONResponse(ctx, resp, result, http.StatusOK); err != nil {
+				return errors.Wrap(ctx, err, "send json failed")
+			}
+
+			glog.V(2).Infof("list %d of %d topics completed", len(result), len(topics))
+			return nil
+		},
+	)
+}
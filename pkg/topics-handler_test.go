@@ -0,0 +1,143 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/bborbe/errors"
+	libhttp "github.com/bborbe/http"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/mocks"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("TopicsHandler", func() {
+	var ctx context.Context
+	var topicsProvider *mocks.TopicsProvider
+	var handler libhttp.WithError
+	var request *http.Request
+	var response *httptest.ResponseRecorder
+	var err error
+
+	names := func() []string {
+		var result pkg.TopicInfos
+		Expect(json.Unmarshal(response.Body.Bytes(), &result)).To(Succeed())
+		list := []string{}
+		for _, topic := range result {
+			list = append(list, topic.Name.String())
+		}
+		return list
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		topicsProvider = &mocks.TopicsProvider{}
+		topicsProvider.TopicsReturns(pkg.TopicInfos{
+			{Name: "__consumer_offsets", Partitions: 50, ReplicationFactor: 3, Internal: true},
+			{Name: "orders", Partitions: 3, ReplicationFactor: 2},
+			{Name: "orders-dlq", Partitions: 1, ReplicationFactor: 2},
+			{Name: "users", Partitions: 6, ReplicationFactor: 3},
+		}, nil)
+		handler = pkg.NewTopicsHandler(topicsProvider)
+		response = httptest.NewRecorder()
+		request = httptest.NewRequest(http.MethodGet, "/topics", nil)
+	})
+
+	JustBeforeEach(func() {
+		err = handler.ServeHTTP(ctx, response, request)
+	})
+
+	It("returns all topics", func() {
+		Expect(err).To(BeNil())
+		Expect(response.Code).To(Equal(http.StatusOK))
+		Expect(names()).To(Equal([]string{"__consumer_offsets", "orders", "orders-dlq", "users"}))
+	})
+
+	It("returns partition count and replication factor", func() {
+		Expect(response.Body.String()).To(ContainSubstring(`"partitions":50`))
+		Expect(response.Body.String()).To(ContainSubstring(`"replicationFactor":3`))
+		Expect(response.Body.String()).To(ContainSubstring(`"internal":true`))
+	})
+
+	Context("with hideInternal", func() {
+		BeforeEach(func() {
+			request = httptest.NewRequest(http.MethodGet, "/topics?hideInternal=true", nil)
+		})
+
+		It("hides internal topics", func() {
+			Expect(names()).To(Equal([]string{"orders", "orders-dlq", "users"}))
+		})
+	})
+
+	Context("with name glob", func() {
+		BeforeEach(func() {
+			request = httptest.NewRequest(http.MethodGet, "/topics?name=orders*", nil)
+		})
+
+		It("returns matching topics", func() {
+			Expect(names()).To(Equal([]string{"orders", "orders-dlq"}))
+		})
+	})
+
+	Context("with regex", func() {
+		BeforeEach(func() {
+			request = httptest.NewRequest(http.MethodGet, "/topics?regex=%5Eu", nil)
+		})
+
+		It("returns matching topics", func() {
+			Expect(names()).To(Equal([]string{"users"}))
+		})
+	})
+
+	Context("with invalid glob", func() {
+		BeforeEach(func() {
+			request = httptest.NewRequest(http.MethodGet, "/topics?name=%5B", nil)
+		})
+
+		It("returns error", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("parse parameter name failed"))
+		})
+	})
+
+	Context("with invalid regex", func() {
+		BeforeEach(func() {
+			request = httptest.NewRequest(http.MethodGet, "/topics?regex=%28", nil)
+		})
+
+		It("returns error", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("parse parameter regex failed"))
+		})
+	})
+
+	Context("with invalid hideInternal", func() {
+		BeforeEach(func() {
+			request = httptest.NewRequest(http.MethodGet, "/topics?hideInternal=banana", nil)
+		})
+
+		It("returns error", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("parse parameter hideInternal failed"))
+		})
+	})
+
+	Context("provider fails", func() {
+		BeforeEach(func() {
+			topicsProvider.TopicsReturns(nil, errors.New(ctx, "banana"))
+		})
+
+		It("returns error", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("get topics failed"))
+		})
+	})
+})
@@ -0,0 +1,77 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+	"sort"
+
+	"github.com/IBM/sarama"
+	"github.com/bborbe/errors"
+	libkafka "github.com/bborbe/kafka"
+)
+
+type TopicInfos []TopicInfo
+
+type TopicInfo struct {
+	Name              libkafka.Topic `json:"name"`
+	Partitions        int            `json:"partitions"`
+	ReplicationFactor int            `json:"replicationFactor"`
+	Internal          bool           `json:"internal"`
+}
+
+//counterfeiter:generate -o ../mocks/topics-provider.go --fake-name TopicsProvider . TopicsProvider
+type TopicsProvider interface {
+	// Topics returns all topics of the cluster sorted by name.
+	Topics(ctx context.Context) (TopicInfos, error)
+}
+
+func NewTopicsProvider(
+	clusterAdmin sarama.ClusterAdmin,
+) TopicsProvider {
+	return &topicsProvider{
+		clusterAdmin: clusterAdmin,
+	}
+}
+
+type topicsProvider struct {
+	clusterAdmin sarama.ClusterAdmin
+}
+
+func (t *topicsProvider) Topics(ctx context.Context) (TopicInfos, error) {
+	topicDetails, err := t.clusterAdmin.ListTopics()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, "list topics failed")
+	}
+	names := make([]string, 0, len(topicDetails))
+	for name := range topicDetails {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if len(names) == 0 {
+		return TopicInfos{}, nil
+	}
+
+	metadata, err := t.clusterAdmin.DescribeTopics(names)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, "describe topics failed")
+	}
+	internals := make(map[string]bool, len(metadata))
+	for _, m := range metadata {
+		internals[m.Name] = m.IsInternal
+	}
+
+	result := make(TopicInfos, 0, len(names))
+	for _, name := range names {
+		detail := topicDetails[name]
+		result = append(result, TopicInfo{
+			Name:              libkafka.Topic(name),
+			Partitions:        int(detail.NumPartitions),
+			ReplicationFactor: int(detail.ReplicationFactor),
+			Internal:          internals[name],
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+
+	"github.com/IBM/sarama"
+	"github.com/bborbe/errors"
+	libkafka "github.com/bborbe/kafka"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/mocks"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+var _ = Describe("TopicsProvider", func() {
+	var ctx context.Context
+	var err error
+	var clusterAdmin *mocks.SaramaClusterAdmin
+	var topicsProvider pkg.TopicsProvider
+	var topics pkg.TopicInfos
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		clusterAdmin = &mocks.SaramaClusterAdmin{}
+		clusterAdmin.ListTopicsReturns(map[string]sarama.TopicDetail{
+			"orders":             {NumPartitions: 3, ReplicationFactor: 2},
+			"__consumer_offsets": {NumPartitions: 50, ReplicationFactor: 3},
+		}, nil)
+		clusterAdmin.DescribeTopicsReturns([]*sarama.TopicMetadata{
+			{Name: "orders"},
+			{Name: "__consumer_offsets", IsInternal: true},
+		}, nil)
+		topicsProvider = pkg.NewTopicsProvider(clusterAdmin)
+	})
+
+	JustBeforeEach(func() {
+		topics, err = topicsProvider.Topics(ctx)
+	})
+
+	It("returns no error", func() {
+		Expect(err).To(BeNil())
+	})
+
+	It("returns topics sorted by name", func() {
+		Expect(topics).To(Equal(pkg.TopicInfos{
+			{
+				Name:              libkafka.Topic("__consumer_offsets"),
+				Partitions:        50,
+				ReplicationFactor: 3,
+				Internal:          true,
+			},
+			{
+				Name:              libkafka.Topic("orders"),
+				Partitions:        3,
+				ReplicationFactor: 2,
+			},
+		}))
+	})
+
+	It("describes all listed topics", func() {
+		Expect(clusterAdmin.DescribeTopicsCallCount()).To(Equal(1))
+		Expect(clusterAdmin.DescribeTopicsArgsForCall(0)).To(
+			Equal([]string{"__consumer_offsets", "orders"}),
+		)
+	})
+
+	Context("no topics", func() {
+		BeforeEach(func() {
+			clusterAdmin.ListTopicsReturns(map[string]sarama.TopicDetail{}, nil)
+		})
+
+		It("returns empty list", func() {
+			Expect(err).To(BeNil())
+			Expect(topics).To(BeEmpty())
+			Expect(clusterAdmin.DescribeTopicsCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("list topics fails", func() {
+		BeforeEach(func() {
+			clusterAdmin.ListTopicsReturns(nil, errors.New(ctx, "banana"))
+		})
+
+		It("returns error", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("list topics failed"))
+		})
+	})
+
+	Context("describe topics fails", func() {
+		BeforeEach(func() {
+			clusterAdmin.DescribeTopicsReturns(nil, errors.New(ctx, "banana"))
+		})
+
+		It("returns error", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("describe topics failed"))
+		})
+	})
+})
@@ -0,0 +1,153 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/IBM/sarama"
+	"github.com/bborbe/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// tracer uses the global tracer provider, spans are dropped until one is set.
+var tracer = otel.Tracer("github.com/bborbe/kafka-topic-reader/pkg")
+
+const (
+	attributeTopic     = attribute.Key("messaging.destination.name")
+	attributePartition = attribute.Key("messaging.kafka.destination.partition")
+	attributeOffset    = attribute.Key("messaging.kafka.message.offset")
+)
+
+// TracerProvider creates tracers and flushes pending spans on shutdown.
+type TracerProvider interface {
+	trace.TracerProvider
+	Shutdown(ctx context.Context) error
+}
+
+// NewTracerProvider exports spans via OTLP over HTTP to the given endpoint, like
+// http://otel-collector:4318. Without endpoint all spans are dropped.
+func NewTracerProvider(
+	ctx context.Context,
+	endpoint string,
+	serviceName string,
+	serviceVersion string,
+	sampleRatio float64,
+) (TracerProvider, error) {
+	if endpoint == "" {
+		return noopTracerProvider{}, nil
+	}
+	exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(endpoint))
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "create otlp exporter for %s failed", endpoint)
+	}
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(
+			attribute.String("service.name", serviceName),
+			attribute.String("service.version", serviceVersion),
+		)),
+	), nil
+}
+
+type noopTracerProvider struct {
+	noop.TracerProvider
+}
+
+func (noopTracerProvider) Shutdown(ctx context.Context) error {
+	return nil
+}
+
+// NewTraceContextPropagator reads and writes W3C traceparent and baggage headers.
+func NewTraceContextPropagator() propagation.TextMapPropagator {
+	return propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	)
+}
+
+// NewTracingHandler starts a server span for every request, continuing the trace of
+// the caller if the request has a traceparent header.
+func NewTracingHandler(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+		ctx := otel.GetTextMapPropagator().Extract(
+			req.Context(),
+			propagation.HeaderCarrier(req.Header),
+		)
+		ctx, span := tracer.Start(
+			ctx,
+			req.Method+" "+req.URL.Path,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("http.request.method", req.Method),
+				attribute.String("url.path", req.URL.Path),
+			),
+		)
+		defer span.End()
+
+		statusWriter := &statusResponseWriter{ResponseWriter: resp, status: http.StatusOK}
+		handler.ServeHTTP(statusWriter, req.WithContext(ctx))
+
+		span.SetAttributes(attribute.Int("http.response.status_code", statusWriter.status))
+		if statusWriter.status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(statusWriter.status))
+		}
+	})
+}
+
+// NewTracingConverter adds a span for every conversion.
+func NewTracingConverter(converter Converter) Converter {
+	return &tracingConverter{
+		converter: converter,
+	}
+}
+
+type tracingConverter struct {
+	converter Converter
+}
+
+func (t *tracingConverter) Convert(
+	ctx context.Context,
+	msg *sarama.ConsumerMessage,
+) (*Record, error) {
+	ctx, span := tracer.Start(
+		ctx,
+		"Converter.Convert",
+		trace.WithAttributes(
+			attributeTopic.String(msg.Topic),
+			attributePartition.Int64(int64(msg.Partition)),
+			attributeOffset.Int64(msg.Offset),
+			attribute.Int("messaging.message.body.size", len(msg.Value)),
+		),
+	)
+	defer span.End()
+
+	record, err := t.converter.Convert(ctx, msg)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+	if record.valueDecodeFailure != "" {
+		span.SetAttributes(
+			attribute.String("conversion.failure", string(record.valueDecodeFailure)),
+		)
+	}
+	return record, nil
+}
+
+func recordSpanError(span trace.Span, err error) {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
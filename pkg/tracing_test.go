@@ -0,0 +1,159 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pkg_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/IBM/sarama"
+	"github.com/bborbe/errors"
+	libkafka "github.com/bborbe/kafka"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/bborbe/kafka-topic-reader/mocks"
+	"github.com/bborbe/kafka-topic-reader/pkg"
+)
+
+// the package tracer is bound to the first global provider, so all specs share one
+var testTracerProvider = sdktrace.NewTracerProvider()
+var setTestTracerProvider sync.Once
+
+var _ = Describe("Tracing", func() {
+	var ctx context.Context
+	var recorder *tracetest.SpanRecorder
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		setTestTracerProvider.Do(func() {
+			otel.SetTracerProvider(testTracerProvider)
+			otel.SetTextMapPropagator(pkg.NewTraceContextPropagator())
+		})
+		recorder = tracetest.NewSpanRecorder()
+		testTracerProvider.RegisterSpanProcessor(recorder)
+	})
+
+	AfterEach(func() {
+		testTracerProvider.UnregisterSpanProcessor(recorder)
+	})
+
+	Context("NewTracingHandler", func() {
+		var request *http.Request
+		var status int
+		var innerCtx context.Context
+
+		BeforeEach(func() {
+			status = http.StatusOK
+			request = httptest.NewRequest(http.MethodGet, "/read", nil)
+		})
+
+		JustBeforeEach(func() {
+			pkg.NewTracingHandler(
+				http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+					innerCtx = req.Context()
+					resp.WriteHeader(status)
+				}),
+			).ServeHTTP(httptest.NewRecorder(), request)
+		})
+
+		It("records one server span", func() {
+			spans := recorder.Ended()
+			Expect(spans).To(HaveLen(1))
+			Expect(spans[0].Name()).To(Equal("GET /read"))
+			Expect(spans[0].SpanKind()).To(Equal(trace.SpanKindServer))
+		})
+
+		It("passes the span to the handler", func() {
+			spanContext := trace.SpanContextFromContext(innerCtx)
+			Expect(spanContext.SpanID()).To(Equal(recorder.Ended()[0].SpanContext().SpanID()))
+		})
+
+		It("starts a new trace", func() {
+			Expect(recorder.Ended()[0].Parent().IsValid()).To(BeFalse())
+		})
+
+		Context("with traceparent header", func() {
+			BeforeEach(func() {
+				request.Header.Set(
+					"traceparent",
+					"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+				)
+			})
+			It("continues the trace", func() {
+				span := recorder.Ended()[0]
+				Expect(span.SpanContext().TraceID().String()).
+					To(Equal("4bf92f3577b34da6a3ce929d0e0e4736"))
+				Expect(span.Parent().SpanID().String()).To(Equal("00f067aa0ba902b7"))
+			})
+		})
+
+		Context("with server error", func() {
+			BeforeEach(func() {
+				status = http.StatusBadGateway
+			})
+			It("marks the span as error", func() {
+				Expect(recorder.Ended()[0].Status().Code).To(Equal(codes.Error))
+			})
+		})
+	})
+
+	Context("NewTracingConverter", func() {
+		var converter *mocks.Converter
+		var record *pkg.Record
+		var err error
+
+		BeforeEach(func() {
+			converter = &mocks.Converter{}
+			converter.ConvertReturns(&pkg.Record{Offset: 42}, nil)
+		})
+
+		JustBeforeEach(func() {
+			record, err = pkg.NewTracingConverter(converter).Convert(ctx, &sarama.ConsumerMessage{
+				Topic:  "orders",
+				Offset: 42,
+			})
+		})
+
+		It("returns the record", func() {
+			Expect(err).To(BeNil())
+			Expect(record).NotTo(BeNil())
+			Expect(record.Offset).To(Equal(libkafka.Offset(42)))
+		})
+
+		It("records a span", func() {
+			spans := recorder.Ended()
+			Expect(spans).To(HaveLen(1))
+			Expect(spans[0].Name()).To(Equal("Converter.Convert"))
+		})
+
+		Context("with conversion error", func() {
+			BeforeEach(func() {
+				converter.ConvertReturns(nil, errors.New(ctx, "banana"))
+			})
+			It("returns the error", func() {
+				Expect(err).NotTo(BeNil())
+			})
+			It("marks the span as error", func() {
+				Expect(recorder.Ended()[0].Status().Code).To(Equal(codes.Error))
+			})
+		})
+	})
+
+	Context("NewTracerProvider", func() {
+		It("returns a no-op provider without endpoint", func() {
+			tracerProvider, err := pkg.NewTracerProvider(ctx, "", "kafka-topic-reader", "dev", 1)
+			Expect(err).To(BeNil())
+			Expect(tracerProvider.Shutdown(ctx)).To(BeNil())
+		})
+	})
+})
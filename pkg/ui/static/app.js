@@ -0,0 +1,369 @@
+(function () {
+  "use strict";
+
+  var fields = ["cluster", "topic", "partition", "offset", "limit", "filter"];
+  var form = document.getElementById("query");
+  var statusEl = document.getElementById("status");
+  var recordsEl = document.getElementById("records");
+  var prevButton = document.getElementById("prev");
+  var nextButton = document.getElementById("next");
+  var shareButton = document.getElementById("share");
+
+  // offsets of previously read pages, used by the prev button
+  var history = [];
+  var nextOffset = null;
+  // watermarks of the partitions of the selected topic
+  var partitions = [];
+  // configs explaining why old offsets are gone
+  var retentionConfigs = ["cleanup.policy", "retention.ms", "retention.bytes", "min.compaction.lag.ms", "max.message.bytes"];
+
+  function input(name) {
+    return document.getElementById(name);
+  }
+
+  function currentParams() {
+    var params = new URLSearchParams();
+    fields.forEach(function (name) {
+      var value = input(name).value;
+      if (value !== "") {
+        params.set(name, value);
+      }
+    });
+    return params;
+  }
+
+  function applyParams(params) {
+    fields.forEach(function (name) {
+      if (params.has(name)) {
+        input(name).value = params.get(name);
+      }
+    });
+  }
+
+  // the token is kept per browser tab and never put into shareable urls
+  var tokenKey = "kafka-topic-reader-token";
+
+  function requestHeaders() {
+    var headers = { "Accept": "application/json" };
+    var token = input("token").value;
+    if (token !== "") {
+      headers["Authorization"] = "Bearer " + token;
+    }
+    return headers;
+  }
+
+  // api returns the url of an endpoint on the selected cluster
+  function api(path, params) {
+    params = new URLSearchParams(params || "");
+    var cluster = input("cluster").value;
+    if (cluster !== "") {
+      params.set("cluster", cluster);
+    }
+    var query = params.toString();
+    return ".." + path + (query === "" ? "" : "?" + query);
+  }
+
+  function setStatus(message, isError) {
+    statusEl.textContent = message;
+    statusEl.className = isError ? "error" : "";
+  }
+
+  function shareableURL(params) {
+    return window.location.origin + window.location.pathname + "?" + params.toString();
+  }
+
+  function hexDump(hex) {
+    var lines = [];
+    for (var i = 0; i < hex.length; i += 32) {
+      var chunk = hex.substring(i, i + 32);
+      var bytes = chunk.match(/.{1,2}/g) || [];
+      var ascii = bytes.map(function (b) {
+        var c = parseInt(b, 16);
+        return c >= 32 && c < 127 ? String.fromCharCode(c) : ".";
+      }).join("");
+      var offset = ("00000000" + (i / 2).toString(16)).slice(-8);
+      lines.push(offset + "  " + bytes.join(" ").padEnd(47) + "  " + ascii);
+    }
+    return lines.join("\n");
+  }
+
+  function isFailedDecode(value) {
+    return value !== null && typeof value === "object" && !Array.isArray(value) &&
+      typeof value.error === "string" && typeof value.previewHex === "string";
+  }
+
+  function renderValue(value) {
+    if (isFailedDecode(value)) {
+      return value.error + "\nvalueLength: " + value.valueLength + "\n\n" + hexDump(value.previewHex);
+    }
+    return JSON.stringify(value, null, 2);
+  }
+
+  function renderRecord(record) {
+    var details = document.createElement("details");
+    details.className = "record" + (isFailedDecode(record.value) ? " failed" : "");
+
+    var summary = document.createElement("summary");
+    summary.textContent = "#" + record.offset + " " + (record.key || "<no key>");
+    var meta = document.createElement("span");
+    meta.className = "meta";
+    meta.textContent = record.topic + "/" + record.partition;
+    summary.appendChild(meta);
+    if (record.redacted && record.redacted.length > 0) {
+      var badge = document.createElement("span");
+      badge.className = "redacted";
+      badge.textContent = "redacted";
+      badge.title = record.redacted.join("\n");
+      summary.appendChild(badge);
+    }
+    details.appendChild(summary);
+
+    var value = document.createElement("pre");
+    value.textContent = renderValue(record.value);
+    details.appendChild(value);
+
+    if (record.header && Object.keys(record.header).length > 0) {
+      var header = document.createElement("pre");
+      header.textContent = "header: " + JSON.stringify(record.header, null, 2);
+      details.appendChild(header);
+    }
+    return details;
+  }
+
+  function selectedPartition() {
+    var partition = parseInt(input("partition").value, 10);
+    return partitions.find(function (p) {
+      return p.partition === partition;
+    });
+  }
+
+  function renderRange() {
+    var rangeText = document.getElementById("range-text");
+    var progress = document.getElementById("progress");
+    var info = selectedPartition();
+    if (!info) {
+      rangeText.textContent = "";
+      progress.hidden = true;
+      return;
+    }
+    rangeText.textContent = "offsets " + info.lowWaterMark + " - " + info.highWaterMark +
+      " (~" + info.estimatedMessages + " messages, leader " + info.leaderAddr + ")";
+    var position = nextOffset === null || nextOffset === undefined ? parseInt(input("offset").value, 10) : nextOffset;
+    if (position < 0) {
+      position = info.highWaterMark + position;
+    }
+    progress.max = Math.max(info.estimatedMessages, 1);
+    progress.value = Math.min(Math.max(position - info.lowWaterMark, 0), progress.max);
+    progress.hidden = false;
+  }
+
+  function loadConfig(topic) {
+    var configText = document.getElementById("config-text");
+    configText.textContent = "";
+    fetch(api("/topics/" + encodeURIComponent(topic) + "/config"), { headers: requestHeaders() })
+      .then(function (resp) {
+        if (!resp.ok) {
+          throw new Error(resp.status);
+        }
+        return resp.json();
+      })
+      .then(function (entries) {
+        configText.textContent = entries
+          .filter(function (entry) {
+            return retentionConfigs.indexOf(entry.name) !== -1;
+          })
+          .map(function (entry) {
+            return entry.name + " = " + entry.value + " (" + entry.source + ")";
+          })
+          .join("\n");
+      })
+      .catch(function (err) {
+        configText.textContent = "load config failed: " + err.message;
+      });
+  }
+
+  function loadPartitions() {
+    var topic = input("topic").value;
+    partitions = [];
+    renderRange();
+    if (topic === "") {
+      return;
+    }
+    loadConfig(topic);
+    fetch(api("/topics/" + encodeURIComponent(topic) + "/partitions"), { headers: requestHeaders() })
+      .then(function (resp) {
+        if (!resp.ok) {
+          throw new Error(resp.status);
+        }
+        return resp.json();
+      })
+      .then(function (result) {
+        partitions = result;
+        var list = document.getElementById("partitions");
+        list.innerHTML = "";
+        partitions.forEach(function (p) {
+          var option = document.createElement("option");
+          option.value = p.partition;
+          option.label = p.lowWaterMark + " - " + p.highWaterMark;
+          list.appendChild(option);
+        });
+        renderRange();
+      })
+      .catch(function (err) {
+        setStatus("load partitions failed: " + err.message, true);
+      });
+  }
+
+  function render(page) {
+    recordsEl.innerHTML = "";
+    (page.records || []).forEach(function (record) {
+      recordsEl.appendChild(renderRecord(record));
+    });
+  }
+
+  function read(pushHistory) {
+    var params = currentParams();
+    if (pushHistory) {
+      window.history.pushState(null, "", shareableURL(params));
+    }
+    setStatus("loading ...", false);
+    nextButton.disabled = true;
+    return fetch(api("/read", params), { headers: requestHeaders() })
+      .then(function (resp) {
+        if (!resp.ok) {
+          return resp.text().then(function (text) {
+            throw new Error(resp.status + " " + text);
+          });
+        }
+        return resp.json();
+      })
+      .then(function (page) {
+        render(page);
+        nextOffset = page.nextOffset;
+        nextButton.disabled = nextOffset === undefined || nextOffset === null;
+        prevButton.disabled = history.length === 0;
+        var message = (page.records || []).length + " records, next offset " + nextOffset;
+        if (page.status) {
+          message += ", " + page.status + " (scanned " + page.scanned + ", high watermark " + page.highWaterMark + ")";
+        }
+        var requested = parseInt(params.get("offset"), 10);
+        if (page.records && page.records.length > 0 && requested >= 0 && page.records[0].offset > requested) {
+          message += " (offset " + requested + " no longer available, see topic config)";
+        }
+        if (page.truncated) {
+          message += " (truncated: " + page.truncated + ")";
+        }
+        setStatus(message, false);
+        renderRange();
+      })
+      .catch(function (err) {
+        setStatus("read failed: " + err.message, true);
+      });
+  }
+
+  input("topic").addEventListener("change", loadPartitions);
+  input("partition").addEventListener("change", renderRange);
+
+  form.addEventListener("submit", function (event) {
+    event.preventDefault();
+    history = [];
+    read(true);
+  });
+
+  nextButton.addEventListener("click", function () {
+    history.push(input("offset").value);
+    input("offset").value = nextOffset;
+    read(true);
+  });
+
+  prevButton.addEventListener("click", function () {
+    if (history.length === 0) {
+      return;
+    }
+    input("offset").value = history.pop();
+    read(true);
+  });
+
+  shareButton.addEventListener("click", function () {
+    var url = shareableURL(currentParams());
+    if (navigator.clipboard) {
+      navigator.clipboard.writeText(url);
+    }
+    setStatus("link: " + url, false);
+  });
+
+  window.addEventListener("popstate", function () {
+    applyParams(new URLSearchParams(window.location.search));
+    nextOffset = null;
+    loadPartitions();
+    read(false);
+  });
+
+  function loadTopics() {
+    fetch(api("/topics", "hideInternal=true"), { headers: requestHeaders() })
+      .then(function (resp) {
+        if (!resp.ok) {
+          throw new Error(resp.status);
+        }
+        return resp.json();
+      })
+      .then(function (topics) {
+        var list = document.getElementById("topics");
+        list.innerHTML = "";
+        topics.forEach(function (topic) {
+          var option = document.createElement("option");
+          option.value = topic.name;
+          option.label = topic.partitions + " partitions";
+          list.appendChild(option);
+        });
+      })
+      .catch(function (err) {
+        setStatus("load topics failed: " + err.message, true);
+      });
+  }
+
+  function loadClusters() {
+    return fetch("../clusters", { headers: requestHeaders() })
+      .then(function (resp) {
+        if (!resp.ok) {
+          throw new Error(resp.status);
+        }
+        return resp.json();
+      })
+      .then(function (clusters) {
+        var select = input("cluster");
+        select.innerHTML = "";
+        clusters.forEach(function (cluster) {
+          var option = document.createElement("option");
+          option.value = cluster.name;
+          option.textContent = cluster.name + (cluster.healthy ? "" : " (unavailable)");
+          select.appendChild(option);
+        });
+        document.getElementById("cluster-label").hidden = clusters.length < 2;
+      })
+      .catch(function (err) {
+        setStatus("load clusters failed: " + err.message, true);
+      });
+  }
+
+  input("token").value = window.sessionStorage.getItem(tokenKey) || "";
+  input("token").addEventListener("change", function () {
+    window.sessionStorage.setItem(tokenKey, input("token").value);
+    loadClusters().then(loadTopics);
+  });
+
+  input("cluster").addEventListener("change", function () {
+    loadTopics();
+    loadPartitions();
+  });
+
+  loadClusters().then(function () {
+    var initial = new URLSearchParams(window.location.search);
+    applyParams(initial);
+    loadTopics();
+    if (initial.has("topic")) {
+      loadPartitions();
+      read(false);
+    }
+  });
+})();
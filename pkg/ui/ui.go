@@ -0,0 +1,25 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ui
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+)
+
+//go:embed static
+var content embed.FS
+
+// NewHandler returns a handler serving the embedded web UI.
+// prefix is the path the UI is mounted on and is stripped before file lookup.
+func NewHandler(prefix string) http.Handler {
+	static, err := fs.Sub(content, "static")
+	if err != nil {
+		// static is embedded at compile time, so this can not happen
+		panic(err)
+	}
+	return http.StripPrefix(prefix, http.FileServer(http.FS(static)))
+}
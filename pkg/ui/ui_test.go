@@ -0,0 +1,69 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ui_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/kafka-topic-reader/pkg/ui"
+)
+
+var _ = Describe("UI", func() {
+	var handler http.Handler
+	var response *httptest.ResponseRecorder
+	var path string
+
+	BeforeEach(func() {
+		handler = ui.NewHandler("/ui/")
+		response = httptest.NewRecorder()
+	})
+
+	JustBeforeEach(func() {
+		handler.ServeHTTP(response, httptest.NewRequest(http.MethodGet, path, nil))
+	})
+
+	Context("index", func() {
+		BeforeEach(func() {
+			path = "/ui/"
+		})
+
+		It("returns OK status", func() {
+			Expect(response.Code).To(Equal(http.StatusOK))
+		})
+
+		It("returns html", func() {
+			Expect(response.Header().Get("Content-Type")).To(ContainSubstring("text/html"))
+			Expect(response.Body.String()).To(ContainSubstring("Kafka Topic Reader"))
+		})
+	})
+
+	Context("script", func() {
+		BeforeEach(func() {
+			path = "/ui/app.js"
+		})
+
+		It("returns OK status", func() {
+			Expect(response.Code).To(Equal(http.StatusOK))
+		})
+
+		It("reads from the read endpoint", func() {
+			Expect(response.Body.String()).To(ContainSubstring(`api("/read"`))
+		})
+	})
+
+	Context("unknown file", func() {
+		BeforeEach(func() {
+			path = "/ui/missing.js"
+		})
+
+		It("returns not found", func() {
+			Expect(response.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+})